@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"io"
 	stdlog "log"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
 	"syscall"
 
+	"github.com/github/github-mcp-server/pkg/auth"
 	"github.com/github/github-mcp-server/pkg/github"
 	iolog "github.com/github/github-mcp-server/pkg/log"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -20,6 +24,9 @@ import (
 	"github.com/spf13/viper"
 )
 
+// validToolNamePrefix matches the characters allowed in the names of MCP tools.
+var validToolNamePrefix = regexp.MustCompile(`^[A-Za-z0-9_-]*$`)
+
 var version = "version"
 var commit = "commit"
 var date = "date"
@@ -54,14 +61,38 @@ var (
 			if err != nil {
 				stdlog.Fatal("Failed to unmarshal toolsets:", err)
 			}
+			var disabledTools []string
+			err = viper.UnmarshalKey("disabled_tools", &disabledTools)
+			if err != nil {
+				stdlog.Fatal("Failed to unmarshal disabled tools:", err)
+			}
 
 			logCommands := viper.GetBool("enable-command-logging")
+			logToolCalls := viper.GetBool("enable_tool_call_logging")
+			dryRun := viper.GetBool("dry_run")
+			throttle := viper.GetBool("rate_limit_throttling")
+			throttleThreshold := viper.GetInt("rate_limit_threshold")
+			maxItems := viper.GetInt("max_items")
+			if maxItems < 0 {
+				stdlog.Fatal("Invalid max items, it must be 0 or more:", maxItems)
+			}
+			toolNamePrefix := viper.GetString("tool_name_prefix")
+			if !validToolNamePrefix.MatchString(toolNamePrefix) {
+				stdlog.Fatal("Invalid tool name prefix, it may only hold letters, digits, underscores and hyphens:", toolNamePrefix)
+			}
 			cfg := runConfig{
 				readOnly:           readOnly,
 				logger:             logger,
 				logCommands:        logCommands,
+				logToolCalls:       logToolCalls,
+				dryRun:             dryRun,
+				throttle:           throttle,
+				throttleThreshold:  throttleThreshold,
+				maxItems:           maxItems,
+				toolNamePrefix:     toolNamePrefix,
 				exportTranslations: exportTranslations,
 				enabledToolsets:    enabledToolsets,
+				disabledTools:      disabledTools,
 			}
 			if err := runStdioServer(cfg); err != nil {
 				stdlog.Fatal("failed to run stdio server:", err)
@@ -77,21 +108,37 @@ func init() {
 
 	// Add global flags that will be shared by all commands
 	rootCmd.PersistentFlags().StringSlice("toolsets", github.DefaultTools, "An optional comma separated list of groups of tools to allow, defaults to enabling all")
+	rootCmd.PersistentFlags().StringSlice("disabled-tools", nil, "An optional comma separated list of tools to disable, e.g. delete_file, while keeping the other tools of their toolsets")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "When enabled, the server will log all command requests and responses to the log file")
+	rootCmd.PersistentFlags().Bool("enable-tool-call-logging", false, "When enabled, the server will log the name, arguments (with secrets and file contents redacted), duration and status of every tool call")
+	rootCmd.PersistentFlags().Bool("dry-run", false, "Make mutating tools validate their inputs and describe the change they would make without making it")
+	rootCmd.PersistentFlags().Bool("rate-limit-throttling", false, "Delay GitHub API requests until the rate limit resets once few requests remain, instead of letting them fail")
+	rootCmd.PersistentFlags().Int("rate-limit-threshold", github.DefaultRateLimitThreshold, "Number of remaining requests below which rate limit throttling delays requests")
+	rootCmd.PersistentFlags().Int("max-items", 0, "Maximum number of items any paginated tool returns, whatever page size is requested, 0 for no maximum")
+	rootCmd.PersistentFlags().String("tool-name-prefix", "", "Prefix the names of all tools, e.g. gh_ to register get_me as gh_get_me, to avoid clashes with the tools of other MCP servers")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
+	rootCmd.PersistentFlags().String("oauth-client-id", "", "Client ID of an OAuth app used to sign in with the device flow when no personal access token is set")
 
 	// Bind flag to viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
+	_ = viper.BindPFlag("disabled_tools", rootCmd.PersistentFlags().Lookup("disabled-tools"))
 	_ = viper.BindPFlag("dynamic_toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
+	_ = viper.BindPFlag("enable_tool_call_logging", rootCmd.PersistentFlags().Lookup("enable-tool-call-logging"))
+	_ = viper.BindPFlag("dry_run", rootCmd.PersistentFlags().Lookup("dry-run"))
+	_ = viper.BindPFlag("rate_limit_throttling", rootCmd.PersistentFlags().Lookup("rate-limit-throttling"))
+	_ = viper.BindPFlag("rate_limit_threshold", rootCmd.PersistentFlags().Lookup("rate-limit-threshold"))
+	_ = viper.BindPFlag("max_items", rootCmd.PersistentFlags().Lookup("max-items"))
+	_ = viper.BindPFlag("tool_name_prefix", rootCmd.PersistentFlags().Lookup("tool-name-prefix"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
+	_ = viper.BindPFlag("oauth_client_id", rootCmd.PersistentFlags().Lookup("oauth-client-id"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
@@ -124,35 +171,20 @@ type runConfig struct {
 	readOnly           bool
 	logger             *log.Logger
 	logCommands        bool
+	logToolCalls       bool
+	dryRun             bool
+	throttle           bool
+	throttleThreshold  int
+	maxItems           int
+	toolNamePrefix     string
 	exportTranslations bool
 	enabledToolsets    []string
+	disabledTools      []string
 }
 
-func runStdioServer(cfg runConfig) error {
-	// Create app context
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	// Create GH client
-	token := viper.GetString("personal_access_token")
-	if token == "" {
-		cfg.logger.Fatal("GITHUB_PERSONAL_ACCESS_TOKEN not set")
-	}
-	ghClient := gogithub.NewClient(nil).WithAuthToken(token)
-	ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", version)
-
-	host := viper.GetString("host")
-
-	if host != "" {
-		var err error
-		ghClient, err = ghClient.WithEnterpriseURLs(host, host)
-		if err != nil {
-			return fmt.Errorf("failed to create GitHub client with host: %w", err)
-		}
-	}
-
-	t, dumpTranslations := translations.TranslationHelper()
-
+// newMCPServer creates the MCP server serving cfg with the tools and resources of the GitHub API
+// reached through ghClient.
+func newMCPServer(cfg runConfig, ghClient *gogithub.Client, t translations.TranslationHelperFunc) *server.MCPServer {
 	beforeInit := func(_ context.Context, _ any, message *mcp.InitializeRequest) {
 		ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s (%s/%s)", version, message.Params.ClientInfo.Name, message.Params.ClientInfo.Version)
 	}
@@ -164,8 +196,19 @@ func runStdioServer(cfg runConfig) error {
 	hooks := &server.Hooks{
 		OnBeforeInitialize: []server.OnBeforeInitializeFunc{beforeInit},
 	}
+	serverOpts := []server.ServerOption{server.WithHooks(hooks)}
+	if cfg.logToolCalls {
+		toolCallLogger := slog.New(slog.NewTextHandler(cfg.logger.Out, nil))
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(github.ToolCallLoggingMiddleware(toolCallLogger)))
+	}
+	if cfg.dryRun {
+		serverOpts = append(serverOpts, github.WithDryRun())
+	}
+	if cfg.maxItems > 0 {
+		serverOpts = append(serverOpts, github.WithMaxItems(cfg.maxItems))
+	}
 	// Create server
-	ghServer := github.NewServer(version, server.WithHooks(hooks))
+	ghServer := github.NewServer(version, serverOpts...)
 
 	enabled := cfg.enabledToolsets
 	dynamic := viper.GetBool("dynamic_toolsets")
@@ -182,22 +225,78 @@ func runStdioServer(cfg runConfig) error {
 	// Create default toolsets
 	toolsets, err := github.InitToolsets(enabled, cfg.readOnly, getClient, t)
 	context := github.InitContextToolset(getClient, t)
+	batch := github.InitBatchToolset(ghServer, cfg.readOnly, t)
 
 	if err != nil {
 		stdlog.Fatal("Failed to initialize toolsets:", err)
 	}
+	toolsets.SetToolNamePrefix(cfg.toolNamePrefix)
+	context.SetToolNamePrefix(cfg.toolNamePrefix)
+	batch.SetToolNamePrefix(cfg.toolNamePrefix)
+	for _, name := range cfg.disabledTools {
+		if !toolsets.HasTool(name) && !context.HasTool(name) && !batch.HasTool(name) {
+			stdlog.Fatal("Failed to disable tools, no tool is named:", name)
+		}
+	}
+	toolsets.DisableTools(cfg.disabledTools...)
+	context.DisableTools(cfg.disabledTools...)
+	batch.DisableTools(cfg.disabledTools...)
 
 	// Register resources with the server
 	github.RegisterResources(ghServer, getClient, t)
 	// Register the tools with the server
 	toolsets.RegisterTools(ghServer)
 	context.RegisterTools(ghServer)
+	batch.RegisterTools(ghServer)
 
 	if dynamic {
 		dynamic := github.InitDynamicToolset(ghServer, toolsets, t)
+		dynamic.SetToolNamePrefix(cfg.toolNamePrefix)
 		dynamic.RegisterTools(ghServer)
 	}
 
+	return ghServer
+}
+
+func runStdioServer(cfg runConfig) error {
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	host := viper.GetString("host")
+
+	// Create GH client
+	token := viper.GetString("personal_access_token")
+	if token == "" {
+		clientID := viper.GetString("oauth_client_id")
+		if clientID == "" {
+			cfg.logger.Fatal("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+		}
+		var err error
+		token, err = deviceFlowToken(ctx, host, clientID)
+		if err != nil {
+			return fmt.Errorf("failed to sign in with the device flow: %w", err)
+		}
+	}
+	var httpClient *http.Client
+	if cfg.throttle {
+		httpClient = &http.Client{Transport: github.NewRateLimitTransport(nil, cfg.throttleThreshold)}
+	}
+	ghClient := gogithub.NewClient(httpClient).WithAuthToken(token)
+	ghClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", version)
+
+	if host != "" {
+		var err error
+		ghClient, err = ghClient.WithEnterpriseURLs(host, host)
+		if err != nil {
+			return fmt.Errorf("failed to create GitHub client with host: %w", err)
+		}
+	}
+
+	t, dumpTranslations := translations.TranslationHelper()
+
+	ghServer := newMCPServer(cfg, ghClient, t)
+
 	stdioServer := server.NewStdioServer(ghServer)
 
 	stdLogger := stdlog.New(cfg.logger.Writer(), "stdioserver", 0)
@@ -237,6 +336,28 @@ func runStdioServer(cfg runConfig) error {
 	return nil
 }
 
+// deviceFlowToken signs the user in with the OAuth device flow. The user code is printed to stderr,
+// since stdout carries the JSON-RPC stream.
+func deviceFlowToken(ctx context.Context, host, clientID string) (string, error) {
+	baseURL := auth.DefaultBaseURL
+	if host != "" {
+		baseURL = host
+	}
+	flow := auth.NewDeviceFlow(baseURL, clientID, []string{"repo", "read:org"}, nil)
+
+	code, err := flow.RequestCode(ctx)
+	if err != nil {
+		return "", err
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "To sign in to GitHub, open %s and enter the code %s\n", code.VerificationURI, code.UserCode)
+
+	token, err := flow.PollToken(ctx, code)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	gogithub "github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewMCPServer(t *testing.T) {
+	for _, readOnly := range []bool{false, true} {
+		t.Run(fmt.Sprintf("read-only %t", readOnly), func(t *testing.T) {
+			cfg := runConfig{
+				readOnly:        readOnly,
+				maxItems:        50,
+				enabledToolsets: []string{"all"},
+			}
+			// Toolsets are built at startup, a tool misregistered in one of them panics here
+			s := newMCPServer(cfg, gogithub.NewClient(nil), translations.NullTranslationHelper)
+
+			response, ok := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc": "2.0", "id": 1, "method": "tools/list"}`)).(mcp.JSONRPCResponse)
+			require.True(t, ok)
+			listed, ok := response.Result.(mcp.ListToolsResult)
+			require.True(t, ok)
+
+			tools := make(map[string]mcp.Tool, len(listed.Tools))
+			for _, tool := range listed.Tools {
+				tools[tool.Name] = tool
+			}
+			require.Contains(t, tools, "get_me")
+			require.Contains(t, tools, "batch_execute")
+			assert.Equal(t, readOnly, *tools["batch_execute"].Annotations.ReadOnlyHint)
+			if readOnly {
+				assert.NotContains(t, tools, "create_issue")
+			} else {
+				assert.Contains(t, tools, "create_issue")
+			}
+		})
+	}
+}
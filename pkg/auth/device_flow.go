@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the web URL the OAuth device flow runs against on github.com.
+const DefaultBaseURL = "https://github.com"
+
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// defaultPollInterval is the poll interval in seconds when the server does not send one, per RFC 8628.
+const defaultPollInterval = 5
+
+var (
+	// ErrExpiredToken is returned when the device code expired before the user authorized it.
+	ErrExpiredToken = errors.New("device code expired before it was authorized")
+	// ErrAccessDenied is returned when the user declined the authorization request.
+	ErrAccessDenied = errors.New("authorization request was denied by the user")
+)
+
+// DeviceCode is the response to a device code request. The user has to enter UserCode at
+// VerificationURI to authorize the application.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// Token is an OAuth access token obtained through the device flow.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"`
+}
+
+// tokenResponse is the response of the access token endpoint, which reports pending
+// authorization and other poll states as errors with a 200 status.
+type tokenResponse struct {
+	Token
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+// DeviceFlow performs the OAuth device authorization grant, which lets a user authorize an
+// application from a browser without the application ever handling their credentials.
+type DeviceFlow struct {
+	baseURL    string
+	clientID   string
+	scopes     []string
+	httpClient *http.Client
+
+	// intervalUnit is the unit of the poll intervals and expiry sent by the server, overridden in tests.
+	intervalUnit time.Duration
+}
+
+// NewDeviceFlow creates a device flow for the OAuth app with the given client ID. baseURL is the
+// web URL of the GitHub instance, e.g. DefaultBaseURL or https://<ghes-host>.
+func NewDeviceFlow(baseURL, clientID string, scopes []string, httpClient *http.Client) *DeviceFlow {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &DeviceFlow{
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		clientID:     clientID,
+		scopes:       scopes,
+		httpClient:   httpClient,
+		intervalUnit: time.Second,
+	}
+}
+
+// RequestCode starts the device flow and returns the code the user has to enter.
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	form := url.Values{
+		"client_id": {f.clientID},
+		"scope":     {strings.Join(f.scopes, " ")},
+	}
+	var code DeviceCode
+	if err := f.post(ctx, "/login/device/code", form, &code); err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+	if code.DeviceCode == "" {
+		return nil, errors.New("failed to request device code: response did not contain a device code")
+	}
+	return &code, nil
+}
+
+// PollToken polls until the user has authorized the device code, it expires or ctx is done.
+// Polling honors the interval requested by the server and backs off when told to slow down.
+// The code expires after its ExpiresIn, or when the server reports it expired.
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (*Token, error) {
+	interval := time.Duration(code.Interval) * f.intervalUnit
+	if code.Interval <= 0 {
+		interval = defaultPollInterval * f.intervalUnit
+	}
+	var expired <-chan time.Time
+	if code.ExpiresIn > 0 {
+		timer := time.NewTimer(time.Duration(code.ExpiresIn) * f.intervalUnit)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	form := url.Values{
+		"client_id":   {f.clientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {deviceCodeGrantType},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-expired:
+			return nil, ErrExpiredToken
+		case <-time.After(interval):
+		}
+
+		var resp tokenResponse
+		if err := f.post(ctx, "/login/oauth/access_token", form, &resp); err != nil {
+			return nil, fmt.Errorf("failed to poll for access token: %w", err)
+		}
+
+		switch resp.Error {
+		case "":
+			if resp.AccessToken == "" {
+				return nil, errors.New("failed to poll for access token: response did not contain a token")
+			}
+			return &resp.Token, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			// The server sends the new interval, per RFC 8628 it is the old one plus 5 seconds
+			if resp.Interval > 0 {
+				interval = time.Duration(resp.Interval) * f.intervalUnit
+			} else {
+				interval += 5 * f.intervalUnit
+			}
+		case "expired_token":
+			return nil, ErrExpiredToken
+		case "access_denied":
+			return nil, ErrAccessDenied
+		default:
+			return nil, fmt.Errorf("failed to poll for access token: %s: %s", resp.Error, resp.ErrorDescription)
+		}
+	}
+}
+
+// post sends a form to the given path and decodes the JSON response into v.
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestFlow creates a device flow against a test server whose token endpoint answers
+// with the given poll responses in order, returning the flow and a pointer to the poll count.
+func newTestFlow(t *testing.T, pollResponses []map[string]any) (*DeviceFlow, *int) {
+	t.Helper()
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/device/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "repo read:org", r.PostForm.Get("scope"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-1234",
+			"verification_uri": "https://github.com/login/device",
+			"expires_in":       900,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "device-code", r.PostForm.Get("device_code"))
+		assert.Equal(t, deviceCodeGrantType, r.PostForm.Get("grant_type"))
+		if !assert.Less(t, polls, len(pollResponses), "unexpected extra poll") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(pollResponses[polls])
+		polls++
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	flow := NewDeviceFlow(srv.URL+"/", "client-id", []string{"repo", "read:org"}, srv.Client())
+	flow.intervalUnit = time.Millisecond
+	return flow, &polls
+}
+
+func Test_DeviceFlow_RequestCode(t *testing.T) {
+	flow, _ := newTestFlow(t, nil)
+
+	code, err := flow.RequestCode(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "device-code", code.DeviceCode)
+	assert.Equal(t, "ABCD-1234", code.UserCode)
+	assert.Equal(t, "https://github.com/login/device", code.VerificationURI)
+	assert.Equal(t, 900, code.ExpiresIn)
+	assert.Equal(t, 1, code.Interval)
+}
+
+func Test_DeviceFlow_PollToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		pollResponses []map[string]any
+		expectedPolls int
+		expectedToken string
+		expectedErr   error
+		expectedMsg   string
+	}{
+		{
+			name: "pending then slow down then authorized",
+			pollResponses: []map[string]any{
+				{"error": "authorization_pending"},
+				{"error": "slow_down", "interval": 2},
+				{"error": "slow_down"},
+				{"error": "authorization_pending"},
+				{"access_token": "gho_token", "token_type": "bearer", "scope": "repo,read:org"},
+			},
+			expectedPolls: 5,
+			expectedToken: "gho_token",
+		},
+		{
+			name: "expired token",
+			pollResponses: []map[string]any{
+				{"error": "authorization_pending"},
+				{"error": "expired_token"},
+			},
+			expectedPolls: 2,
+			expectedErr:   ErrExpiredToken,
+		},
+		{
+			name: "access denied",
+			pollResponses: []map[string]any{
+				{"error": "access_denied"},
+			},
+			expectedPolls: 1,
+			expectedErr:   ErrAccessDenied,
+		},
+		{
+			name: "unknown error",
+			pollResponses: []map[string]any{
+				{"error": "incorrect_client_credentials", "error_description": "The client_id is not valid."},
+			},
+			expectedPolls: 1,
+			expectedMsg:   "incorrect_client_credentials: The client_id is not valid.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flow, polls := newTestFlow(t, tc.pollResponses)
+
+			code, err := flow.RequestCode(context.Background())
+			require.NoError(t, err)
+
+			token, err := flow.PollToken(context.Background(), code)
+			assert.Equal(t, tc.expectedPolls, *polls)
+
+			switch {
+			case tc.expectedErr != nil:
+				require.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectedMsg != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedToken, token.AccessToken)
+				assert.Equal(t, "bearer", token.TokenType)
+			}
+		})
+	}
+}
+
+func Test_DeviceFlow_PollTokenDefaultInterval(t *testing.T) {
+	flow, polls := newTestFlow(t, []map[string]any{
+		{"error": "authorization_pending"},
+		{"access_token": "gho_token", "token_type": "bearer"},
+	})
+
+	// Without an interval from the server, polling waits the RFC 8628 default of 5 seconds
+	start := time.Now()
+	token, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "device-code"})
+	require.NoError(t, err)
+	assert.Equal(t, "gho_token", token.AccessToken)
+	assert.Equal(t, 2, *polls)
+	assert.GreaterOrEqual(t, time.Since(start), 2*defaultPollInterval*time.Millisecond)
+}
+
+func Test_DeviceFlow_PollTokenStopsAtExpiry(t *testing.T) {
+	pending := make([]map[string]any, 100)
+	for i := range pending {
+		pending[i] = map[string]any{"error": "authorization_pending"}
+	}
+	flow, polls := newTestFlow(t, pending)
+
+	_, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "device-code", Interval: 10, ExpiresIn: 35})
+	require.ErrorIs(t, err, ErrExpiredToken)
+	// About 3 polls fit in the lifetime of the code, timing on a busy machine may allow fewer
+	assert.Positive(t, *polls)
+	assert.LessOrEqual(t, *polls, 3)
+}
+
+func Test_DeviceFlow_PollTokenHonorsContext(t *testing.T) {
+	flow, polls := newTestFlow(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := flow.PollToken(ctx, &DeviceCode{DeviceCode: "device-code", Interval: 1000})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, *polls)
+}
@@ -0,0 +1,1222 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultWorkflowRunWaitTimeout = 300
+	maxWorkflowRunWaitTimeout     = 1800
+)
+
+// workflowRunPollInterval and workflowRunMaxPollInterval bound the backoff wait_for_workflow_run uses
+// between two checks of a run, overridden in tests.
+var (
+	workflowRunPollInterval    = 5 * time.Second
+	workflowRunMaxPollInterval = 30 * time.Second
+)
+
+// failingJob is a job of a workflow run that did not succeed, with the steps that failed in it.
+type failingJob struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Conclusion  string   `json:"conclusion"`
+	HTMLURL     string   `json:"html_url"`
+	FailedSteps []string `json:"failed_steps"`
+}
+
+// workflowRunResult is the result of wait_for_workflow_run.
+type workflowRunResult struct {
+	RunID       int64        `json:"run_id"`
+	Status      string       `json:"status"`
+	Conclusion  string       `json:"conclusion,omitempty"`
+	HTMLURL     string       `json:"html_url"`
+	TimedOut    bool         `json:"timed_out"`
+	FailingJobs []failingJob `json:"failing_jobs,omitempty"`
+}
+
+// isFailedConclusion reports whether a job or step conclusion means it did not succeed.
+// Skipped and neutral jobs do not fail a run, so they are not reported.
+func isFailedConclusion(conclusion string) bool {
+	switch conclusion {
+	case "failure", "timed_out", "cancelled", "startup_failure", "action_required":
+		return true
+	}
+	return false
+}
+
+// listFailingJobs lists the jobs of the latest attempt of a run that did not succeed.
+func listFailingJobs(ctx context.Context, client *github.Client, owner, repo string, runID int64) ([]failingJob, error) {
+	opts := &github.ListWorkflowJobsOptions{
+		Filter:      "latest",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	failing := []failingJob{}
+	for {
+		jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflow jobs: %w", err)
+		}
+		_ = resp.Body.Close()
+
+		for _, job := range jobs.Jobs {
+			if !isFailedConclusion(job.GetConclusion()) {
+				continue
+			}
+			steps := []string{}
+			for _, step := range job.Steps {
+				if isFailedConclusion(step.GetConclusion()) {
+					steps = append(steps, step.GetName())
+				}
+			}
+			failing = append(failing, failingJob{
+				ID:          job.GetID(),
+				Name:        job.GetName(),
+				Conclusion:  job.GetConclusion(),
+				HTMLURL:     job.GetHTMLURL(),
+				FailedSteps: steps,
+			})
+		}
+
+		if resp.NextPage == 0 {
+			return failing, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// WaitForWorkflowRun creates a tool to wait until a workflow run completes.
+func WaitForWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("wait_for_workflow_run",
+			mcp.WithDescription(t("TOOL_WAIT_FOR_WORKFLOW_RUN_DESCRIPTION", "Wait for a GitHub Actions workflow run to complete, then return its conclusion and the jobs that failed. Returns with timed_out set if the run is still going when the timeout elapses")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_WAIT_FOR_WORKFLOW_RUN_USER_TITLE", "Wait for workflow run"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workflow run"),
+			),
+			mcp.WithNumber("timeout",
+				mcp.Description(fmt.Sprintf("Maximum number of seconds to wait (default %d, max %d)", defaultWorkflowRunWaitTimeout, maxWorkflowRunWaitTimeout)),
+				mcp.Min(1),
+				mcp.Max(maxWorkflowRunWaitTimeout),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			timeout, err := OptionalIntParamWithDefault(request, "timeout", defaultWorkflowRunWaitTimeout)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if timeout < 1 || timeout > maxWorkflowRunWaitTimeout {
+				return mcp.NewToolResultError(fmt.Sprintf("timeout must be between 1 and %d seconds", maxWorkflowRunWaitTimeout)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Stop at whichever comes first of the timeout and the request's own deadline, so there
+			// is still time left to report where the run got to
+			deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+			if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+				deadline = ctxDeadline
+			}
+
+			interval := workflowRunPollInterval
+			var last *workflowRunResult
+			for {
+				run, resp, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, int64(runID))
+				if err != nil {
+					// The request's deadline can pass while a poll is in flight, report the last status seen
+					if last != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+						last.TimedOut = true
+						return marshalWorkflowRunResult(*last)
+					}
+					return nil, fmt.Errorf("failed to get workflow run: %w", err)
+				}
+				_ = resp.Body.Close()
+
+				result := workflowRunResult{
+					RunID:   run.GetID(),
+					Status:  run.GetStatus(),
+					HTMLURL: run.GetHTMLURL(),
+				}
+
+				if run.GetStatus() == "completed" {
+					result.Conclusion = run.GetConclusion()
+					if isFailedConclusion(run.GetConclusion()) {
+						result.FailingJobs, err = listFailingJobs(ctx, client, owner, repo, int64(runID))
+						if err != nil {
+							return nil, err
+						}
+					}
+					return marshalWorkflowRunResult(result)
+				}
+
+				wait := interval
+				if remaining := time.Until(deadline); wait > remaining {
+					wait = remaining
+				}
+				select {
+				case <-ctx.Done():
+					if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+						return nil, ctx.Err()
+					}
+					result.TimedOut = true
+					return marshalWorkflowRunResult(result)
+				case <-time.After(wait):
+				}
+				if !time.Now().Before(deadline) {
+					result.TimedOut = true
+					return marshalWorkflowRunResult(result)
+				}
+				last = &result
+				interval *= 2
+				if interval > workflowRunMaxPollInterval {
+					interval = workflowRunMaxPollInterval
+				}
+			}
+		}
+}
+
+// marshalWorkflowRunResult returns the result of wait_for_workflow_run as a tool result.
+func marshalWorkflowRunResult(result workflowRunResult) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// runnerUsage is the billable time of a workflow or run on one runner OS.
+type runnerUsage struct {
+	OS              string `json:"os"`
+	BillableMS      int64  `json:"billable_ms"`
+	BillableMinutes int64  `json:"billable_minutes"`
+	Jobs            int    `json:"jobs,omitempty"`
+}
+
+// workflowUsageResult is the result of get_workflow_run_usage and get_workflow_usage.
+type workflowUsageResult struct {
+	RunDurationMS        int64         `json:"run_duration_ms,omitempty"`
+	TotalBillableMinutes int64         `json:"total_billable_minutes"`
+	Billable             []runnerUsage `json:"billable"`
+}
+
+// billableMinutes converts billable milliseconds to minutes, rounded up.
+func billableMinutes(ms int64) int64 {
+	return (ms + time.Minute.Milliseconds() - 1) / time.Minute.Milliseconds()
+}
+
+// runBillableMinutes returns the billable minutes of a run on one runner OS. Actions rounds each job
+// up to the minute, so the jobs are rounded one by one when the run lists them, and the total
+// otherwise, which can come out lower.
+func runBillableMinutes(bill *github.WorkflowRunBill) int64 {
+	if len(bill.JobRuns) == 0 {
+		return billableMinutes(bill.GetTotalMS())
+	}
+	var minutes int64
+	for _, job := range bill.JobRuns {
+		minutes += billableMinutes(job.GetDurationMS())
+	}
+	return minutes
+}
+
+// summarizeRunUsage breaks the billable time of a run down by runner OS, sorted by OS.
+func summarizeRunUsage(usage *github.WorkflowRunUsage) workflowUsageResult {
+	result := workflowUsageResult{
+		RunDurationMS: usage.GetRunDurationMS(),
+		Billable:      []runnerUsage{},
+	}
+	if usage.Billable != nil {
+		for runnerOS, bill := range *usage.Billable {
+			result.Billable = append(result.Billable, runnerUsage{
+				OS:              runnerOS,
+				BillableMS:      bill.GetTotalMS(),
+				BillableMinutes: runBillableMinutes(bill),
+				Jobs:            bill.GetJobs(),
+			})
+		}
+	}
+	return totalRunnerUsage(result)
+}
+
+// summarizeWorkflowUsage breaks the billable time of a workflow in the current billing cycle down by runner OS, sorted by OS.
+// The usage has no jobs, so the minutes are an approximation: the total of each OS is rounded up once.
+func summarizeWorkflowUsage(usage *github.WorkflowUsage) workflowUsageResult {
+	result := workflowUsageResult{Billable: []runnerUsage{}}
+	if usage.Billable != nil {
+		for runnerOS, bill := range *usage.Billable {
+			result.Billable = append(result.Billable, runnerUsage{
+				OS:              runnerOS,
+				BillableMS:      bill.GetTotalMS(),
+				BillableMinutes: billableMinutes(bill.GetTotalMS()),
+			})
+		}
+	}
+	return totalRunnerUsage(result)
+}
+
+// totalRunnerUsage sorts the per-OS usage of result and sums up its billable minutes.
+func totalRunnerUsage(result workflowUsageResult) workflowUsageResult {
+	sort.Slice(result.Billable, func(i, j int) bool { return result.Billable[i].OS < result.Billable[j].OS })
+	for _, usage := range result.Billable {
+		result.TotalBillableMinutes += usage.BillableMinutes
+	}
+	return result
+}
+
+// GetWorkflowRunUsage creates a tool to get the billable time of a workflow run.
+func GetWorkflowRunUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_run_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_RUN_USAGE_DESCRIPTION", "Get the billable minutes of a GitHub Actions workflow run broken down by runner OS, and the total run duration. Minutes are rounded up per job, like Actions bills them")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WORKFLOW_RUN_USAGE_USER_TITLE", "Get workflow run usage"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			usage, resp, err := client.Actions.GetWorkflowRunUsageByID(ctx, owner, repo, int64(runID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get workflow run usage: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(summarizeRunUsage(usage))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// workflowRef identifies a workflow either by ID or by the file name of its definition.
+type workflowRef struct {
+	id       int64
+	fileName string
+}
+
+// parseWorkflowRef interprets a workflow parameter as an ID when it is numeric, as a file name otherwise.
+func parseWorkflowRef(workflow string) workflowRef {
+	if id, err := strconv.ParseInt(workflow, 10, 64); err == nil {
+		return workflowRef{id: id}
+	}
+	return workflowRef{fileName: path.Base(workflow)}
+}
+
+// withWorkflow adds the workflow parameter identifying a workflow by ID or file name.
+func withWorkflow() mcp.ToolOption {
+	return mcp.WithString("workflow",
+		mcp.Required(),
+		mcp.Description("The workflow ID or the file name of the workflow, e.g. ci.yml"),
+	)
+}
+
+// GetWorkflowUsage creates a tool to get the billable time of a workflow in the current billing cycle.
+func GetWorkflowUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_USAGE_DESCRIPTION", "Get the billable minutes a GitHub Actions workflow used in the current billing cycle, broken down by runner OS. Minutes are approximate, rounded up per runner OS rather than per job")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WORKFLOW_USAGE_USER_TITLE", "Get workflow usage"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			withWorkflow(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflow, err := requiredParam[string](request, "workflow")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ref := parseWorkflowRef(workflow)
+			var usage *github.WorkflowUsage
+			var resp *github.Response
+			if ref.fileName != "" {
+				usage, resp, err = client.Actions.GetWorkflowUsageByFileName(ctx, owner, repo, ref.fileName)
+			} else {
+				usage, resp, err = client.Actions.GetWorkflowUsageByID(ctx, owner, repo, ref.id)
+			}
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("workflow %s not found in %s/%s", workflow, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get workflow usage: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(summarizeWorkflowUsage(usage))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// workflowState is the confirmation returned by enable_workflow and disable_workflow.
+type workflowState struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	State string `json:"state"`
+}
+
+// setWorkflowEnabledHandler returns the handler shared by enable_workflow and disable_workflow. The
+// workflow is resolved first so an unknown workflow gets a clear error and the confirmation names it.
+func setWorkflowEnabledHandler(getClient GetClientFn, enable bool) server.ToolHandlerFunc {
+	action, state := "disable", "disabled_manually"
+	if enable {
+		action, state = "enable", "active"
+	}
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		owner, err := requiredParam[string](request, "owner")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		repo, err := requiredParam[string](request, "repo")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		workflowParam, err := requiredParam[string](request, "workflow")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+
+		ref := parseWorkflowRef(workflowParam)
+		var workflow *github.Workflow
+		var resp *github.Response
+		if ref.fileName != "" {
+			workflow, resp, err = client.Actions.GetWorkflowByFileName(ctx, owner, repo, ref.fileName)
+		} else {
+			workflow, resp, err = client.Actions.GetWorkflowByID(ctx, owner, repo, ref.id)
+		}
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("workflow %s not found in %s/%s", workflowParam, owner, repo)), nil
+			}
+			return nil, fmt.Errorf("failed to get workflow: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if isDryRun(ctx) {
+			return dryRunResult("%s workflow %s in %s/%s", action, workflow.GetName(), owner, repo), nil
+		}
+		if enable {
+			resp, err = client.Actions.EnableWorkflowByID(ctx, owner, repo, workflow.GetID())
+		} else {
+			resp, err = client.Actions.DisableWorkflowByID(ctx, owner, repo, workflow.GetID())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to %s workflow: %w", action, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusNoContent {
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("failed to %s workflow: %s", action, string(body))), nil
+		}
+
+		r, err := json.Marshal(workflowState{
+			ID:    workflow.GetID(),
+			Name:  workflow.GetName(),
+			Path:  workflow.GetPath(),
+			State: state,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return mcp.NewToolResultText(string(r)), nil
+	}
+}
+
+// EnableWorkflow creates a tool to enable a workflow.
+func EnableWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("enable_workflow",
+			mcp.WithDescription(t("TOOL_ENABLE_WORKFLOW_DESCRIPTION", "Enable a GitHub Actions workflow that was disabled, so it runs on its triggers again")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ENABLE_WORKFLOW_USER_TITLE", "Enable workflow"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			withWorkflow(),
+		),
+		setWorkflowEnabledHandler(getClient, true)
+}
+
+// DisableWorkflow creates a tool to disable a workflow.
+func DisableWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("disable_workflow",
+			mcp.WithDescription(t("TOOL_DISABLE_WORKFLOW_DESCRIPTION", "Disable a GitHub Actions workflow so it no longer runs on its triggers, without editing its definition")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DISABLE_WORKFLOW_USER_TITLE", "Disable workflow"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			withWorkflow(),
+		),
+		setWorkflowEnabledHandler(getClient, false)
+}
+
+// runnerSummary is the compact form of a self-hosted runner returned by list_self_hosted_runners.
+type runnerSummary struct {
+	ID     int64    `json:"id"`
+	Name   string   `json:"name"`
+	OS     string   `json:"os"`
+	Status string   `json:"status"`
+	Busy   bool     `json:"busy"`
+	Labels []string `json:"labels"`
+}
+
+// runnersResult is the result of list_self_hosted_runners. TotalCount is the number of runners
+// reported by the API, before the status filter.
+type runnersResult struct {
+	TotalCount int             `json:"total_count"`
+	Runners    []runnerSummary `json:"runners"`
+}
+
+// summarizeRunners converts runners to their compact form, keeping only those with the given
+// status when it is not empty.
+func summarizeRunners(runners []*github.Runner, status string) []runnerSummary {
+	summaries := []runnerSummary{}
+	for _, runner := range runners {
+		if status != "" && runner.GetStatus() != status {
+			continue
+		}
+		labels := make([]string, 0, len(runner.Labels))
+		for _, label := range runner.Labels {
+			labels = append(labels, label.GetName())
+		}
+		summaries = append(summaries, runnerSummary{
+			ID:     runner.GetID(),
+			Name:   runner.GetName(),
+			OS:     runner.GetOS(),
+			Status: runner.GetStatus(),
+			Busy:   runner.GetBusy(),
+			Labels: labels,
+		})
+	}
+	return summaries
+}
+
+// ListSelfHostedRunners creates a tool to list the self-hosted runners of a repository or an organization.
+func ListSelfHostedRunners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_self_hosted_runners",
+			mcp.WithDescription(t("TOOL_LIST_SELF_HOSTED_RUNNERS_DESCRIPTION", "List the self-hosted runners of a repository, or of an organization when repo is omitted, with their status, busy flag and labels. The status filter applies to the returned page")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_SELF_HOSTED_RUNNERS_USER_TITLE", "List self-hosted runners"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner, or the organization name when repo is omitted"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name, omit to list the runners of the organization"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Only list runners with this status"),
+				mcp.Enum("online", "offline"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch status {
+			case "", "online", "offline":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid status: %s, must be online or offline", status)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListRunnersOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			var runners *github.Runners
+			var resp *github.Response
+			if repo != "" {
+				runners, resp, err = client.Actions.ListRunners(ctx, owner, repo, opts)
+			} else {
+				runners, resp, err = client.Actions.ListOrganizationRunners(ctx, owner, opts)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to list self-hosted runners: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list self-hosted runners: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(runnersResult{
+				TotalCount: runners.TotalCount,
+				Runners:    summarizeRunners(runners.Runners, status),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// pendingDeploymentSummary is the compact form of a deployment waiting for review returned by get_pending_deployments.
+type pendingDeploymentSummary struct {
+	EnvironmentID         int64    `json:"environment_id"`
+	Environment           string   `json:"environment"`
+	WaitTimer             int64    `json:"wait_timer"`
+	CurrentUserCanApprove bool     `json:"current_user_can_approve"`
+	Reviewers             []string `json:"reviewers"`
+}
+
+// summarizePendingDeployments converts pending deployments to their compact form. Reviewers are
+// user logins, or team slugs prefixed with "team:".
+func summarizePendingDeployments(deployments []*github.PendingDeployment) []pendingDeploymentSummary {
+	summaries := make([]pendingDeploymentSummary, 0, len(deployments))
+	for _, deployment := range deployments {
+		reviewers := []string{}
+		for _, reviewer := range deployment.Reviewers {
+			switch r := reviewer.Reviewer.(type) {
+			case *github.User:
+				reviewers = append(reviewers, r.GetLogin())
+			case *github.Team:
+				reviewers = append(reviewers, "team:"+r.GetSlug())
+			}
+		}
+		summaries = append(summaries, pendingDeploymentSummary{
+			EnvironmentID:         deployment.GetEnvironment().GetID(),
+			Environment:           deployment.GetEnvironment().GetName(),
+			WaitTimer:             deployment.GetWaitTimer(),
+			CurrentUserCanApprove: deployment.GetCurrentUserCanApprove(),
+			Reviewers:             reviewers,
+		})
+	}
+	return summaries
+}
+
+// GetPendingDeployments creates a tool to list the deployments of a workflow run waiting for review.
+func GetPendingDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pending_deployments",
+			mcp.WithDescription(t("TOOL_GET_PENDING_DEPLOYMENTS_DESCRIPTION", "Get the deployments of a workflow run that are waiting for a required reviewer to approve them, with their environment IDs and reviewers")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PENDING_DEPLOYMENTS_USER_TITLE", "Get pending deployments"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workflow run"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			deployments, resp, err := client.Actions.GetPendingDeployments(ctx, owner, repo, int64(runID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pending deployments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pending deployments: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizePendingDeployments(deployments))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// reviewedDeployment is the compact form of a deployment returned by review_pending_deployments.
+type reviewedDeployment struct {
+	ID          int64  `json:"id"`
+	Environment string `json:"environment"`
+	Ref         string `json:"ref"`
+	SHA         string `json:"sha"`
+}
+
+// ReviewPendingDeployments creates a tool to approve or reject the pending deployments of a workflow run.
+func ReviewPendingDeployments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("review_pending_deployments",
+			mcp.WithDescription(t("TOOL_REVIEW_PENDING_DEPLOYMENTS_DESCRIPTION", "Approve or reject the deployments of a workflow run waiting for review in the given environments. Use get_pending_deployments to find the environment IDs")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REVIEW_PENDING_DEPLOYMENTS_USER_TITLE", "Review pending deployments"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workflow run"),
+			),
+			mcp.WithArray("environment_ids",
+				mcp.Required(),
+				mcp.Description("IDs of the environments to approve or reject, they must be pending for the run"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+			mcp.WithString("state",
+				mcp.Required(),
+				mcp.Description("Whether to approve or reject the deployments"),
+				mcp.Enum("approved", "rejected"),
+			),
+			mcp.WithString("comment",
+				mcp.Required(),
+				mcp.Description("Comment to go along with the review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runID, err := RequiredInt(request, "run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environmentIDs, err := OptionalIntArrayParam(request, "environment_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(environmentIDs) == 0 {
+				return mcp.NewToolResultError("missing required parameter: environment_ids"), nil
+			}
+			state, err := requiredParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if state != "approved" && state != "rejected" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state: %s, must be approved or rejected", state)), nil
+			}
+			comment, err := requiredParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Check the environments against the pending set first, the API rejects the whole review
+			// with an unhelpful message if any of them is not pending
+			pending, resp, err := client.Actions.GetPendingDeployments(ctx, owner, repo, int64(runID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pending deployments: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			if len(pending) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("workflow run %d has no deployments waiting for review", runID)), nil
+			}
+			pendingIDs := make(map[int64]bool, len(pending))
+			pendingNames := make([]string, 0, len(pending))
+			for _, deployment := range pending {
+				environment := deployment.GetEnvironment()
+				pendingIDs[environment.GetID()] = true
+				pendingNames = append(pendingNames, fmt.Sprintf("%s (%d)", environment.GetName(), environment.GetID()))
+			}
+			ids := make([]int64, 0, len(environmentIDs))
+			for _, id := range environmentIDs {
+				if !pendingIDs[int64(id)] {
+					return mcp.NewToolResultError(fmt.Sprintf("environment %d is not waiting for review in workflow run %d, pending environments: %s", id, runID, strings.Join(pendingNames, ", "))), nil
+				}
+				ids = append(ids, int64(id))
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("review the deployments of workflow run %d for environments %v as %s", runID, ids, state), nil
+			}
+			deployments, resp, err := client.Actions.PendingDeployments(ctx, owner, repo, int64(runID), &github.PendingDeploymentsRequest{
+				EnvironmentIDs: ids,
+				State:          state,
+				Comment:        comment,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to review pending deployments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to review pending deployments: %s", string(body))), nil
+			}
+
+			reviewed := make([]reviewedDeployment, 0, len(deployments))
+			for _, deployment := range deployments {
+				reviewed = append(reviewed, reviewedDeployment{
+					ID:          deployment.GetID(),
+					Environment: deployment.GetEnvironment(),
+					Ref:         deployment.GetRef(),
+					SHA:         deployment.GetSHA(),
+				})
+			}
+
+			r, err := json.Marshal(reviewed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// checkRunAnnotation is the compact form of an annotation a check run left on a line of a file.
+type checkRunAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line,omitempty"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+// summarizeCheckRunAnnotation converts a check run annotation to its compact form. The end line is
+// left out when the annotation is on a single line.
+func summarizeCheckRunAnnotation(annotation *github.CheckRunAnnotation) checkRunAnnotation {
+	summary := checkRunAnnotation{
+		Path:            annotation.GetPath(),
+		StartLine:       annotation.GetStartLine(),
+		AnnotationLevel: annotation.GetAnnotationLevel(),
+		Title:           annotation.GetTitle(),
+		Message:         annotation.GetMessage(),
+	}
+	if annotation.GetEndLine() != summary.StartLine {
+		summary.EndLine = annotation.GetEndLine()
+	}
+	return summary
+}
+
+// ListCheckRunAnnotations creates a tool to list the annotations of a check run.
+func ListCheckRunAnnotations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_check_run_annotations",
+			mcp.WithDescription(t("TOOL_LIST_CHECK_RUN_ANNOTATIONS_DESCRIPTION", "List the annotations a check run left on lines of files, such as the lint warnings and test failures behind its conclusion")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CHECK_RUN_ANNOTATIONS_USER_TITLE", "List check run annotations"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("check_run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the check run"),
+			),
+			mcp.WithString("annotation_level",
+				mcp.Description("Only list the annotations of this level"),
+				mcp.Enum("notice", "warning", "failure"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkRunID, err := RequiredInt(request, "check_run_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			level, err := OptionalParam[string](request, "annotation_level")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			annotations, resp, err := client.Checks.ListCheckRunAnnotations(ctx, owner, repo, int64(checkRunID), &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("check run %d not found in %s/%s", checkRunID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list check run annotations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list check run annotations: %s", string(body))), nil
+			}
+
+			summaries := make([]checkRunAnnotation, 0, len(annotations))
+			for _, annotation := range annotations {
+				if level != "" && annotation.GetAnnotationLevel() != level {
+					continue
+				}
+				summaries = append(summaries, summarizeCheckRunAnnotation(annotation))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// orgActionsPermissions is the Actions policy of an organization. SelectedActions lists the actions
+// allowed when only selected actions are.
+type orgActionsPermissions struct {
+	EnabledRepositories string                 `json:"enabled_repositories"`
+	AllowedActions      string                 `json:"allowed_actions,omitempty"`
+	SelectedActions     *github.ActionsAllowed `json:"selected_actions,omitempty"`
+}
+
+// GetOrgActionsPermissions creates a tool to get the Actions policy of an organization.
+func GetOrgActionsPermissions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_org_actions_permissions",
+			mcp.WithDescription(t("TOOL_GET_ORG_ACTIONS_PERMISSIONS_DESCRIPTION", "Get the GitHub Actions policy of an organization: which repositories can run Actions, and which actions and reusable workflows they can use")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORG_ACTIONS_PERMISSIONS_USER_TITLE", "Get organization Actions permissions"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			permissions, resp, err := client.Actions.GetActionsPermissions(ctx, org)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("organization %s not found", org)), nil
+				}
+				return nil, fmt.Errorf("failed to get organization Actions permissions: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get organization Actions permissions: %s", string(body))), nil
+			}
+
+			result := orgActionsPermissions{
+				EnabledRepositories: permissions.GetEnabledRepositories(),
+				AllowedActions:      permissions.GetAllowedActions(),
+			}
+			if result.AllowedActions == "selected" {
+				allowed, resp, err := client.Actions.GetActionsAllowed(ctx, org)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get the selected actions: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+				result.SelectedActions = allowed
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SetOrgActionsPermissions creates a tool to set the Actions policy of an organization.
+func SetOrgActionsPermissions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_org_actions_permissions",
+			mcp.WithDescription(t("TOOL_SET_ORG_ACTIONS_PERMISSIONS_DESCRIPTION", "Set the GitHub Actions policy of an organization: which repositories can run Actions, and which actions and reusable workflows they can use")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_ORG_ACTIONS_PERMISSIONS_USER_TITLE", "Set organization Actions permissions"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("enabled_repositories",
+				mcp.Required(),
+				mcp.Description("Repositories that can run Actions: all, none, or the selected ones"),
+				mcp.Enum("all", "none", "selected"),
+			),
+			mcp.WithString("allowed_actions",
+				mcp.Description("Actions the repositories can use: all, local_only for those of the organization, or the selected ones. Keeps the current policy when omitted"),
+				mcp.Enum("all", "local_only", "selected"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enabledRepositories, err := requiredParam[string](request, "enabled_repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			allowedActions, err := OptionalParam[string](request, "allowed_actions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch enabledRepositories {
+			case "all", "none", "selected":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid enabled_repositories: %s, must be one of all, none or selected", enabledRepositories)), nil
+			}
+			switch allowedActions {
+			case "", "all", "local_only", "selected":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid allowed_actions: %s, must be one of all, local_only or selected", allowedActions)), nil
+			}
+			if enabledRepositories == "none" && allowedActions != "" {
+				return mcp.NewToolResultError("allowed_actions cannot be set when enabled_repositories is none"), nil
+			}
+
+			permissions := github.ActionsPermissions{EnabledRepositories: github.Ptr(enabledRepositories)}
+			policy := fmt.Sprintf("enabled repositories %s", enabledRepositories)
+			if allowedActions != "" {
+				permissions.AllowedActions = github.Ptr(allowedActions)
+				policy += fmt.Sprintf(", allowed actions %s", allowedActions)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("set the Actions permissions of %s to %s", org, policy), nil
+			}
+			_, resp, err := client.Actions.EditActionsPermissions(ctx, org, permissions)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("organization %s not found", org)), nil
+				}
+				return nil, fmt.Errorf("failed to set organization Actions permissions: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set organization Actions permissions: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(orgActionsPermissions{
+				EnabledRepositories: enabledRepositories,
+				AllowedActions:      allowedActions,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,1392 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WaitForWorkflowRun(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := WaitForWorkflowRun(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "wait_for_workflow_run", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "timeout")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	originalInterval, originalMaxInterval := workflowRunPollInterval, workflowRunMaxPollInterval
+	workflowRunPollInterval, workflowRunMaxPollInterval = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() {
+		workflowRunPollInterval, workflowRunMaxPollInterval = originalInterval, originalMaxInterval
+	})
+
+	run := func(status, conclusion string) *github.WorkflowRun {
+		r := &github.WorkflowRun{
+			ID:      github.Ptr(int64(42)),
+			Status:  github.Ptr(status),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/actions/runs/42"),
+		}
+		if conclusion != "" {
+			r.Conclusion = github.Ptr(conclusion)
+		}
+		return r
+	}
+	mockJobs := &github.Jobs{
+		TotalCount: github.Ptr(3),
+		Jobs: []*github.WorkflowJob{
+			{
+				ID:         github.Ptr(int64(1)),
+				Name:       github.Ptr("build"),
+				Conclusion: github.Ptr("success"),
+			},
+			{
+				ID:         github.Ptr(int64(2)),
+				Name:       github.Ptr("test"),
+				Conclusion: github.Ptr("failure"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/42/job/2"),
+				Steps: []*github.TaskStep{
+					{Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success")},
+					{Name: github.Ptr("Run tests"), Conclusion: github.Ptr("failure")},
+				},
+			},
+			{
+				ID:         github.Ptr(int64(3)),
+				Name:       github.Ptr("lint"),
+				Conclusion: github.Ptr("skipped"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		ctxTimeout     time.Duration
+		expectError    bool
+		expectedResult workflowRunResult
+		expectedErrMsg string
+	}{
+		{
+			name: "in progress run completes successfully",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					run("queued", ""),
+					run("in_progress", ""),
+					run("completed", "success"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError: false,
+			expectedResult: workflowRunResult{
+				RunID:      42,
+				Status:     "completed",
+				Conclusion: "success",
+				HTMLURL:    "https://github.com/owner/repo/actions/runs/42",
+			},
+		},
+		{
+			name: "in progress run fails with failing jobs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					run("in_progress", ""),
+					run("completed", "failure"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"filter":   "latest",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockJobs),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			expectError: false,
+			expectedResult: workflowRunResult{
+				RunID:      42,
+				Status:     "completed",
+				Conclusion: "failure",
+				HTMLURL:    "https://github.com/owner/repo/actions/runs/42",
+				FailingJobs: []failingJob{
+					{
+						ID:          2,
+						Name:        "test",
+						Conclusion:  "failure",
+						HTMLURL:     "https://github.com/owner/repo/actions/runs/42/job/2",
+						FailedSteps: []string{"Run tests"},
+					},
+				},
+			},
+		},
+		{
+			name: "context deadline returns timeout result",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusOK, run("in_progress", "")),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(42),
+			},
+			ctxTimeout:  50 * time.Millisecond,
+			expectError: false,
+			expectedResult: workflowRunResult{
+				RunID:    42,
+				Status:   "in_progress",
+				HTMLURL:  "https://github.com/owner/repo/actions/runs/42",
+				TimedOut: true,
+			},
+		},
+		{
+			name: "run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"run_id": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get workflow run",
+		},
+		{
+			name:         "timeout out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"run_id":  float64(42),
+				"timeout": float64(3600),
+			},
+			expectError:    false,
+			expectedErrMsg: "timeout must be between 1 and 1800 seconds",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := WaitForWorkflowRun(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			ctx := context.Background()
+			if tc.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.ctxTimeout)
+				defer cancel()
+			}
+
+			// Call handler
+			result, err := handler(ctx, request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned workflowRunResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_SummarizeRunUsage(t *testing.T) {
+	usage := &github.WorkflowRunUsage{
+		RunDurationMS: github.Ptr(int64(500000)),
+		Billable: &github.WorkflowRunBillMap{
+			"WINDOWS": {TotalMS: github.Ptr(int64(60000)), Jobs: github.Ptr(1)},
+			"UBUNTU":  {TotalMS: github.Ptr(int64(180001)), Jobs: github.Ptr(3)},
+			"MACOS":   {TotalMS: github.Ptr(int64(0)), Jobs: github.Ptr(0)},
+		},
+	}
+
+	assert.Equal(t, workflowUsageResult{
+		RunDurationMS:        500000,
+		TotalBillableMinutes: 5,
+		Billable: []runnerUsage{
+			{OS: "MACOS", BillableMS: 0, BillableMinutes: 0},
+			{OS: "UBUNTU", BillableMS: 180001, BillableMinutes: 4, Jobs: 3},
+			{OS: "WINDOWS", BillableMS: 60000, BillableMinutes: 1, Jobs: 1},
+		},
+	}, summarizeRunUsage(usage))
+
+	assert.Equal(t, workflowUsageResult{Billable: []runnerUsage{}}, summarizeRunUsage(&github.WorkflowRunUsage{}))
+
+	// Each job is rounded up on its own when the run lists its jobs
+	withJobs := &github.WorkflowRunUsage{
+		Billable: &github.WorkflowRunBillMap{
+			"UBUNTU": {
+				TotalMS: github.Ptr(int64(90000)),
+				Jobs:    github.Ptr(3),
+				JobRuns: []*github.WorkflowRunJobRun{
+					{JobID: github.Ptr(1), DurationMS: github.Ptr(int64(30000))},
+					{JobID: github.Ptr(2), DurationMS: github.Ptr(int64(30000))},
+					{JobID: github.Ptr(3), DurationMS: github.Ptr(int64(30000))},
+				},
+			},
+		},
+	}
+	assert.Equal(t, workflowUsageResult{
+		TotalBillableMinutes: 3,
+		Billable: []runnerUsage{
+			{OS: "UBUNTU", BillableMS: 90000, BillableMinutes: 3, Jobs: 3},
+		},
+	}, summarizeRunUsage(withJobs))
+}
+
+func Test_GetWorkflowRunUsage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowRunUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_run_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposActionsRunsTimingByOwnerByRepoByRunId,
+			&github.WorkflowRunUsage{
+				RunDurationMS: github.Ptr(int64(120000)),
+				Billable: &github.WorkflowRunBillMap{
+					"UBUNTU": {TotalMS: github.Ptr(int64(90000)), Jobs: github.Ptr(2)},
+				},
+			},
+		),
+	))
+	_, handler := GetWorkflowRunUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"run_id": float64(42),
+	}))
+	require.NoError(t, err)
+
+	var returned workflowUsageResult
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, workflowUsageResult{
+		RunDurationMS:        120000,
+		TotalBillableMinutes: 2,
+		Billable:             []runnerUsage{{OS: "UBUNTU", BillableMS: 90000, BillableMinutes: 2, Jobs: 2}},
+	}, returned)
+}
+
+func Test_GetWorkflowUsage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_workflow_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "workflow")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflow"})
+
+	mockUsage := &github.WorkflowUsage{
+		Billable: &github.WorkflowBillMap{
+			"UBUNTU": {TotalMS: github.Ptr(int64(600000))},
+			"MACOS":  {TotalMS: github.Ptr(int64(30000))},
+		},
+	}
+	expectedUsage := workflowUsageResult{
+		TotalBillableMinutes: 11,
+		Billable: []runnerUsage{
+			{OS: "MACOS", BillableMS: 30000, BillableMinutes: 1},
+			{OS: "UBUNTU", BillableMS: 600000, BillableMinutes: 10},
+		},
+	}
+	usageAt := func(expectedPath string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, expectedPath, r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(mockUsage)
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedUsage  workflowUsageResult
+		expectedErrMsg string
+	}{
+		{
+			name:         "by workflow ID",
+			mockedClient: mock.NewMockedHTTPClient(usageAt("/repos/owner/repo/actions/workflows/161335/timing")),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "161335",
+			},
+			expectedUsage: expectedUsage,
+		},
+		{
+			name:         "by workflow file name",
+			mockedClient: mock.NewMockedHTTPClient(usageAt("/repos/owner/repo/actions/workflows/ci.yml/timing")),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": ".github/workflows/ci.yml",
+			},
+			expectedUsage: expectedUsage,
+		},
+		{
+			name: "workflow not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsTimingByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "missing.yml",
+			},
+			expectedErrMsg: "workflow missing.yml not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned workflowUsageResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedUsage, returned)
+		})
+	}
+}
+
+func Test_EnableWorkflow(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := EnableWorkflow(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "enable_workflow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "workflow")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflow"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockWorkflow := &github.Workflow{
+		ID:    github.Ptr(int64(161335)),
+		Name:  github.Ptr("CI"),
+		Path:  github.Ptr(".github/workflows/ci.yml"),
+		State: github.Ptr("disabled_manually"),
+	}
+	workflowAt := func(expectedPath string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetReposActionsWorkflowsByOwnerByRepoByWorkflowId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, expectedPath, r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(mockWorkflow)
+			}),
+		)
+	}
+	enabled := mock.WithRequestMatchHandler(
+		mock.PutReposActionsWorkflowsEnableByOwnerByRepoByWorkflowId,
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/repos/owner/repo/actions/workflows/161335/enable", r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+	expectedState := workflowState{
+		ID:    161335,
+		Name:  "CI",
+		Path:  ".github/workflows/ci.yml",
+		State: "active",
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedState  workflowState
+		expectedErrMsg string
+	}{
+		{
+			name: "by workflow ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				workflowAt("/repos/owner/repo/actions/workflows/161335"),
+				enabled,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "161335",
+			},
+			expectedState: expectedState,
+		},
+		{
+			name: "by workflow file name",
+			mockedClient: mock.NewMockedHTTPClient(
+				workflowAt("/repos/owner/repo/actions/workflows/ci.yml"),
+				enabled,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": ".github/workflows/ci.yml",
+			},
+			expectedState: expectedState,
+		},
+		{
+			name: "workflow not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "missing.yml",
+			},
+			expectedErrMsg: "workflow missing.yml not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := EnableWorkflow(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned workflowState
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, returned)
+		})
+	}
+}
+
+func Test_DisableWorkflow(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DisableWorkflow(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "disable_workflow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "workflow"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockWorkflow := &github.Workflow{
+		ID:    github.Ptr(int64(161335)),
+		Name:  github.Ptr("CI"),
+		Path:  github.Ptr(".github/workflows/ci.yml"),
+		State: github.Ptr("active"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedState  workflowState
+		expectedErrMsg string
+	}{
+		{
+			name: "disables the resolved workflow",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsWorkflowsByOwnerByRepoByWorkflowId,
+					mockWorkflow,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsWorkflowsDisableByOwnerByRepoByWorkflowId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/actions/workflows/161335/disable", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "ci.yml",
+			},
+			expectedState: workflowState{
+				ID:    161335,
+				Name:  "CI",
+				Path:  ".github/workflows/ci.yml",
+				State: "disabled_manually",
+			},
+		},
+		{
+			name: "disable fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposActionsWorkflowsByOwnerByRepoByWorkflowId,
+					mockWorkflow,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposActionsWorkflowsDisableByOwnerByRepoByWorkflowId,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"workflow": "ci.yml",
+			},
+			expectedErrMsg: "failed to disable workflow",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DisableWorkflow(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returned workflowState
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, returned)
+		})
+	}
+}
+
+func Test_ListSelfHostedRunners(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSelfHostedRunners(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_self_hosted_runners", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "status")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	mockRunners := &github.Runners{
+		TotalCount: 2,
+		Runners: []*github.Runner{
+			{
+				ID:     github.Ptr(int64(1)),
+				Name:   github.Ptr("build-linux-1"),
+				OS:     github.Ptr("linux"),
+				Status: github.Ptr("online"),
+				Busy:   github.Ptr(true),
+				Labels: []*github.RunnerLabels{
+					{Name: github.Ptr("self-hosted"), Type: github.Ptr("read-only")},
+					{Name: github.Ptr("linux"), Type: github.Ptr("read-only")},
+					{Name: github.Ptr("gpu"), Type: github.Ptr("custom")},
+				},
+			},
+			{
+				ID:     github.Ptr(int64(2)),
+				Name:   github.Ptr("build-mac-1"),
+				OS:     github.Ptr("macos"),
+				Status: github.Ptr("offline"),
+				Busy:   github.Ptr(false),
+			},
+		},
+	}
+	linuxRunner := runnerSummary{
+		ID:     1,
+		Name:   "build-linux-1",
+		OS:     "linux",
+		Status: "online",
+		Busy:   true,
+		Labels: []string{"self-hosted", "linux", "gpu"},
+	}
+	macRunner := runnerSummary{
+		ID:     2,
+		Name:   "build-mac-1",
+		OS:     "macos",
+		Status: "offline",
+		Labels: []string{},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedRunners runnersResult
+		expectedErrMsg  string
+	}{
+		{
+			name: "repository runners with labels",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunnersByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRunners),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedRunners: runnersResult{
+				TotalCount: 2,
+				Runners:    []runnerSummary{linuxRunner, macRunner},
+			},
+		},
+		{
+			name: "organization runners filtered by status",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsRunnersByOrg,
+					mockRunners,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "org",
+				"status": "offline",
+			},
+			expectedRunners: runnersResult{
+				TotalCount: 2,
+				Runners:    []runnerSummary{macRunner},
+			},
+		},
+		{
+			name:         "invalid status",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "org",
+				"status": "busy",
+			},
+			expectedErrMsg: "invalid status: busy, must be online or offline",
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsRunnersByOrg,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list self-hosted runners",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListSelfHostedRunners(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned runnersResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRunners, returned)
+		})
+	}
+}
+
+const mockPendingDeployments = `[
+	{
+		"environment": {"id": 161088068, "name": "production"},
+		"wait_timer": 30,
+		"current_user_can_approve": true,
+		"reviewers": [
+			{"type": "User", "reviewer": {"login": "octocat"}},
+			{"type": "Team", "reviewer": {"slug": "release-managers"}}
+		]
+	},
+	{
+		"environment": {"id": 161088069, "name": "staging"},
+		"current_user_can_approve": false
+	}
+]`
+
+func Test_GetPendingDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPendingDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pending_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "run_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+			mockResponse(t, http.StatusOK, json.RawMessage(mockPendingDeployments)),
+		),
+	))
+	_, handler := GetPendingDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"run_id": float64(42),
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned []pendingDeploymentSummary
+	err = json.Unmarshal([]byte(textContent.Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, []pendingDeploymentSummary{
+		{
+			EnvironmentID:         161088068,
+			Environment:           "production",
+			WaitTimer:             30,
+			CurrentUserCanApprove: true,
+			Reviewers:             []string{"octocat", "team:release-managers"},
+		},
+		{
+			EnvironmentID: 161088069,
+			Environment:   "staging",
+			Reviewers:     []string{},
+		},
+	}, returned)
+}
+
+func Test_ReviewPendingDeployments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReviewPendingDeployments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "review_pending_deployments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "environment_ids")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "run_id", "environment_ids", "state", "comment"})
+
+	mockDeployments := []*github.Deployment{
+		{
+			ID:          github.Ptr(int64(1)),
+			Environment: github.Ptr("production"),
+			Ref:         github.Ptr("main"),
+			SHA:         github.Ptr("abc123"),
+		},
+	}
+	reviewWith := func(expected github.PendingDeploymentsRequest) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.PostReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body github.PendingDeploymentsRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, expected, body)
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(mockDeployments)
+			}),
+		)
+	}
+	pending := mock.WithRequestMatchHandler(
+		mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+		mockResponse(t, http.StatusOK, json.RawMessage(mockPendingDeployments)),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expected       []reviewedDeployment
+		expectedErrMsg string
+	}{
+		{
+			name: "approve",
+			mockedClient: mock.NewMockedHTTPClient(
+				pending,
+				reviewWith(github.PendingDeploymentsRequest{
+					EnvironmentIDs: []int64{161088068},
+					State:          "approved",
+					Comment:        "Ship it",
+				}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "approved",
+				"comment":         "Ship it",
+			},
+			expected: []reviewedDeployment{
+				{ID: 1, Environment: "production", Ref: "main", SHA: "abc123"},
+			},
+		},
+		{
+			name: "reject",
+			mockedClient: mock.NewMockedHTTPClient(
+				pending,
+				reviewWith(github.PendingDeploymentsRequest{
+					EnvironmentIDs: []int64{161088068, 161088069},
+					State:          "rejected",
+					Comment:        "Failing smoke tests",
+				}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"environment_ids": []any{float64(161088068), float64(161088069)},
+				"state":           "rejected",
+				"comment":         "Failing smoke tests",
+			},
+			expected: []reviewedDeployment{
+				{ID: 1, Environment: "production", Ref: "main", SHA: "abc123"},
+			},
+		},
+		{
+			name:         "environment not pending",
+			mockedClient: mock.NewMockedHTTPClient(pending),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"environment_ids": []any{float64(7)},
+				"state":           "approved",
+				"comment":         "Ship it",
+			},
+			expectedErrMsg: "environment 7 is not waiting for review in workflow run 42, pending environments: production (161088068), staging (161088069)",
+		},
+		{
+			name: "no pending deployments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsPendingDeploymentsByOwnerByRepoByRunId,
+					mockResponse(t, http.StatusOK, []*github.PendingDeployment{}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"environment_ids": []any{float64(161088068)},
+				"state":           "approved",
+				"comment":         "Ship it",
+			},
+			expectedErrMsg: "workflow run 42 has no deployments waiting for review",
+		},
+		{
+			name:         "missing environment ids",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"run_id":          float64(42),
+				"environment_ids": []any{},
+				"state":           "approved",
+				"comment":         "Ship it",
+			},
+			expectedErrMsg: "missing required parameter: environment_ids",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReviewPendingDeployments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned []reviewedDeployment
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_ListCheckRunAnnotations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCheckRunAnnotations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_check_run_annotations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "check_run_id")
+	assert.Contains(t, tool.InputSchema.Properties, "annotation_level")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "check_run_id"})
+
+	mockAnnotations := []*github.CheckRunAnnotation{
+		{
+			Path:            github.Ptr("pkg/server.go"),
+			StartLine:       github.Ptr(12),
+			EndLine:         github.Ptr(12),
+			AnnotationLevel: github.Ptr("warning"),
+			Title:           github.Ptr("errcheck"),
+			Message:         github.Ptr("Error return value of `resp.Body.Close` is not checked"),
+			RawDetails:      github.Ptr("long details"),
+		},
+		{
+			Path:            github.Ptr("pkg/server_test.go"),
+			StartLine:       github.Ptr(40),
+			EndLine:         github.Ptr(44),
+			AnnotationLevel: github.Ptr("failure"),
+			Message:         github.Ptr("Test_Server failed: expected 200, got 500"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expected       []checkRunAnnotation
+		expectedErrMsg string
+	}{
+		{
+			name: "lists the annotations of a page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunId,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "50",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockAnnotations),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"check_run_id": float64(4),
+				"page":         float64(2),
+				"perPage":      float64(50),
+			},
+			expected: []checkRunAnnotation{
+				{
+					Path:            "pkg/server.go",
+					StartLine:       12,
+					AnnotationLevel: "warning",
+					Title:           "errcheck",
+					Message:         "Error return value of `resp.Body.Close` is not checked",
+				},
+				{
+					Path:            "pkg/server_test.go",
+					StartLine:       40,
+					EndLine:         44,
+					AnnotationLevel: "failure",
+					Message:         "Test_Server failed: expected 200, got 500",
+				},
+			},
+		},
+		{
+			name: "only the failures",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunId,
+					mockAnnotations,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"check_run_id":     float64(4),
+				"annotation_level": "failure",
+			},
+			expected: []checkRunAnnotation{
+				{
+					Path:            "pkg/server_test.go",
+					StartLine:       40,
+					EndLine:         44,
+					AnnotationLevel: "failure",
+					Message:         "Test_Server failed: expected 200, got 500",
+				},
+			},
+		},
+		{
+			name: "check run not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCheckRunsAnnotationsByOwnerByRepoByCheckRunId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"check_run_id": float64(4),
+			},
+			expectedErrMsg: "check run 4 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCheckRunAnnotations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned []checkRunAnnotation
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_GetOrgActionsPermissions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetOrgActionsPermissions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_org_actions_permissions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expected       orgActionsPermissions
+		expectedErrMsg string
+	}{
+		{
+			name: "local actions only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsPermissionsByOrg,
+					&github.ActionsPermissions{
+						EnabledRepositories: github.Ptr("all"),
+						AllowedActions:      github.Ptr("local_only"),
+					},
+				),
+				mock.WithRequestMatchHandler(mock.GetOrgsActionsPermissionsSelectedActionsByOrg, failOnRequest(t)),
+			),
+			expected: orgActionsPermissions{
+				EnabledRepositories: "all",
+				AllowedActions:      "local_only",
+			},
+		},
+		{
+			name: "selected actions are listed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsPermissionsByOrg,
+					&github.ActionsPermissions{
+						EnabledRepositories: github.Ptr("selected"),
+						AllowedActions:      github.Ptr("selected"),
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetOrgsActionsPermissionsSelectedActionsByOrg,
+					&github.ActionsAllowed{
+						GithubOwnedAllowed: github.Ptr(true),
+						VerifiedAllowed:    github.Ptr(false),
+						PatternsAllowed:    []string{"octo-org/*"},
+					},
+				),
+			),
+			expected: orgActionsPermissions{
+				EnabledRepositories: "selected",
+				AllowedActions:      "selected",
+				SelectedActions: &github.ActionsAllowed{
+					GithubOwnedAllowed: github.Ptr(true),
+					VerifiedAllowed:    github.Ptr(false),
+					PatternsAllowed:    []string{"octo-org/*"},
+				},
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsPermissionsByOrg,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectedErrMsg: "organization octo-org not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetOrgActionsPermissions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{"org": "octo-org"}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned orgActionsPermissions
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
+func Test_SetOrgActionsPermissions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SetOrgActionsPermissions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "set_org_actions_permissions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "enabled_repositories")
+	assert.Contains(t, tool.InputSchema.Properties, "allowed_actions")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "enabled_repositories"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expected       orgActionsPermissions
+		expectedErrMsg string
+	}{
+		{
+			name: "restrict to local actions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsActionsPermissionsByOrg,
+					expectRequestBody(t, map[string]any{
+						"enabled_repositories": "all",
+						"allowed_actions":      "local_only",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":                  "octo-org",
+				"enabled_repositories": "all",
+				"allowed_actions":      "local_only",
+			},
+			expected: orgActionsPermissions{
+				EnabledRepositories: "all",
+				AllowedActions:      "local_only",
+			},
+		},
+		{
+			name: "disable Actions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsActionsPermissionsByOrg,
+					expectRequestBody(t, map[string]any{
+						"enabled_repositories": "none",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":                  "octo-org",
+				"enabled_repositories": "none",
+			},
+			expected: orgActionsPermissions{
+				EnabledRepositories: "none",
+			},
+		},
+		{
+			name:         "invalid allowed actions",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":                  "octo-org",
+				"enabled_repositories": "all",
+				"allowed_actions":      "verified",
+			},
+			expectedErrMsg: "invalid allowed_actions: verified, must be one of all, local_only or selected",
+		},
+		{
+			name:         "invalid enabled repositories",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":                  "octo-org",
+				"enabled_repositories": "some",
+			},
+			expectedErrMsg: "invalid enabled_repositories: some, must be one of all, none or selected",
+		},
+		{
+			name:         "allowed actions with Actions disabled",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":                  "octo-org",
+				"enabled_repositories": "none",
+				"allowed_actions":      "all",
+			},
+			expectedErrMsg: "allowed_actions cannot be set when enabled_repositories is none",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetOrgActionsPermissions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned orgActionsPermissions
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxArchiveBytes caps the size of a repository archive returned inline. Larger archives are only
+// returned as a download URL.
+const maxArchiveBytes = 1024 * 1024
+
+// archiveDownloadClient downloads an archive from the URL the API redirects to. The URL is signed,
+// so it must not receive the token, overridden in tests.
+var archiveDownloadClient = http.DefaultClient
+
+// repositoryArchive is the result of download_repository_archive.
+type repositoryArchive struct {
+	Format      string `json:"format"`
+	Ref         string `json:"ref,omitempty"`
+	DownloadURL string `json:"download_url"`
+	Size        int    `json:"size,omitempty"`
+	Encoding    string `json:"encoding,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// DownloadRepositoryArchive creates a tool to get a tarball or zipball of a repository at a ref.
+func DownloadRepositoryArchive(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_repository_archive",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_REPOSITORY_ARCHIVE_DESCRIPTION", fmt.Sprintf("Get a tarball or zipball of a GitHub repository at a branch, tag or commit. Returns a download URL valid for a few minutes, and with include_content the archive base64 encoded when it is at most %d bytes", maxArchiveBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DOWNLOAD_REPOSITORY_ARCHIVE_USER_TITLE", "Download repository archive"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("format",
+				mcp.Description("Archive format, default tarball"),
+				mcp.Enum(string(github.Tarball), string(github.Zipball)),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to archive, defaults to the default branch"),
+			),
+			mcp.WithBoolean("include_content",
+				mcp.Description("Also download the archive and return it base64 encoded, default false"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalParam[string](request, "format")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if format == "" {
+				format = string(github.Tarball)
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeContent, err := OptionalParam[bool](request, "include_content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			link, resp, err := client.Repositories.GetArchiveLink(ctx, owner, repo, github.ArchiveFormat(format), &github.RepositoryContentGetOptions{Ref: ref}, 1)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					if ref != "" {
+						return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", ref, owner, repo)), nil
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get archive link: %w", err)
+			}
+
+			result := repositoryArchive{
+				Format:      format,
+				Ref:         ref,
+				DownloadURL: link.String(),
+			}
+			if !includeContent {
+				return marshalRepositoryArchive(result)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create archive request: %w", err)
+			}
+			archiveResp, err := archiveDownloadClient.Do(req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to download archive: %w", err)
+			}
+			defer func() { _ = archiveResp.Body.Close() }()
+
+			if archiveResp.StatusCode != http.StatusOK {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to download archive: %s", archiveResp.Status)), nil
+			}
+
+			// The size of an archive is not known before downloading it, only read one byte past the cap
+			data, err := io.ReadAll(io.LimitReader(archiveResp.Body, maxArchiveBytes+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read archive: %w", err)
+			}
+			if len(data) > maxArchiveBytes {
+				result.Note = fmt.Sprintf("archive is larger than %d bytes, download it from download_url instead", maxArchiveBytes)
+				return marshalRepositoryArchive(result)
+			}
+			result.Size = len(data)
+			result.Encoding = "base64"
+			result.Content = base64.StdEncoding.EncodeToString(data)
+
+			return marshalRepositoryArchive(result)
+		}
+}
+
+// marshalRepositoryArchive returns the result of download_repository_archive as a tool result.
+func marshalRepositoryArchive(result repositoryArchive) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return mcp.NewToolResultText(string(r)), nil
+}
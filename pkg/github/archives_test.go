@@ -0,0 +1,194 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DownloadRepositoryArchive(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadRepositoryArchive(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "download_repository_archive", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "format")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "include_content")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// redirectTo stubs the archive endpoint, redirecting to the signed download URL
+	redirectTo := func(location string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Location", location)
+			w.WriteHeader(http.StatusFound)
+		}
+	}
+	archives := map[string][]byte{
+		"https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.0.0?token=abc": []byte("\x1f\x8b tarball"),
+		"https://codeload.github.com/owner/repo/legacy.zip/refs/tags/v1.0.0?token=abc":    []byte("PK\x03\x04 zipball"),
+		"https://codeload.github.com/owner/repo/legacy.zip/refs/heads/main?token=abc":     bytes.Repeat([]byte{0}, maxArchiveBytes+1),
+	}
+	downloadClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Empty(t, req.Header.Get("Authorization"))
+		data, ok := archives[req.URL.String()]
+		if !ok {
+			return &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: io.NopCloser(bytes.NewReader(nil))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(data))}, nil
+	})}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedArchive repositoryArchive
+		expectedErrMsg  string
+	}{
+		{
+			name: "tarball link",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					redirectTo("https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.0.0?token=abc"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "v1.0.0",
+			},
+			expectedArchive: repositoryArchive{
+				Format:      "tarball",
+				Ref:         "v1.0.0",
+				DownloadURL: "https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.0.0?token=abc",
+			},
+		},
+		{
+			name: "tarball with content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					redirectTo("https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.0.0?token=abc"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"format":          "tarball",
+				"ref":             "v1.0.0",
+				"include_content": true,
+			},
+			expectedArchive: repositoryArchive{
+				Format:      "tarball",
+				Ref:         "v1.0.0",
+				DownloadURL: "https://codeload.github.com/owner/repo/legacy.tar.gz/refs/tags/v1.0.0?token=abc",
+				Size:        10,
+				Encoding:    "base64",
+				Content:     base64.StdEncoding.EncodeToString([]byte("\x1f\x8b tarball")),
+			},
+		},
+		{
+			name: "zipball with content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposZipballByOwnerByRepoByRef,
+					redirectTo("https://codeload.github.com/owner/repo/legacy.zip/refs/tags/v1.0.0?token=abc"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"format":          "zipball",
+				"ref":             "v1.0.0",
+				"include_content": true,
+			},
+			expectedArchive: repositoryArchive{
+				Format:      "zipball",
+				Ref:         "v1.0.0",
+				DownloadURL: "https://codeload.github.com/owner/repo/legacy.zip/refs/tags/v1.0.0?token=abc",
+				Size:        12,
+				Encoding:    "base64",
+				Content:     base64.StdEncoding.EncodeToString([]byte("PK\x03\x04 zipball")),
+			},
+		},
+		{
+			name: "large archive returns only the download URL",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposZipballByOwnerByRepoByRef,
+					redirectTo("https://codeload.github.com/owner/repo/legacy.zip/refs/heads/main?token=abc"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"format":          "zipball",
+				"ref":             "main",
+				"include_content": true,
+			},
+			expectedArchive: repositoryArchive{
+				Format:      "zipball",
+				Ref:         "main",
+				DownloadURL: "https://codeload.github.com/owner/repo/legacy.zip/refs/heads/main?token=abc",
+				Note:        "archive is larger than 1048576 bytes, download it from download_url instead",
+			},
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTarballByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "v9.9.9",
+			},
+			expectError:    true,
+			expectedErrMsg: "ref v9.9.9 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			originalDownloadClient := archiveDownloadClient
+			archiveDownloadClient = downloadClient
+			t.Cleanup(func() { archiveDownloadClient = originalDownloadClient })
+			_, handler := DownloadRepositoryArchive(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned repositoryArchive
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedArchive, returned)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// autolinkPlaceholder is the part of an autolink URL template replaced by the referenced identifier.
+const autolinkPlaceholder = "<num>"
+
+// validateAutolinkURLTemplate checks that an autolink URL template links somewhere specific for each reference.
+func validateAutolinkURLTemplate(urlTemplate string) error {
+	if !strings.Contains(urlTemplate, autolinkPlaceholder) {
+		return fmt.Errorf("url_template must contain the %s placeholder, e.g. https://jira.example.com/browse/PROJ-%s", autolinkPlaceholder, autolinkPlaceholder)
+	}
+	if !strings.HasPrefix(urlTemplate, "http://") && !strings.HasPrefix(urlTemplate, "https://") {
+		return fmt.Errorf("url_template must be an http or https URL")
+	}
+	return nil
+}
+
+// ListAutolinks creates a tool to list the autolink references of a repository.
+func ListAutolinks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_autolinks",
+			mcp.WithDescription(t("TOOL_LIST_AUTOLINKS_DESCRIPTION", "List the autolink references of a GitHub repository, which link prefixes such as PROJ- to an external tracker. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_AUTOLINKS_USER_TITLE", "List autolink references"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			autolinks, resp, err := client.Repositories.ListAutolinks(ctx, owner, repo, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list autolinks: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list autolinks: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(autolinks)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateAutolink creates a tool to add an autolink reference to a repository.
+func CreateAutolink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_autolink",
+			mcp.WithDescription(t("TOOL_CREATE_AUTOLINK_DESCRIPTION", "Create an autolink reference in a GitHub repository, turning references such as PROJ-123 into links to an external tracker")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_AUTOLINK_USER_TITLE", "Create autolink reference"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("key_prefix",
+				mcp.Required(),
+				mcp.Description("Prefix that starts a reference, e.g. PROJ-"),
+			),
+			mcp.WithString("url_template",
+				mcp.Required(),
+				mcp.Description("URL of the referenced item, with <num> where the identifier goes, e.g. https://jira.example.com/browse/PROJ-<num>"),
+			),
+			mcp.WithBoolean("is_alphanumeric",
+				mcp.Description("Whether the identifier may contain letters as well as digits (default true)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keyPrefix, err := requiredParam[string](request, "key_prefix")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			urlTemplate, err := requiredParam[string](request, "url_template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateAutolinkURLTemplate(urlTemplate); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			isAlphanumeric, ok, err := OptionalParamOK[bool](request, "is_alphanumeric")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.AutolinkOptions{
+				KeyPrefix:   github.Ptr(keyPrefix),
+				URLTemplate: github.Ptr(urlTemplate),
+			}
+			if ok {
+				opts.IsAlphanumeric = github.Ptr(isAlphanumeric)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("create autolink %s in %s/%s", keyPrefix, owner, repo), nil
+			}
+			autolink, resp, err := client.Repositories.AddAutolink(ctx, owner, repo, opts)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot create autolink for %s in %s/%s: %s", keyPrefix, owner, repo, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to create autolink: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create autolink: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(autolink)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteAutolink creates a tool to delete an autolink reference of a repository.
+func DeleteAutolink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_autolink",
+			mcp.WithDescription(t("TOOL_DELETE_AUTOLINK_DESCRIPTION", "Delete an autolink reference of a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_AUTOLINK_USER_TITLE", "Delete autolink reference"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("autolink_id",
+				mcp.Required(),
+				mcp.Description("The ID of the autolink, from list_autolinks"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			autolinkID, err := RequiredInt(request, "autolink_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("delete autolink %d from %s/%s", autolinkID, owner, repo), nil
+			}
+			resp, err := client.Repositories.DeleteAutolink(ctx, owner, repo, int64(autolinkID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("autolink %d not found in %s/%s", autolinkID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to delete autolink: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete autolink: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted autolink %d from %s/%s", autolinkID, owner, repo)), nil
+		}
+}
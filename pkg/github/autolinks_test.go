@@ -0,0 +1,262 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateAutolinkURLTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		urlTemplate string
+		expectedErr string
+	}{
+		{
+			name:        "valid template",
+			urlTemplate: "https://jira.example.com/browse/PROJ-<num>",
+		},
+		{
+			name:        "missing placeholder",
+			urlTemplate: "https://jira.example.com/browse/PROJ-",
+			expectedErr: "url_template must contain the <num> placeholder, e.g. https://jira.example.com/browse/PROJ-<num>",
+		},
+		{
+			name:        "misspelled placeholder",
+			urlTemplate: "https://jira.example.com/browse/PROJ-<number>",
+			expectedErr: "url_template must contain the <num> placeholder, e.g. https://jira.example.com/browse/PROJ-<num>",
+		},
+		{
+			name:        "not a URL",
+			urlTemplate: "jira.example.com/<num>",
+			expectedErr: "url_template must be an http or https URL",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateAutolinkURLTemplate(tc.urlTemplate)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func Test_ListAutolinks(t *testing.T) {
+	mockAutolinks := []*github.Autolink{
+		{
+			ID:             github.Ptr(int64(1)),
+			KeyPrefix:      github.Ptr("PROJ-"),
+			URLTemplate:    github.Ptr("https://jira.example.com/browse/PROJ-<num>"),
+			IsAlphanumeric: github.Ptr(true),
+		},
+	}
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposAutolinksByOwnerByRepo,
+			mockAutolinks,
+		),
+	))
+	tool, handler := ListAutolinks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_autolinks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.Autolink
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, mockAutolinks, returned)
+}
+
+func Test_CreateAutolink(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateAutolink(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_autolink", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "is_alphanumeric")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "key_prefix", "url_template"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockAutolink := &github.Autolink{
+		ID:             github.Ptr(int64(2)),
+		KeyPrefix:      github.Ptr("TICKET-"),
+		URLTemplate:    github.Ptr("https://tracker.example.com/<num>"),
+		IsAlphanumeric: github.Ptr(false),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedAutolink *github.Autolink
+		expectedErrMsg   string
+	}{
+		{
+			name: "creates the autolink",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposAutolinksByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"key_prefix":      "TICKET-",
+						"url_template":    "https://tracker.example.com/<num>",
+						"is_alphanumeric": false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockAutolink),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"key_prefix":      "TICKET-",
+				"url_template":    "https://tracker.example.com/<num>",
+				"is_alphanumeric": false,
+			},
+			expectedAutolink: mockAutolink,
+		},
+		{
+			name: "leaves is_alphanumeric to the API default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposAutolinksByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"key_prefix":   "TICKET-",
+						"url_template": "https://tracker.example.com/<num>",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockAutolink),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"key_prefix":   "TICKET-",
+				"url_template": "https://tracker.example.com/<num>",
+			},
+			expectedAutolink: mockAutolink,
+		},
+		{
+			name:         "template without placeholder",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"key_prefix":   "TICKET-",
+				"url_template": "https://tracker.example.com/",
+			},
+			expectedErrMsg: "url_template must contain the <num> placeholder",
+		},
+		{
+			name: "key prefix already in use",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposAutolinksByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, json.RawMessage(`{"message": "Validation Failed", "errors": [{"resource": "KeyLink", "code": "custom", "field": "key_prefix", "message": "key_prefix has already been taken"}]}`)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"key_prefix":   "TICKET-",
+				"url_template": "https://tracker.example.com/<num>",
+			},
+			expectedErrMsg: "cannot create autolink for TICKET- in owner/repo: Validation Failed: key_prefix has already been taken",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateAutolink(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returned github.Autolink
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedAutolink, returned)
+		})
+	}
+}
+
+func Test_DeleteAutolink(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectError  bool
+		expectedText string
+	}{
+		{
+			name: "deletes the autolink",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposAutolinksByOwnerByRepoByAutolinkId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/autolinks/3", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			expectedText: "Deleted autolink 3 from owner/repo",
+		},
+		{
+			name: "autolink not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposAutolinksByOwnerByRepoByAutolinkId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:  true,
+			expectedText: "autolink 3 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			tool, handler := DeleteAutolink(stubGetClientFn(client), translations.NullTranslationHelper)
+			assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "autolink_id"})
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"autolink_id": float64(3),
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectError, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxBatchCalls caps the number of tool calls a single batch_execute call may make.
+const maxBatchCalls = 20
+
+// batchCallConcurrency bounds the number of calls of a concurrent batch running at the same time.
+const batchCallConcurrency = 5
+
+// batchCall is a single entry of a batch_execute call.
+type batchCall struct {
+	Tool      string
+	Arguments map[string]interface{}
+}
+
+// batchCallResult is the result of one call of a batch, the content the tool returned, or the
+// error that kept it from running. Calls left out after an error with stop_on_error are skipped.
+type batchCallResult struct {
+	Tool    string        `json:"tool"`
+	IsError bool          `json:"is_error"`
+	Skipped bool          `json:"skipped,omitempty"`
+	Content []mcp.Content `json:"content,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// listServerTools returns the tools s currently serves, by name.
+func listServerTools(ctx context.Context, s *server.MCPServer) (map[string]mcp.Tool, error) {
+	response := s.HandleMessage(ctx, json.RawMessage(`{"jsonrpc": "2.0", "id": 1, "method": "tools/list"}`))
+	switch response := response.(type) {
+	case mcp.JSONRPCResponse:
+		listed, ok := response.Result.(mcp.ListToolsResult)
+		if !ok {
+			return nil, fmt.Errorf("unexpected tools/list result %T", response.Result)
+		}
+		tools := make(map[string]mcp.Tool, len(listed.Tools))
+		for _, tool := range listed.Tools {
+			tools[tool.Name] = tool
+		}
+		return tools, nil
+	case mcp.JSONRPCError:
+		return nil, fmt.Errorf("failed to list tools: %s", response.Error.Message)
+	default:
+		return nil, fmt.Errorf("unexpected tools/list response %T", response)
+	}
+}
+
+// executeBatchCall makes a single call of a batch through s, so it goes through the same
+// middleware as a call of the client would, such as dry-run and max items.
+func executeBatchCall(ctx context.Context, s *server.MCPServer, id int, call batchCall) batchCallResult {
+	message, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": mcp.JSONRPC_VERSION,
+		"id":      id,
+		"method":  "tools/call",
+		"params": map[string]interface{}{
+			"name":      call.Tool,
+			"arguments": call.Arguments,
+		},
+	})
+	if err != nil {
+		return batchCallResult{Tool: call.Tool, IsError: true, Error: fmt.Sprintf("failed to marshal call: %s", err)}
+	}
+
+	switch response := s.HandleMessage(ctx, message).(type) {
+	case mcp.JSONRPCResponse:
+		result, ok := response.Result.(mcp.CallToolResult)
+		if !ok {
+			return batchCallResult{Tool: call.Tool, IsError: true, Error: fmt.Sprintf("unexpected tools/call result %T", response.Result)}
+		}
+		return batchCallResult{Tool: call.Tool, IsError: result.IsError, Content: result.Content}
+	case mcp.JSONRPCError:
+		return batchCallResult{Tool: call.Tool, IsError: true, Error: response.Error.Message}
+	default:
+		return batchCallResult{Tool: call.Tool, IsError: true, Error: fmt.Sprintf("unexpected tools/call response %T", response)}
+	}
+}
+
+// BatchExecute creates a tool to make several calls to the other tools of s in one round trip.
+// Calls are made as the client would make them, so only the tools s serves can be called, and on
+// a read-only server, only read-only ones.
+func BatchExecute(s *server.MCPServer, readOnly bool, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("batch_execute",
+			mcp.WithDescription(t("TOOL_BATCH_EXECUTE_DESCRIPTION", fmt.Sprintf("Make up to %d calls to the other tools of this server in one round trip, e.g. to label several issues. Calls are made in order, or at the same time with concurrent, and the result of each is returned in the order of the calls", maxBatchCalls))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: t("TOOL_BATCH_EXECUTE_USER_TITLE", "Batch tool calls"),
+				// The calls of a batch may write, unless the server is read-only
+				ReadOnlyHint: mcp.ToBoolPtr(readOnly),
+			}),
+			mcp.WithArray("calls",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"tool"},
+						"properties": map[string]interface{}{
+							"tool": map[string]interface{}{
+								"type":        "string",
+								"description": "name of the tool to call",
+							},
+							"arguments": map[string]interface{}{
+								"type":        "object",
+								"description": "arguments of the tool",
+							},
+						},
+					},
+				),
+				mcp.Description("Tool calls to make"),
+			),
+			mcp.WithBoolean("concurrent",
+				mcp.Description("Make the calls at the same time rather than in order"),
+			),
+			mcp.WithBoolean("stop_on_error",
+				mcp.Description("Skip the calls not started yet once a call fails"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			callsObj, ok := request.GetArguments()["calls"].([]interface{})
+			if !ok || len(callsObj) == 0 {
+				return mcp.NewToolResultError("calls parameter must be a non-empty array of objects with tool"), nil
+			}
+			if len(callsObj) > maxBatchCalls {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d calls can be made at once, got %d", maxBatchCalls, len(callsObj))), nil
+			}
+			concurrent, err := OptionalParam[bool](request, "concurrent")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			stopOnError, err := OptionalParam[bool](request, "stop_on_error")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			calls := make([]batchCall, 0, len(callsObj))
+			for _, callObj := range callsObj {
+				callMap, ok := callObj.(map[string]interface{})
+				if !ok {
+					return mcp.NewToolResultError("each call must be an object with tool and optional arguments"), nil
+				}
+				name, ok := callMap["tool"].(string)
+				if !ok || name == "" {
+					return mcp.NewToolResultError("each call must have a tool"), nil
+				}
+				args, ok := callMap["arguments"].(map[string]interface{})
+				if !ok && callMap["arguments"] != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("arguments of the call to %s must be an object", name)), nil
+				}
+				calls = append(calls, batchCall{Tool: name, Arguments: args})
+			}
+
+			tools, err := listServerTools(ctx, s)
+			if err != nil {
+				return nil, err
+			}
+			// checkCall returns why call cannot be made, or "" when it can
+			checkCall := func(call batchCall) string {
+				tool, ok := tools[call.Tool]
+				switch {
+				// The name of the request holds the tool name prefix, if any
+				case call.Tool == request.Params.Name:
+					return fmt.Sprintf("%s cannot be called from a batch", call.Tool)
+				case !ok && readOnly:
+					return fmt.Sprintf("tool %s not found, write tools are not available in read-only mode", call.Tool)
+				case !ok:
+					return fmt.Sprintf("tool %s not found", call.Tool)
+				case readOnly && (tool.Annotations.ReadOnlyHint == nil || !*tool.Annotations.ReadOnlyHint):
+					return fmt.Sprintf("tool %s is not available in read-only mode", call.Tool)
+				}
+				return ""
+			}
+
+			results := make([]batchCallResult, len(calls))
+			var mu sync.Mutex
+			stopped := false
+			run := func(i int) {
+				mu.Lock()
+				skip := stopped
+				mu.Unlock()
+				var result batchCallResult
+				switch reason := checkCall(calls[i]); {
+				case skip:
+					result = batchCallResult{Tool: calls[i].Tool, Skipped: true}
+				case reason != "":
+					result = batchCallResult{Tool: calls[i].Tool, IsError: true, Error: reason}
+				default:
+					result = executeBatchCall(ctx, s, i+1, calls[i])
+				}
+				mu.Lock()
+				results[i] = result
+				if stopOnError && result.IsError {
+					stopped = true
+				}
+				mu.Unlock()
+			}
+
+			if concurrent {
+				sem := make(chan struct{}, batchCallConcurrency)
+				var wg sync.WaitGroup
+				for i := range calls {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						sem <- struct{}{}
+						defer func() { <-sem }()
+						run(i)
+					}(i)
+				}
+				wg.Wait()
+			} else {
+				for i := range calls {
+					run(i)
+				}
+			}
+
+			r, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
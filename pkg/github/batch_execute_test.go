@@ -0,0 +1,267 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchTestServer returns a server with batch_execute and the tools echo, which returns its
+// message, fail, which returns a tool error, and label, a tool that is not read-only, whose
+// calls are recorded in order.
+func newBatchTestServer(t *testing.T, readOnly bool, opts ...server.ServerOption) (*server.MCPServer, *[]string) {
+	t.Helper()
+	s := NewServer("test", opts...)
+	var mu sync.Mutex
+	var calls []string
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+
+	s.AddTool(mcp.NewTool("echo",
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)}),
+		mcp.WithString("message"),
+	), func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		message, _ := request.GetArguments()["message"].(string)
+		record("echo " + message)
+		return mcp.NewToolResultText(message), nil
+	})
+	s.AddTool(mcp.NewTool("fail",
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)}),
+	), func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		record("fail")
+		return mcp.NewToolResultError("something failed"), nil
+	})
+	s.AddTool(mcp.NewTool("label",
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(false)}),
+		mcp.WithNumber("issue_number"),
+	), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		number, _ := request.GetArguments()["issue_number"].(float64)
+		if isDryRun(ctx) {
+			return dryRunResult("label issue %d", int(number)), nil
+		}
+		record(fmt.Sprintf("label %d", int(number)))
+		return mcp.NewToolResultText(fmt.Sprintf("Labeled issue %d", int(number))), nil
+	})
+	s.AddTool(BatchExecute(s, readOnly, translations.NullTranslationHelper))
+	return s, &calls
+}
+
+// callBatch calls batch_execute of s with args and returns its results.
+func callBatch(t *testing.T, s *server.MCPServer, args map[string]interface{}) []map[string]any {
+	t.Helper()
+	message, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]interface{}{"name": "batch_execute", "arguments": args},
+	})
+	require.NoError(t, err)
+	response, ok := s.HandleMessage(context.Background(), message).(mcp.JSONRPCResponse)
+	require.True(t, ok)
+	result, ok := response.Result.(mcp.CallToolResult)
+	require.True(t, ok)
+	textContent := getTextResult(t, &result)
+	require.False(t, result.IsError, textContent.Text)
+
+	var returned []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	return returned
+}
+
+// textResult is a successful batch call result returning text.
+func textResult(tool, text string) map[string]any {
+	return map[string]any{
+		"tool":     tool,
+		"is_error": false,
+		"content":  []any{map[string]any{"type": "text", "text": text}},
+	}
+}
+
+func Test_BatchExecute(t *testing.T) {
+	// Verify tool definition once
+	s, _ := newBatchTestServer(t, false)
+	tool, _ := BatchExecute(s, false, translations.NullTranslationHelper)
+
+	assert.Equal(t, "batch_execute", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "calls")
+	assert.Contains(t, tool.InputSchema.Properties, "concurrent")
+	assert.Contains(t, tool.InputSchema.Properties, "stop_on_error")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"calls"})
+
+	tests := []struct {
+		name            string
+		readOnly        bool
+		opts            []server.ServerOption
+		requestArgs     map[string]interface{}
+		expectedResults []map[string]any
+		expectedCalls   []string
+	}{
+		{
+			name: "sequential calls run in order",
+			requestArgs: map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"tool": "label", "arguments": map[string]interface{}{"issue_number": float64(1)}},
+					map[string]interface{}{"tool": "fail"},
+					map[string]interface{}{"tool": "label", "arguments": map[string]interface{}{"issue_number": float64(2)}},
+					map[string]interface{}{"tool": "missing"},
+					map[string]interface{}{"tool": "batch_execute"},
+				},
+			},
+			expectedResults: []map[string]any{
+				textResult("label", "Labeled issue 1"),
+				{
+					"tool":     "fail",
+					"is_error": true,
+					"content":  []any{map[string]any{"type": "text", "text": "something failed"}},
+				},
+				textResult("label", "Labeled issue 2"),
+				{"tool": "missing", "is_error": true, "error": "tool missing not found"},
+				{"tool": "batch_execute", "is_error": true, "error": "batch_execute cannot be called from a batch"},
+			},
+			expectedCalls: []string{"label 1", "fail", "label 2"},
+		},
+		{
+			name: "stop on error skips the calls after the failing one",
+			requestArgs: map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"tool": "echo", "arguments": map[string]interface{}{"message": "first"}},
+					map[string]interface{}{"tool": "fail"},
+					map[string]interface{}{"tool": "echo", "arguments": map[string]interface{}{"message": "last"}},
+				},
+				"stop_on_error": true,
+			},
+			expectedResults: []map[string]any{
+				textResult("echo", "first"),
+				{
+					"tool":     "fail",
+					"is_error": true,
+					"content":  []any{map[string]any{"type": "text", "text": "something failed"}},
+				},
+				{"tool": "echo", "is_error": false, "skipped": true},
+			},
+			expectedCalls: []string{"echo first", "fail"},
+		},
+		{
+			name:     "read-only mode rejects write tools",
+			readOnly: true,
+			requestArgs: map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"tool": "label", "arguments": map[string]interface{}{"issue_number": float64(1)}},
+					map[string]interface{}{"tool": "echo", "arguments": map[string]interface{}{"message": "read"}},
+					map[string]interface{}{"tool": "create_issue"},
+				},
+			},
+			expectedResults: []map[string]any{
+				{"tool": "label", "is_error": true, "error": "tool label is not available in read-only mode"},
+				textResult("echo", "read"),
+				{"tool": "create_issue", "is_error": true, "error": "tool create_issue not found, write tools are not available in read-only mode"},
+			},
+			expectedCalls: []string{"echo read"},
+		},
+		{
+			name: "calls go through the middleware of the server",
+			opts: []server.ServerOption{WithDryRun()},
+			requestArgs: map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"tool": "label", "arguments": map[string]interface{}{"issue_number": float64(3)}},
+				},
+			},
+			expectedResults: []map[string]any{
+				textResult("label", "Dry run, nothing was changed: would label issue 3"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, calls := newBatchTestServer(t, tc.readOnly, tc.opts...)
+			assert.Equal(t, tc.expectedResults, callBatch(t, s, tc.requestArgs))
+			assert.Equal(t, tc.expectedCalls, *calls)
+		})
+	}
+}
+
+func Test_BatchExecute_Concurrent(t *testing.T) {
+	s, _ := newBatchTestServer(t, false)
+
+	// Every call of wait blocks until all three are running, which only happens concurrently
+	var started sync.WaitGroup
+	started.Add(3)
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+	s.AddTool(mcp.NewTool("wait",
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)}),
+		mcp.WithString("message"),
+	), func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started.Done()
+		select {
+		case <-allStarted:
+		case <-time.After(5 * time.Second):
+			return mcp.NewToolResultError("calls were not concurrent"), nil
+		}
+		message, _ := request.GetArguments()["message"].(string)
+		return mcp.NewToolResultText(message), nil
+	})
+
+	results := callBatch(t, s, map[string]interface{}{
+		"calls": []interface{}{
+			map[string]interface{}{"tool": "wait", "arguments": map[string]interface{}{"message": "a"}},
+			map[string]interface{}{"tool": "wait", "arguments": map[string]interface{}{"message": "b"}},
+			map[string]interface{}{"tool": "wait", "arguments": map[string]interface{}{"message": "c"}},
+		},
+		"concurrent": true,
+	})
+	// Results are in the order of the calls however they finish
+	assert.Equal(t, []map[string]any{
+		textResult("wait", "a"),
+		textResult("wait", "b"),
+		textResult("wait", "c"),
+	}, results)
+}
+
+func Test_BatchExecute_MaxItems(t *testing.T) {
+	s, _ := newBatchTestServer(t, false, WithMaxItems(2))
+	s.AddTool(mcp.NewTool("list_numbers",
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)}),
+		WithPagination(),
+	), func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("[1,2,3]"), nil
+	})
+
+	// The calls of the batch are capped one by one, the results of the batch itself are not trimmed
+	results := callBatch(t, s, map[string]interface{}{
+		"calls": []interface{}{
+			map[string]interface{}{"tool": "echo", "arguments": map[string]interface{}{"message": "a"}},
+			map[string]interface{}{"tool": "echo", "arguments": map[string]interface{}{"message": "[1,2,3]"}},
+			map[string]interface{}{"tool": "list_numbers"},
+		},
+	})
+	assert.Equal(t, []map[string]any{
+		textResult("echo", "a"),
+		textResult("echo", "[1,2,3]"),
+		{
+			"tool":     "list_numbers",
+			"is_error": false,
+			"content": []any{
+				map[string]any{"type": "text", "text": "[1,2]"},
+				map[string]any{"type": "text", "text": cappedNote(2)},
+			},
+		},
+	}, results)
+}
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// requiredStatusChecksSummary is the result of get_required_status_checks and update_required_status_checks.
+type requiredStatusChecksSummary struct {
+	Branch   string   `json:"branch"`
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+// requiredCheckContexts returns the names of the required checks, once each. Required checks may be
+// listed by context name or as check objects depending on how they were configured.
+func requiredCheckContexts(checks *github.RequiredStatusChecks) []string {
+	contexts := []string{}
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			contexts = append(contexts, name)
+		}
+	}
+	if checks.Contexts != nil {
+		for _, name := range *checks.Contexts {
+			add(name)
+		}
+	}
+	if checks.Checks != nil {
+		for _, check := range *checks.Checks {
+			add(check.Context)
+		}
+	}
+	return contexts
+}
+
+// summarizeRequiredStatusChecks converts the required status checks of branch to their compact form.
+func summarizeRequiredStatusChecks(branch string, checks *github.RequiredStatusChecks) requiredStatusChecksSummary {
+	return requiredStatusChecksSummary{
+		Branch:   branch,
+		Strict:   checks.Strict,
+		Contexts: requiredCheckContexts(checks),
+	}
+}
+
+// requiredStatusChecksNotFound is the tool error for a branch that is missing, not protected, or
+// does not require status checks, which the API all answers with a 404.
+func requiredStatusChecksNotFound(owner, repo, branch string) *mcp.CallToolResult {
+	return mcp.NewToolResultError(fmt.Sprintf("branch %s in %s/%s does not require status checks, check that it exists and is protected with required status checks", branch, owner, repo))
+}
+
+// GetRequiredStatusChecks creates a tool to get the status checks a protected branch requires.
+func GetRequiredStatusChecks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_required_status_checks",
+			mcp.WithDescription(t("TOOL_GET_REQUIRED_STATUS_CHECKS_DESCRIPTION", "Get the status checks that must pass before merging into a protected branch of a GitHub repository, and whether branches must be up to date with it (strict)")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REQUIRED_STATUS_CHECKS_USER_TITLE", "Get required status checks"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the protected branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			checks, resp, err := client.Repositories.GetRequiredStatusChecks(ctx, owner, repo, branch)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return requiredStatusChecksNotFound(owner, repo, branch), nil
+				}
+				return nil, fmt.Errorf("failed to get required status checks: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get required status checks: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeRequiredStatusChecks(branch, checks))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateRequiredStatusChecks creates a tool to change the status checks a protected branch requires.
+func UpdateRequiredStatusChecks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_required_status_checks",
+			mcp.WithDescription(t("TOOL_UPDATE_REQUIRED_STATUS_CHECKS_DESCRIPTION", "Change the status checks that must pass before merging into a protected branch of a GitHub repository, or whether branches must be up to date with it. The contexts given replace the required checks")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_REQUIRED_STATUS_CHECKS_USER_TITLE", "Update required status checks"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the protected branch"),
+			),
+			mcp.WithBoolean("strict",
+				mcp.Description("Require branches to be up to date with the branch before merging"),
+			),
+			mcp.WithArray("contexts",
+				mcp.Description("Names of the checks that must pass, replacing the required checks"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			checksRequest := &github.RequiredStatusChecksRequest{}
+			if strict, ok, err := OptionalParamOK[bool](request, "strict"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				checksRequest.Strict = github.Ptr(strict)
+			}
+			if _, ok := request.GetArguments()["contexts"]; ok {
+				contexts, err := OptionalStringArrayParam(request, "contexts")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if len(contexts) == 0 {
+					return mcp.NewToolResultError("contexts must name at least one check"), nil
+				}
+				for _, name := range contexts {
+					checksRequest.Checks = append(checksRequest.Checks, &github.RequiredStatusCheck{Context: name})
+				}
+			}
+			if checksRequest.Strict == nil && checksRequest.Checks == nil {
+				return mcp.NewToolResultError("no fields to update, give at least one of strict or contexts"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("update the required status checks of branch %s in %s/%s", branch, owner, repo), nil
+			}
+			checks, resp, err := client.Repositories.UpdateRequiredStatusChecks(ctx, owner, repo, branch, checksRequest)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return requiredStatusChecksNotFound(owner, repo, branch), nil
+				}
+				return nil, fmt.Errorf("failed to update required status checks: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update required status checks: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeRequiredStatusChecks(branch, checks))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRequiredStatusChecks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRequiredStatusChecks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_required_status_checks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult requiredStatusChecksSummary
+		expectedErrMsg string
+	}{
+		{
+			name: "contexts and checks of a protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/branches/main/protection/required_status_checks", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+							Strict:   true,
+							Contexts: &[]string{"ci/build", "ci/test"},
+							Checks: &[]*github.RequiredStatusCheck{
+								{Context: "ci/build"},
+								{Context: "lint", AppID: github.Ptr(int64(15368))},
+							},
+						})(w, r)
+					}),
+				),
+			),
+			expectedResult: requiredStatusChecksSummary{
+				Branch:   "main",
+				Strict:   true,
+				Contexts: []string{"ci/build", "ci/test", "lint"},
+			},
+		},
+		{
+			name: "branch not protected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "branch main in owner/repo does not require status checks, check that it exists and is protected with required status checks",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRequiredStatusChecks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned requiredStatusChecksSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdateRequiredStatusChecks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRequiredStatusChecks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_required_status_checks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "strict")
+	assert.Contains(t, tool.InputSchema.Properties, "contexts")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult requiredStatusChecksSummary
+		expectedErrMsg string
+	}{
+		{
+			name: "replace the contexts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"checks": []any{
+							map[string]any{"context": "ci/build"},
+							map[string]any{"context": "ci/e2e"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+							Strict: true,
+							Checks: &[]*github.RequiredStatusCheck{
+								{Context: "ci/build"},
+								{Context: "ci/e2e"},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "main",
+				"contexts": []interface{}{"ci/build", "ci/e2e"},
+			},
+			expectedResult: requiredStatusChecksSummary{
+				Branch:   "main",
+				Strict:   true,
+				Contexts: []string{"ci/build", "ci/e2e"},
+			},
+		},
+		{
+			name: "turn strict off",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"strict": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RequiredStatusChecks{
+							Contexts: &[]string{"ci/build"},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"strict": false,
+			},
+			expectedResult: requiredStatusChecksSummary{
+				Branch:   "main",
+				Contexts: []string{"ci/build"},
+			},
+		},
+		{
+			name: "branch not protected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposBranchesProtectionRequiredStatusChecksByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not protected"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"strict": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "branch main in owner/repo does not require status checks, check that it exists and is protected with required status checks",
+		},
+		{
+			name:         "empty contexts",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "main",
+				"contexts": []interface{}{},
+			},
+			expectError:    true,
+			expectedErrMsg: "contexts must name at least one check",
+		},
+		{
+			name:         "no fields to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "no fields to update, give at least one of strict or contexts",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRequiredStatusChecks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned requiredStatusChecksSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
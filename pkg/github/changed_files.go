@@ -0,0 +1,192 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// compareFilesCap is the number of changed files GitHub lists for a comparison at most,
+	// whatever the page.
+	compareFilesCap = 300
+	// maxComparePages caps the number of comparison pages list_changed_files_in_range follows.
+	maxComparePages = 10
+)
+
+// changedFile is the aggregate change made to a file by the commits of a range.
+type changedFile struct {
+	Path         string `json:"path"`
+	PreviousPath string `json:"previous_path,omitempty"`
+	Status       string `json:"status"`
+	Additions    int    `json:"additions"`
+	Deletions    int    `json:"deletions"`
+}
+
+// changedFilesSummary is the result of list_changed_files_in_range. Truncated is set when GitHub
+// capped the files of the comparison, or pages were left past maxComparePages, so files are missing.
+type changedFilesSummary struct {
+	Base         string        `json:"base"`
+	Head         string        `json:"head"`
+	TotalCommits int           `json:"total_commits"`
+	TotalFiles   int           `json:"total_files"`
+	Additions    int           `json:"additions"`
+	Deletions    int           `json:"deletions"`
+	Files        []changedFile `json:"files"`
+	Truncated    bool          `json:"truncated,omitempty"`
+	Note         string        `json:"note,omitempty"`
+}
+
+// changedFileSet aggregates the files of the pages of a comparison by path.
+type changedFileSet struct {
+	files map[string]*changedFile
+}
+
+// add adds the changes of file to the set.
+func (s *changedFileSet) add(file *github.CommitFile) {
+	if s.files == nil {
+		s.files = make(map[string]*changedFile)
+	}
+	changed, ok := s.files[file.GetFilename()]
+	if !ok {
+		changed = &changedFile{
+			Path:         file.GetFilename(),
+			PreviousPath: file.GetPreviousFilename(),
+			Status:       file.GetStatus(),
+		}
+		s.files[file.GetFilename()] = changed
+	}
+	changed.Additions += file.GetAdditions()
+	changed.Deletions += file.GetDeletions()
+}
+
+// summarize returns the files of the set sorted by path, with the range they were changed in.
+func (s *changedFileSet) summarize(base, head string, totalCommits int) changedFilesSummary {
+	summary := changedFilesSummary{
+		Base:         base,
+		Head:         head,
+		TotalCommits: totalCommits,
+		TotalFiles:   len(s.files),
+		Files:        make([]changedFile, 0, len(s.files)),
+	}
+	for _, file := range s.files {
+		summary.Additions += file.Additions
+		summary.Deletions += file.Deletions
+		summary.Files = append(summary.Files, *file)
+	}
+	sort.Slice(summary.Files, func(i, j int) bool {
+		return summary.Files[i].Path < summary.Files[j].Path
+	})
+	if summary.TotalFiles >= compareFilesCap {
+		summary.Truncated = true
+		summary.Note = fmt.Sprintf("GitHub lists at most %d changed files of a comparison, narrow the range to see the other files", compareFilesCap)
+	}
+	return summary
+}
+
+// ListChangedFilesInRange creates a tool to list the files changed between two refs of a repository.
+func ListChangedFilesInRange(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_changed_files_in_range",
+			mcp.WithDescription(t("TOOL_LIST_CHANGED_FILES_IN_RANGE_DESCRIPTION", fmt.Sprintf("List the files changed between two branches, tags or commits of a GitHub repository, e.g. the files a branch changes, with the additions and deletions of each file over the whole range. GitHub lists at most %d files of a range, the result is flagged truncated when it hits the cap", compareFilesCap))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CHANGED_FILES_IN_RANGE_USER_TITLE", "List changed files in range"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var files changedFileSet
+			totalCommits := 0
+			opts := &github.ListOptions{PerPage: 100}
+			pagesLeft := false
+			for page := 1; ; page++ {
+				comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("cannot compare %s...%s in %s/%s, check that both refs exist", base, head, owner, repo)), nil
+					}
+					return nil, fmt.Errorf("failed to compare commits: %w", err)
+				}
+
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					_ = resp.Body.Close()
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to compare commits: %s", string(body))), nil
+				}
+				_ = resp.Body.Close()
+
+				totalCommits = comparison.GetTotalCommits()
+				for _, file := range comparison.Files {
+					files.add(file)
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				if page == maxComparePages {
+					pagesLeft = true
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			summary := files.summarize(base, head, totalCommits)
+			if pagesLeft && !summary.Truncated {
+				summary.Truncated = true
+				summary.Note = fmt.Sprintf("Only the first %d pages of the comparison were read, narrow the range to see the other files", maxComparePages)
+			}
+			r, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListChangedFilesInRange(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListChangedFilesInRange(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_changed_files_in_range", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	// pagedComparison serves the comparison of main...feature over two pages
+	pagedComparison := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/compare/main...feature", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/compare/main...feature?page=2&per_page=100>; rel="next"`)
+			mockResponse(t, http.StatusOK, &github.CommitsComparison{
+				TotalCommits: github.Ptr(120),
+				Files: []*github.CommitFile{
+					{Filename: github.Ptr("pkg/server.go"), Status: github.Ptr("modified"), Additions: github.Ptr(10), Deletions: github.Ptr(4)},
+					{Filename: github.Ptr("README.md"), Status: github.Ptr("modified"), Additions: github.Ptr(2), Deletions: github.Ptr(1)},
+					{Filename: github.Ptr("pkg/new.go"), PreviousFilename: github.Ptr("pkg/old.go"), Status: github.Ptr("renamed"), Additions: github.Ptr(1), Deletions: github.Ptr(1)},
+				},
+			})(w, r)
+		case "2":
+			mockResponse(t, http.StatusOK, &github.CommitsComparison{
+				TotalCommits: github.Ptr(120),
+				Files: []*github.CommitFile{
+					{Filename: github.Ptr("pkg/server.go"), Status: github.Ptr("modified"), Additions: github.Ptr(5), Deletions: github.Ptr(2)},
+				},
+			})(w, r)
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	})
+
+	cappedFiles := make([]*github.CommitFile, 0, compareFilesCap)
+	for i := 0; i < compareFilesCap; i++ {
+		cappedFiles = append(cappedFiles, &github.CommitFile{
+			Filename:  github.Ptr(fmt.Sprintf("gen/file%03d.go", i)),
+			Status:    github.Ptr("added"),
+			Additions: github.Ptr(1),
+		})
+	}
+
+	// endlessComparison always has a next page, with a file of its own
+	endlessComparison := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		next, err := strconv.Atoi(page)
+		require.NoError(t, err)
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/repos/owner/repo/compare/main...feature?page=%d&per_page=100>; rel="next"`, next+1))
+		mockResponse(t, http.StatusOK, &github.CommitsComparison{
+			TotalCommits: github.Ptr(5000),
+			Files: []*github.CommitFile{
+				{Filename: github.Ptr(fmt.Sprintf("page%s.go", page)), Status: github.Ptr("added"), Additions: github.Ptr(1)},
+			},
+		})(w, r)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult *changedFilesSummary
+		expectedPages  int
+		expectedErrMsg string
+	}{
+		{
+			name: "aggregates the files of every page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCompareByOwnerByRepoByBasehead, pagedComparison),
+			),
+			expectedResult: &changedFilesSummary{
+				Base:         "main",
+				Head:         "feature",
+				TotalCommits: 120,
+				TotalFiles:   3,
+				Additions:    18,
+				Deletions:    8,
+				Files: []changedFile{
+					{Path: "README.md", Status: "modified", Additions: 2, Deletions: 1},
+					{Path: "pkg/new.go", PreviousPath: "pkg/old.go", Status: "renamed", Additions: 1, Deletions: 1},
+					{Path: "pkg/server.go", Status: "modified", Additions: 15, Deletions: 6},
+				},
+			},
+		},
+		{
+			name: "flags the files capped by GitHub",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					&github.CommitsComparison{TotalCommits: github.Ptr(3), Files: cappedFiles},
+				),
+			),
+		},
+		{
+			name: "flags the pages left past the page cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCompareByOwnerByRepoByBasehead, endlessComparison),
+			),
+			expectedPages: maxComparePages,
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "cannot compare main...feature in owner/repo, check that both refs exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListChangedFilesInRange(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned changedFilesSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			if tc.expectedResult != nil {
+				assert.Equal(t, *tc.expectedResult, returned)
+				return
+			}
+			if tc.expectedPages != 0 {
+				assert.True(t, returned.Truncated)
+				assert.Equal(t, "Only the first 10 pages of the comparison were read, narrow the range to see the other files", returned.Note)
+				assert.Equal(t, tc.expectedPages, returned.TotalFiles)
+				return
+			}
+			// The capped comparison
+			assert.True(t, returned.Truncated)
+			assert.NotEmpty(t, returned.Note)
+			assert.Equal(t, compareFilesCap, returned.TotalFiles)
+			assert.Equal(t, compareFilesCap, returned.Additions)
+			assert.Equal(t, "gen/file000.go", returned.Files[0].Path)
+		})
+	}
+}
@@ -0,0 +1,441 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	changeCategoryFeature = "features"
+	changeCategoryFix     = "fixes"
+	changeCategoryOther   = "other"
+
+	// defaultMaxChangelogCommits caps the commits inspected by summarize_changes_since, since each
+	// one costs a pull request lookup. The compare API itself returns at most 250 commits.
+	defaultMaxChangelogCommits = 100
+	maxChangelogCommits        = 250
+)
+
+// conventionalCommitPattern matches the type of a conventional commit subject, e.g. "feat(api)!: ...".
+var conventionalCommitPattern = regexp.MustCompile(`^(\w+)(\([^)]*\))?!?:`)
+
+// changelogEntry is a single change in a summarize_changes_since result. Changes that went through
+// a pull request are reported once per pull request, other commits are reported on their own.
+type changelogEntry struct {
+	PullRequest int    `json:"pull_request,omitempty"`
+	SHA         string `json:"sha,omitempty"`
+	Title       string `json:"title"`
+	Author      string `json:"author"`
+	URL         string `json:"url"`
+}
+
+// changelogSummary is the categorized result of summarize_changes_since.
+type changelogSummary struct {
+	Base             string           `json:"base"`
+	Head             string           `json:"head"`
+	TotalCommits     int              `json:"total_commits"`
+	ProcessedCommits int              `json:"processed_commits"`
+	Truncated        bool             `json:"truncated"`
+	Features         []changelogEntry `json:"features"`
+	Fixes            []changelogEntry `json:"fixes"`
+	Other            []changelogEntry `json:"other"`
+}
+
+// categorizeChange decides whether a change is a feature, a fix or something else. Labels take
+// precedence over the conventional-commit prefix of the title, which covers repos that only use one.
+func categorizeChange(title string, labels []string) string {
+	for _, label := range labels {
+		switch strings.ToLower(label) {
+		case "feature", "enhancement", "feat", "type: feature", "kind/feature":
+			return changeCategoryFeature
+		case "bug", "fix", "bugfix", "type: bug", "kind/bug":
+			return changeCategoryFix
+		}
+	}
+
+	if match := conventionalCommitPattern.FindStringSubmatch(strings.TrimSpace(title)); match != nil {
+		switch strings.ToLower(match[1]) {
+		case "feat", "feature":
+			return changeCategoryFeature
+		case "fix", "bugfix", "hotfix":
+			return changeCategoryFix
+		}
+	}
+	return changeCategoryOther
+}
+
+// firstMergedPullRequest returns the first merged pull request of prs, the pull requests
+// containing a commit, or nil when none of them was merged.
+func firstMergedPullRequest(prs []*github.PullRequest) *github.PullRequest {
+	for _, pr := range prs {
+		if pr.MergedAt != nil {
+			return pr
+		}
+	}
+	return nil
+}
+
+// SummarizeChangesSince creates a tool to summarize the changes merged since a tag, grouped for a changelog.
+func SummarizeChangesSince(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("summarize_changes_since",
+			mcp.WithDescription(t("TOOL_SUMMARIZE_CHANGES_SINCE_DESCRIPTION", "Summarize the changes on the default branch of a GitHub repository since a tag or commit, grouped into features, fixes and other changes based on pull request labels and conventional-commit prefixes. Useful for drafting release notes")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUMMARIZE_CHANGES_SINCE_USER_TITLE", "Summarize changes since a release"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Tag, branch or commit SHA to summarize changes since, usually the previous release tag"),
+			),
+			mcp.WithString("head",
+				mcp.Description("Branch, tag or commit SHA to summarize changes up to (defaults to the default branch)"),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description(fmt.Sprintf("Maximum number of commits to inspect, newest first (default %d, max %d)", defaultMaxChangelogCommits, maxChangelogCommits)),
+				mcp.Min(1),
+				mcp.Max(maxChangelogCommits),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := OptionalParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(request, "max_commits", defaultMaxChangelogCommits)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxCommits < 1 || maxCommits > maxChangelogCommits {
+				return mcp.NewToolResultError(fmt.Sprintf("max_commits must be between 1 and %d", maxChangelogCommits)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if head == "" {
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get repository: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+				head = repository.GetDefaultBranch()
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compare commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare commits: %s", string(body))), nil
+			}
+
+			// The comparison lists commits oldest first, keep the newest ones when capping
+			commits := comparison.Commits
+			if len(commits) > maxCommits {
+				commits = commits[len(commits)-maxCommits:]
+			}
+
+			summary := changelogSummary{
+				Base:             base,
+				Head:             head,
+				TotalCommits:     comparison.GetTotalCommits(),
+				ProcessedCommits: len(commits),
+				Truncated:        comparison.GetTotalCommits() > len(commits),
+				Features:         []changelogEntry{},
+				Fixes:            []changelogEntry{},
+				Other:            []changelogEntry{},
+			}
+			add := func(category string, entry changelogEntry) {
+				switch category {
+				case changeCategoryFeature:
+					summary.Features = append(summary.Features, entry)
+				case changeCategoryFix:
+					summary.Fixes = append(summary.Fixes, entry)
+				default:
+					summary.Other = append(summary.Other, entry)
+				}
+			}
+
+			seen := make(map[int]bool)
+			for _, commit := range commits {
+				prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, commit.GetSHA(), nil)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list pull requests for commit %s: %w", commit.GetSHA(), err)
+				}
+				_ = resp.Body.Close()
+
+				merged := firstMergedPullRequest(prs)
+				if merged == nil {
+					title, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+					add(categorizeChange(title, nil), changelogEntry{
+						SHA:    commit.GetSHA(),
+						Title:  title,
+						Author: commit.GetAuthor().GetLogin(),
+						URL:    commit.GetHTMLURL(),
+					})
+					continue
+				}
+
+				if seen[merged.GetNumber()] {
+					continue
+				}
+				seen[merged.GetNumber()] = true
+
+				labels := make([]string, 0, len(merged.Labels))
+				for _, label := range merged.Labels {
+					labels = append(labels, label.GetName())
+				}
+				add(categorizeChange(merged.GetTitle(), labels), changelogEntry{
+					PullRequest: merged.GetNumber(),
+					Title:       merged.GetTitle(),
+					Author:      merged.GetUser().GetLogin(),
+					URL:         merged.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// releasePullRequest is a pull request merged between two releases.
+type releasePullRequest struct {
+	Number   int        `json:"number"`
+	Title    string     `json:"title"`
+	Author   string     `json:"author"`
+	Labels   []string   `json:"labels"`
+	MergedAt *time.Time `json:"merged_at,omitempty"`
+	Commits  int        `json:"commits"`
+	URL      string     `json:"url"`
+}
+
+// releasePullRequests is the result of list_merged_pull_requests_between_releases.
+type releasePullRequests struct {
+	FromTag          string               `json:"from_tag"`
+	ToTag            string               `json:"to_tag"`
+	TotalCommits     int                  `json:"total_commits"`
+	ProcessedCommits int                  `json:"processed_commits"`
+	Truncated        bool                 `json:"truncated"`
+	PullRequests     []releasePullRequest `json:"pull_requests"`
+	Contributors     []string             `json:"contributors"`
+	// DirectCommits are the commits that did not go through a merged pull request
+	DirectCommits []string `json:"direct_commits"`
+}
+
+// releasePullRequestSet collects the merged pull requests of the commits of a range, each pull
+// request once however many of its commits are in the range, in the order they are first seen.
+type releasePullRequestSet struct {
+	pullRequests []releasePullRequest
+	index        map[int]int
+}
+
+func (s *releasePullRequestSet) add(pr *github.PullRequest) {
+	if i, ok := s.index[pr.GetNumber()]; ok {
+		s.pullRequests[i].Commits++
+		return
+	}
+	if s.index == nil {
+		s.index = make(map[int]int)
+	}
+	labels := make([]string, 0, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels = append(labels, label.GetName())
+	}
+	entry := releasePullRequest{
+		Number:  pr.GetNumber(),
+		Title:   pr.GetTitle(),
+		Author:  pr.GetUser().GetLogin(),
+		Labels:  labels,
+		Commits: 1,
+		URL:     pr.GetHTMLURL(),
+	}
+	if pr.MergedAt != nil {
+		entry.MergedAt = &pr.MergedAt.Time
+	}
+	s.index[pr.GetNumber()] = len(s.pullRequests)
+	s.pullRequests = append(s.pullRequests, entry)
+}
+
+// contributors returns the authors of the pull requests, sorted and without duplicates.
+func (s *releasePullRequestSet) contributors() []string {
+	seen := make(map[string]bool)
+	authors := []string{}
+	for _, pr := range s.pullRequests {
+		if pr.Author != "" && !seen[pr.Author] {
+			seen[pr.Author] = true
+			authors = append(authors, pr.Author)
+		}
+	}
+	sort.Strings(authors)
+	return authors
+}
+
+// ListMergedPullRequestsBetweenReleases creates a tool to list the pull requests merged between two tags.
+func ListMergedPullRequestsBetweenReleases(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_merged_pull_requests_between_releases",
+			mcp.WithDescription(t("TOOL_LIST_MERGED_PULL_REQUESTS_BETWEEN_RELEASES_DESCRIPTION", "List the pull requests merged between two release tags of a GitHub repository, with their authors and labels, and the contributors of the release. Useful for assembling changelogs and contributor lists")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_MERGED_PULL_REQUESTS_BETWEEN_RELEASES_USER_TITLE", "List pull requests merged between releases"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("from_tag",
+				mcp.Required(),
+				mcp.Description("Tag of the previous release"),
+			),
+			mcp.WithString("to_tag",
+				mcp.Required(),
+				mcp.Description("Tag of the release"),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description(fmt.Sprintf("Maximum number of commits to inspect, newest first (default %d, max %d)", defaultMaxChangelogCommits, maxChangelogCommits)),
+				mcp.Min(1),
+				mcp.Max(maxChangelogCommits),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fromTag, err := requiredParam[string](request, "from_tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			toTag, err := requiredParam[string](request, "to_tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(request, "max_commits", defaultMaxChangelogCommits)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxCommits < 1 || maxCommits > maxChangelogCommits {
+				return mcp.NewToolResultError(fmt.Sprintf("max_commits must be between 1 and %d", maxChangelogCommits)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, fromTag, toTag, nil)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("tag %s or %s not found in %s/%s", fromTag, toTag, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to compare tags: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare tags: %s", string(body))), nil
+			}
+			if comparison.GetStatus() == "behind" {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is older than %s, swap from_tag and to_tag", toTag, fromTag)), nil
+			}
+
+			// The comparison lists commits oldest first, keep the newest ones when capping
+			commits := comparison.Commits
+			if len(commits) > maxCommits {
+				commits = commits[len(commits)-maxCommits:]
+			}
+
+			var pullRequests releasePullRequestSet
+			directCommits := []string{}
+			for _, commit := range commits {
+				prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, commit.GetSHA(), nil)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list pull requests for commit %s: %w", commit.GetSHA(), err)
+				}
+				_ = resp.Body.Close()
+
+				merged := firstMergedPullRequest(prs)
+				if merged == nil {
+					directCommits = append(directCommits, commit.GetSHA())
+					continue
+				}
+				pullRequests.add(merged)
+			}
+
+			result := releasePullRequests{
+				FromTag:          fromTag,
+				ToTag:            toTag,
+				TotalCommits:     comparison.GetTotalCommits(),
+				ProcessedCommits: len(commits),
+				Truncated:        comparison.GetTotalCommits() > len(commits),
+				PullRequests:     pullRequests.pullRequests,
+				Contributors:     pullRequests.contributors(),
+				DirectCommits:    directCommits,
+			}
+			if result.PullRequests == nil {
+				result.PullRequests = []releasePullRequest{}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
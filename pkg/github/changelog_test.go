@@ -0,0 +1,388 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CategorizeChange(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		labels   []string
+		expected string
+	}{
+		{name: "conventional feature", title: "feat: add dark mode", expected: changeCategoryFeature},
+		{name: "conventional feature with scope and breaking marker", title: "feat(api)!: drop v1 endpoints", expected: changeCategoryFeature},
+		{name: "conventional fix", title: "fix(parser): handle empty input", expected: changeCategoryFix},
+		{name: "conventional chore", title: "chore: bump dependencies", expected: changeCategoryOther},
+		{name: "plain message", title: "Update README", expected: changeCategoryOther},
+		{name: "bug label", title: "Handle nil pointer", labels: []string{"bug"}, expected: changeCategoryFix},
+		{name: "enhancement label is case insensitive", title: "Support YAML", labels: []string{"Enhancement"}, expected: changeCategoryFeature},
+		{name: "label wins over prefix", title: "fix: typo in new flag", labels: []string{"documentation", "feature"}, expected: changeCategoryFeature},
+		{name: "unrelated labels fall back to prefix", title: "fix: off by one", labels: []string{"documentation"}, expected: changeCategoryFix},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, categorizeChange(tc.title, tc.labels))
+		})
+	}
+}
+
+func Test_SummarizeChangesSince(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SummarizeChangesSince(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "summarize_changes_since", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "max_commits")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base"})
+
+	mergedAt := &github.Timestamp{Time: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)}
+
+	mockComparison := &github.CommitsComparison{
+		TotalCommits: github.Ptr(4),
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.Ptr("sha1"), Commit: &github.Commit{Message: github.Ptr("feat: add search (#10)")}},
+			{SHA: github.Ptr("sha2"), Commit: &github.Commit{Message: github.Ptr("Address review feedback")}},
+			{
+				SHA:     github.Ptr("sha3"),
+				Commit:  &github.Commit{Message: github.Ptr("fix: crash on startup\n\nLonger description")},
+				Author:  &github.User{Login: github.Ptr("committer")},
+				HTMLURL: github.Ptr("https://github.com/owner/repo/commit/sha3"),
+			},
+			{SHA: github.Ptr("sha4"), Commit: &github.Commit{Message: github.Ptr("Handle nil config (#11)")}},
+		},
+	}
+
+	prsByCommit := map[string][]*github.PullRequest{
+		// Two commits of the same PR must only be reported once
+		"sha1": {{Number: github.Ptr(10), Title: github.Ptr("feat: add search"), MergedAt: mergedAt, User: &github.User{Login: github.Ptr("alice")}}},
+		"sha2": {{Number: github.Ptr(10), Title: github.Ptr("feat: add search"), MergedAt: mergedAt, User: &github.User{Login: github.Ptr("alice")}}},
+		"sha3": {},
+		"sha4": {{
+			Number:   github.Ptr(11),
+			Title:    github.Ptr("Handle nil config"),
+			MergedAt: mergedAt,
+			User:     &github.User{Login: github.Ptr("bob")},
+			Labels:   []*github.Label{{Name: github.Ptr("bug")}},
+		}},
+	}
+
+	commitPullsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		sha := parts[len(parts)-2]
+		w.WriteHeader(http.StatusOK)
+		b, _ := json.Marshal(prsByCommit[sha])
+		_, _ = w.Write(b)
+	})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedSummary changelogSummary
+		expectedErrMsg  string
+	}{
+		{
+			name: "mixed commits and pull requests are categorized",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{DefaultBranch: github.Ptr("main")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/compare/v1.0.0...main", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						b, _ := json.Marshal(mockComparison)
+						_, _ = w.Write(b)
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					commitPullsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.0.0",
+			},
+			expectError: false,
+			expectedSummary: changelogSummary{
+				Base:             "v1.0.0",
+				Head:             "main",
+				TotalCommits:     4,
+				ProcessedCommits: 4,
+				Truncated:        false,
+				Features:         []changelogEntry{{PullRequest: 10, Title: "feat: add search", Author: "alice"}},
+				Fixes: []changelogEntry{
+					{SHA: "sha3", Title: "fix: crash on startup", Author: "committer", URL: "https://github.com/owner/repo/commit/sha3"},
+					{PullRequest: 11, Title: "Handle nil config", Author: "bob"},
+				},
+				Other: []changelogEntry{},
+			},
+		},
+		{
+			name: "commits are capped to the newest ones",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockComparison,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					commitPullsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base":        "v1.0.0",
+				"head":        "release",
+				"max_commits": float64(1),
+			},
+			expectError: false,
+			expectedSummary: changelogSummary{
+				Base:             "v1.0.0",
+				Head:             "release",
+				TotalCommits:     4,
+				ProcessedCommits: 1,
+				Truncated:        true,
+				Features:         []changelogEntry{},
+				Fixes:            []changelogEntry{{PullRequest: 11, Title: "Handle nil config", Author: "bob"}},
+				Other:            []changelogEntry{},
+			},
+		},
+		{
+			name: "comparison fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v0.0.0",
+				"head":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to compare commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SummarizeChangesSince(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedSummary changelogSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSummary)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSummary, returnedSummary)
+		})
+	}
+}
+
+func Test_ReleasePullRequestSet(t *testing.T) {
+	alice := &github.PullRequest{Number: github.Ptr(10), Title: github.Ptr("Add search"), User: &github.User{Login: github.Ptr("alice")}}
+	bob := &github.PullRequest{Number: github.Ptr(11), Title: github.Ptr("Fix crash"), User: &github.User{Login: github.Ptr("bob")}, Labels: []*github.Label{{Name: github.Ptr("bug")}}}
+	aliceAgain := &github.PullRequest{Number: github.Ptr(12), Title: github.Ptr("Document search"), User: &github.User{Login: github.Ptr("alice")}}
+
+	var set releasePullRequestSet
+	for _, pr := range []*github.PullRequest{alice, bob, alice, aliceAgain, alice} {
+		set.add(pr)
+	}
+
+	assert.Equal(t, []releasePullRequest{
+		{Number: 10, Title: "Add search", Author: "alice", Labels: []string{}, Commits: 3},
+		{Number: 11, Title: "Fix crash", Author: "bob", Labels: []string{"bug"}, Commits: 1},
+		{Number: 12, Title: "Document search", Author: "alice", Labels: []string{}, Commits: 1},
+	}, set.pullRequests)
+	assert.Equal(t, []string{"alice", "bob"}, set.contributors())
+}
+
+func Test_ListMergedPullRequestsBetweenReleases(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMergedPullRequestsBetweenReleases(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_merged_pull_requests_between_releases", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "from_tag")
+	assert.Contains(t, tool.InputSchema.Properties, "to_tag")
+	assert.Contains(t, tool.InputSchema.Properties, "max_commits")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "from_tag", "to_tag"})
+
+	mergedAt := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	searchPR := &github.PullRequest{
+		Number:   github.Ptr(10),
+		Title:    github.Ptr("Add search"),
+		MergedAt: &github.Timestamp{Time: mergedAt},
+		User:     &github.User{Login: github.Ptr("alice")},
+		Labels:   []*github.Label{{Name: github.Ptr("enhancement")}},
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/pull/10"),
+	}
+	prsByCommit := map[string][]*github.PullRequest{
+		"sha1": {searchPR},
+		"sha2": {searchPR},
+		// A pull request into another branch that was closed without merging
+		"sha3": {{Number: github.Ptr(9), Title: github.Ptr("Backport"), User: &github.User{Login: github.Ptr("carol")}}},
+	}
+	commitPullsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		sha := parts[len(parts)-2]
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(mock.MustMarshal(prsByCommit[sha]))
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult releasePullRequests
+		expectedErrMsg string
+	}{
+		{
+			name: "pull requests of the range are deduplicated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/compare/v1.0.0...v1.1.0", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(&github.CommitsComparison{
+							Status:       github.Ptr("ahead"),
+							TotalCommits: github.Ptr(3),
+							Commits: []*github.RepositoryCommit{
+								{SHA: github.Ptr("sha1")},
+								{SHA: github.Ptr("sha2")},
+								{SHA: github.Ptr("sha3")},
+							},
+						}))
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					commitPullsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"from_tag": "v1.0.0",
+				"to_tag":   "v1.1.0",
+			},
+			expectedResult: releasePullRequests{
+				FromTag:          "v1.0.0",
+				ToTag:            "v1.1.0",
+				TotalCommits:     3,
+				ProcessedCommits: 3,
+				PullRequests: []releasePullRequest{
+					{Number: 10, Title: "Add search", Author: "alice", Labels: []string{"enhancement"}, MergedAt: &mergedAt, Commits: 2, URL: "https://github.com/owner/repo/pull/10"},
+				},
+				Contributors:  []string{"alice"},
+				DirectCommits: []string{"sha3"},
+			},
+		},
+		{
+			name: "tags in the wrong order",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					&github.CommitsComparison{Status: github.Ptr("behind"), TotalCommits: github.Ptr(0)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"from_tag": "v1.1.0",
+				"to_tag":   "v1.0.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "v1.0.0 is older than v1.1.0, swap from_tag and to_tag",
+		},
+		{
+			name: "unknown tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"from_tag": "v1.0.0",
+				"to_tag":   "v9.9.9",
+			},
+			expectError:    true,
+			expectedErrMsg: "tag v1.0.0 or v9.9.9 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListMergedPullRequestsBetweenReleases(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned releasePullRequests
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
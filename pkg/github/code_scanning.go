@@ -1,11 +1,18 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -18,7 +25,7 @@ func GetCodeScanningAlert(getClient GetClientFn, t translations.TranslationHelpe
 			mcp.WithDescription(t("TOOL_GET_CODE_SCANNING_ALERT_DESCRIPTION", "Get details of a specific code scanning alert in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_CODE_SCANNING_ALERT_USER_TITLE", "Get code scanning alert"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -80,7 +87,7 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			mcp.WithDescription(t("TOOL_LIST_CODE_SCANNING_ALERTS_DESCRIPTION", "List code scanning alerts in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_CODE_SCANNING_ALERTS_USER_TITLE", "List code scanning alerts"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -158,3 +165,430 @@ func ListCodeScanningAlerts(getClient GetClientFn, t translations.TranslationHel
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// codeScanningAnalysis is the compact form of a code scanning analysis, e.g. a CodeQL run.
+type codeScanningAnalysis struct {
+	ID           int64      `json:"id"`
+	Ref          string     `json:"ref"`
+	CommitSHA    string     `json:"commit_sha"`
+	Tool         string     `json:"tool"`
+	ToolVersion  string     `json:"tool_version,omitempty"`
+	Category     string     `json:"category,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	ResultsCount int        `json:"results_count"`
+	RulesCount   int        `json:"rules_count"`
+	SarifID      string     `json:"sarif_id,omitempty"`
+	Error        string     `json:"error,omitempty"`
+	Warning      string     `json:"warning,omitempty"`
+}
+
+// summarizeCodeScanningAnalysis converts a code scanning analysis to its compact form.
+func summarizeCodeScanningAnalysis(analysis *github.ScanningAnalysis) codeScanningAnalysis {
+	summary := codeScanningAnalysis{
+		ID:           analysis.GetID(),
+		Ref:          analysis.GetRef(),
+		CommitSHA:    analysis.GetCommitSHA(),
+		Tool:         analysis.GetTool().GetName(),
+		ToolVersion:  analysis.GetTool().GetVersion(),
+		Category:     analysis.GetCategory(),
+		ResultsCount: analysis.GetResultsCount(),
+		RulesCount:   analysis.GetRulesCount(),
+		SarifID:      analysis.GetSarifID(),
+		Error:        analysis.GetError(),
+		Warning:      analysis.GetWarning(),
+	}
+	if analysis.CreatedAt != nil {
+		summary.CreatedAt = &analysis.CreatedAt.Time
+	}
+	return summary
+}
+
+// ListCodeQLAnalyses creates a tool to list the code scanning analyses of a repository.
+func ListCodeQLAnalyses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_codeql_analyses",
+			mcp.WithDescription(t("TOOL_LIST_CODEQL_ANALYSES_DESCRIPTION", "List the code scanning analyses of a GitHub repository, such as CodeQL runs, most recent first, with the ref, commit, tool and number of results of each. Use get_codeql_analysis to download the SARIF of an analysis")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CODEQL_ANALYSES_USER_TITLE", "List CodeQL analyses"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only list the analyses of this ref, e.g. refs/heads/main or refs/pull/42/merge"),
+			),
+			mcp.WithString("sarif_id",
+				mcp.Description("Only list the analyses of this SARIF upload"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sarifID, err := OptionalParam[string](request, "sarif_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.AnalysesListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if ref != "" {
+				opts.Ref = github.Ptr(ref)
+			}
+			if sarifID != "" {
+				opts.SarifID = github.Ptr(sarifID)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			analyses, resp, err := client.CodeScanning.ListAnalysesForRepo(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("no code scanning analysis found in %s/%s, check that the repository exists and code scanning is set up", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list analyses: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list analyses: %s", string(body))), nil
+			}
+
+			summaries := make([]codeScanningAnalysis, 0, len(analyses))
+			for _, analysis := range analyses {
+				summaries = append(summaries, summarizeCodeScanningAnalysis(analysis))
+			}
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal analyses: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// sarifRuleCount is the number of results of a rule in a SARIF log.
+type sarifRuleCount struct {
+	RuleID string `json:"rule_id"`
+	Count  int    `json:"count"`
+}
+
+// sarifLog is the part of a SARIF log needed to count its results by rule.
+type sarifLog struct {
+	Runs []struct {
+		Results []struct {
+			RuleID string `json:"ruleId"`
+			Rule   struct {
+				ID string `json:"id"`
+			} `json:"rule"`
+		} `json:"results"`
+	} `json:"runs"`
+}
+
+// sarifRuleCounts counts the results of a SARIF log by rule, most frequent first.
+func sarifRuleCounts(sarif []byte) ([]sarifRuleCount, error) {
+	var log sarifLog
+	if err := json.Unmarshal(sarif, &log); err != nil {
+		return nil, fmt.Errorf("failed to parse SARIF: %w", err)
+	}
+	counts := map[string]int{}
+	for _, run := range log.Runs {
+		for _, result := range run.Results {
+			// A result names its rule by ruleId, or by rule.id only when the rule is given by index
+			ruleID := result.RuleID
+			if ruleID == "" {
+				ruleID = result.Rule.ID
+			}
+			counts[ruleID]++
+		}
+	}
+	ruleCounts := make([]sarifRuleCount, 0, len(counts))
+	for ruleID, count := range counts {
+		ruleCounts = append(ruleCounts, sarifRuleCount{RuleID: ruleID, Count: count})
+	}
+	sort.Slice(ruleCounts, func(i, j int) bool {
+		if ruleCounts[i].Count != ruleCounts[j].Count {
+			return ruleCounts[i].Count > ruleCounts[j].Count
+		}
+		return ruleCounts[i].RuleID < ruleCounts[j].RuleID
+	})
+	return ruleCounts, nil
+}
+
+// codeScanningAnalysisDetail is the result of get_codeql_analysis. The SARIF fields are set when
+// the SARIF was asked for.
+type codeScanningAnalysisDetail struct {
+	Analysis       codeScanningAnalysis `json:"analysis"`
+	RuleCounts     []sarifRuleCount     `json:"rule_counts,omitempty"`
+	Sarif          string               `json:"sarif,omitempty"`
+	SarifTruncated bool                 `json:"sarif_truncated,omitempty"`
+}
+
+// maxSARIFBytes caps the size of the SARIF log get_codeql_analysis downloads. The whole log is
+// needed to count its results, so larger logs are refused rather than truncated.
+const maxSARIFBytes = 10 * 1024 * 1024
+
+// downloadAnalysisSARIF fetches the SARIF log of a code scanning analysis, which the analysis
+// endpoint returns when asked for the SARIF media type. It reads one byte past maxSARIFBytes at
+// most, enough to tell the log is too large.
+func downloadAnalysisSARIF(ctx context.Context, client *github.Client, owner, repo string, analysisID int64) ([]byte, *github.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/code-scanning/analyses/%d", owner, repo, analysisID), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/sarif+json")
+	resp, err := client.BareDo(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	sarif, err := io.ReadAll(io.LimitReader(resp.Body, maxSARIFBytes+1))
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to read SARIF: %w", err)
+	}
+	return sarif, resp, nil
+}
+
+// GetCodeQLAnalysis creates a tool to get a code scanning analysis of a repository, optionally with its SARIF.
+func GetCodeQLAnalysis(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_codeql_analysis",
+			mcp.WithDescription(t("TOOL_GET_CODEQL_ANALYSIS_DESCRIPTION", fmt.Sprintf("Get a code scanning analysis of a GitHub repository, such as a CodeQL run: its ref, commit, tool and number of results. With sarif, also download its SARIF log, truncated to %d bytes, with the number of results of each rule", maxTextResultBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CODEQL_ANALYSIS_USER_TITLE", "Get CodeQL analysis"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithNumber("analysis_id",
+				mcp.Required(),
+				mcp.Description("The ID of the analysis, from list_codeql_analyses"),
+			),
+			mcp.WithBoolean("sarif",
+				mcp.Description(fmt.Sprintf("Also download the SARIF log of the analysis, refused when larger than %d bytes, default false", maxSARIFBytes)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			analysisID, err := RequiredInt(request, "analysis_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			withSARIF, err := OptionalParam[bool](request, "sarif")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			analysis, resp, err := client.CodeScanning.GetAnalysis(ctx, owner, repo, int64(analysisID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("analysis %d not found in %s/%s", analysisID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get analysis: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get analysis: %s", string(body))), nil
+			}
+
+			detail := codeScanningAnalysisDetail{Analysis: summarizeCodeScanningAnalysis(analysis)}
+			if withSARIF {
+				sarif, _, err := downloadAnalysisSARIF(ctx, client, owner, repo, int64(analysisID))
+				if err != nil {
+					return nil, fmt.Errorf("failed to download SARIF: %w", err)
+				}
+				if len(sarif) > maxSARIFBytes {
+					return mcp.NewToolResultError(fmt.Sprintf("the SARIF log of analysis %d is larger than %d bytes", analysisID, maxSARIFBytes)), nil
+				}
+				// Counted before truncating, since a truncated SARIF log is not valid JSON
+				detail.RuleCounts, err = sarifRuleCounts(sarif)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				detail.Sarif, detail.SarifTruncated = truncateText(string(sarif), maxTextResultBytes)
+			}
+
+			r, err := json.Marshal(detail)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal analysis: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// validateGzippedSARIF checks that sarif is a base64 encoded gzip stream, as the upload API
+// requires, so that a raw or corrupt SARIF log is refused before it is sent.
+func validateGzippedSARIF(sarif string) error {
+	compressed, err := base64.StdEncoding.DecodeString(sarif)
+	if err != nil {
+		return fmt.Errorf("sarif must be base64 encoded: %w", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return fmt.Errorf("sarif must be gzip compressed before being base64 encoded: %w", err)
+	}
+	// Reading to the end verifies the checksum of the stream
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return fmt.Errorf("sarif is not a valid gzip stream: %w", err)
+	}
+	return reader.Close()
+}
+
+// UploadSARIF creates a tool to upload the SARIF results of a code scanning tool to a repository.
+func UploadSARIF(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("upload_sarif",
+			mcp.WithDescription(t("TOOL_UPLOAD_SARIF_DESCRIPTION", "Upload the SARIF results of a code scanning tool, e.g. a third-party scanner run in CI, to a GitHub repository so that they show as code scanning alerts. GitHub processes the upload in the background, use list_codeql_analyses with the returned sarif_id to find the resulting analyses")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPLOAD_SARIF_USER_TITLE", "Upload SARIF"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("The owner of the repository."),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("The name of the repository."),
+			),
+			mcp.WithString("commit_sha",
+				mcp.Required(),
+				mcp.Description("Full SHA of the commit the results are for"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Full Git reference the results are for, e.g. refs/heads/main or refs/pull/42/merge"),
+			),
+			mcp.WithString("sarif",
+				mcp.Required(),
+				mcp.Description("The SARIF log, gzip compressed then base64 encoded"),
+			),
+			mcp.WithString("tool_name",
+				mcp.Description("Name of the tool that produced the results, overriding the one in the SARIF log"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitSHA, err := requiredParam[string](request, "commit_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !strings.HasPrefix(ref, "refs/") {
+				return mcp.NewToolResultError(fmt.Sprintf("ref must be a full Git reference such as refs/heads/%s", ref)), nil
+			}
+			sarif, err := requiredParam[string](request, "sarif")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateGzippedSARIF(sarif); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			toolName, err := OptionalParam[string](request, "tool_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			analysis := &github.SarifAnalysis{
+				CommitSHA: github.Ptr(commitSHA),
+				Ref:       github.Ptr(ref),
+				Sarif:     github.Ptr(sarif),
+			}
+			if toolName != "" {
+				analysis.ToolName = github.Ptr(toolName)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("upload SARIF results for %s at %s to %s/%s", ref, commitSHA, owner, repo), nil
+			}
+			sarifID, resp, err := client.CodeScanning.UploadSarif(ctx, owner, repo, analysis)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found, or code scanning is not available for it", owner, repo)), nil
+				case resp != nil && resp.StatusCode == http.StatusRequestEntityTooLarge:
+					return mcp.NewToolResultError("the SARIF upload is too large, GitHub accepts at most 10 MB of gzip compressed SARIF"), nil
+				case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp):
+					return mcp.NewToolResultError(fmt.Sprintf("cannot upload SARIF to %s/%s: %s", owner, repo, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to upload SARIF: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]string{
+				"sarif_id": sarifID.GetID(),
+				"url":      sarifID.GetURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal SARIF upload: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -1,9 +1,14 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -238,3 +243,427 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListCodeQLAnalyses(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCodeQLAnalyses(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_codeql_analyses", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "sarif_id")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockAnalyses := []*github.ScanningAnalysis{
+		{
+			ID:           github.Ptr(int64(201)),
+			Ref:          github.Ptr("refs/heads/main"),
+			CommitSHA:    github.Ptr("abc123"),
+			Category:     github.Ptr(".github/workflows/codeql.yml:analyze/language:go"),
+			ResultsCount: github.Ptr(3),
+			RulesCount:   github.Ptr(120),
+			SarifID:      github.Ptr("sarif-1"),
+			Tool:         &github.Tool{Name: github.Ptr("CodeQL"), Version: github.Ptr("2.17.0")},
+		},
+		{
+			ID:        github.Ptr(int64(200)),
+			Ref:       github.Ptr("refs/heads/main"),
+			CommitSHA: github.Ptr("def456"),
+			Tool:      &github.Tool{Name: github.Ptr("CodeQL")},
+			Error:     github.Ptr("extraction failed"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAnalyses []codeScanningAnalysis
+		expectedErrMsg   string
+	}{
+		{
+			name: "analyses of a ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAnalysesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"ref":      "refs/heads/main",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockAnalyses),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ref":     "refs/heads/main",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedAnalyses: []codeScanningAnalysis{
+				{
+					ID:           201,
+					Ref:          "refs/heads/main",
+					CommitSHA:    "abc123",
+					Tool:         "CodeQL",
+					ToolVersion:  "2.17.0",
+					Category:     ".github/workflows/codeql.yml:analyze/language:go",
+					ResultsCount: 3,
+					RulesCount:   120,
+					SarifID:      "sarif-1",
+				},
+				{
+					ID:        200,
+					Ref:       "refs/heads/main",
+					CommitSHA: "def456",
+					Tool:      "CodeQL",
+					Error:     "extraction failed",
+				},
+			},
+		},
+		{
+			name: "code scanning not set up",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAnalysesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "no analysis found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "no code scanning analysis found in owner/repo, check that the repository exists and code scanning is set up",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCodeQLAnalyses(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned []codeScanningAnalysis
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedAnalyses, returned)
+		})
+	}
+}
+
+func Test_GetCodeQLAnalysis(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeQLAnalysis(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_codeql_analysis", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "analysis_id")
+	assert.Contains(t, tool.InputSchema.Properties, "sarif")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "analysis_id"})
+
+	mockAnalysis := &github.ScanningAnalysis{
+		ID:           github.Ptr(int64(201)),
+		Ref:          github.Ptr("refs/heads/main"),
+		CommitSHA:    github.Ptr("abc123"),
+		ResultsCount: github.Ptr(3),
+		RulesCount:   github.Ptr(120),
+		Tool:         &github.Tool{Name: github.Ptr("CodeQL"), Version: github.Ptr("2.17.0")},
+	}
+	sarif := `{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"CodeQL"}},"results":[` +
+		`{"ruleId":"go/sql-injection","level":"error"},` +
+		`{"ruleId":"go/path-injection","level":"error"},` +
+		`{"ruleId":"go/sql-injection","level":"error"},` +
+		`{"rule":{"id":"go/log-injection","index":4},"level":"warning"}]}]}`
+	expectedAnalysis := codeScanningAnalysis{
+		ID:           201,
+		Ref:          "refs/heads/main",
+		CommitSHA:    "abc123",
+		Tool:         "CodeQL",
+		ToolVersion:  "2.17.0",
+		ResultsCount: 3,
+		RulesCount:   120,
+	}
+
+	// The SARIF is the same endpoint as the analysis, asked for another media type
+	analysisHandlerWithSARIF := func(sarif string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/repos/owner/repo/code-scanning/analyses/201", r.URL.Path)
+			if r.Header.Get("Accept") == "application/sarif+json" {
+				w.Header().Set("Content-Type", "application/sarif+json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(sarif))
+				return
+			}
+			mockResponse(t, http.StatusOK, mockAnalysis)(w, r)
+		}
+	}
+	analysisHandler := analysisHandlerWithSARIF(sarif)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedDetail codeScanningAnalysisDetail
+		expectedErrMsg string
+	}{
+		{
+			name: "analysis metadata",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisId, analysisHandler),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"analysis_id": float64(201),
+			},
+			expectedDetail: codeScanningAnalysisDetail{Analysis: expectedAnalysis},
+		},
+		{
+			name: "analysis with its SARIF",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisId, analysisHandler),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"analysis_id": float64(201),
+				"sarif":       true,
+			},
+			expectedDetail: codeScanningAnalysisDetail{
+				Analysis: expectedAnalysis,
+				RuleCounts: []sarifRuleCount{
+					{RuleID: "go/sql-injection", Count: 2},
+					{RuleID: "go/log-injection", Count: 1},
+					{RuleID: "go/path-injection", Count: 1},
+				},
+				Sarif: sarif,
+			},
+		},
+		{
+			name: "invalid SARIF",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisId, analysisHandlerWithSARIF(`{"runs":`)),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"analysis_id": float64(201),
+				"sarif":       true,
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to parse SARIF: unexpected end of JSON input",
+		},
+		{
+			name: "SARIF too large",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisId, analysisHandlerWithSARIF(strings.Repeat(" ", maxSARIFBytes+1))),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"analysis_id": float64(201),
+				"sarif":       true,
+			},
+			expectError:    true,
+			expectedErrMsg: fmt.Sprintf("the SARIF log of analysis 201 is larger than %d bytes", maxSARIFBytes),
+		},
+		{
+			name: "analysis not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodeScanningAnalysesByOwnerByRepoByAnalysisId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"analysis_id": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "analysis 999 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeQLAnalysis(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned codeScanningAnalysisDetail
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedDetail, returned)
+		})
+	}
+}
+
+func Test_UploadSARIF(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UploadSARIF(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "upload_sarif", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_sha")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "sarif")
+	assert.Contains(t, tool.InputSchema.Properties, "tool_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "commit_sha", "ref", "sarif"})
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err := zw.Write([]byte(`{"version":"2.1.0","runs":[]}`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	sarif := base64.StdEncoding.EncodeToString(compressed.Bytes())
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		dryRun         bool
+		expectError    bool
+		expectedResult map[string]string
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "upload is accepted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodeScanningSarifsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"commit_sha": "4b6472266afd7b471e86085a6659e8c7f2b119da",
+						"ref":        "refs/heads/main",
+						"sarif":      sarif,
+						"tool_name":  "semgrep",
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, map[string]string{
+							"id":  "47177e22-5596-11eb-80a1-c1e54ef945c6",
+							"url": "https://api.github.com/repos/owner/repo/code-scanning/sarifs/47177e22-5596-11eb-80a1-c1e54ef945c6",
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "4b6472266afd7b471e86085a6659e8c7f2b119da",
+				"ref":        "refs/heads/main",
+				"sarif":      sarif,
+				"tool_name":  "semgrep",
+			},
+			expectedResult: map[string]string{
+				"sarif_id": "47177e22-5596-11eb-80a1-c1e54ef945c6",
+				"url":      "https://api.github.com/repos/owner/repo/code-scanning/sarifs/47177e22-5596-11eb-80a1-c1e54ef945c6",
+			},
+		},
+		{
+			name:         "dry run does not upload",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatchHandler(mock.PostReposCodeScanningSarifsByOwnerByRepo, failOnRequest(t))),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "4b6472266afd7b471e86085a6659e8c7f2b119da",
+				"ref":        "refs/heads/main",
+				"sarif":      sarif,
+			},
+			dryRun:       true,
+			expectedText: "Dry run, nothing was changed: would upload SARIF results for refs/heads/main at 4b6472266afd7b471e86085a6659e8c7f2b119da to owner/repo",
+		},
+		{
+			name:         "uncompressed SARIF is refused",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatchHandler(mock.PostReposCodeScanningSarifsByOwnerByRepo, failOnRequest(t))),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "4b6472266afd7b471e86085a6659e8c7f2b119da",
+				"ref":        "refs/heads/main",
+				"sarif":      base64.StdEncoding.EncodeToString([]byte(`{"version":"2.1.0","runs":[]}`)),
+			},
+			expectError:    true,
+			expectedErrMsg: "sarif must be gzip compressed before being base64 encoded: gzip: invalid header",
+		},
+		{
+			name:         "ref must be a full reference",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatchHandler(mock.PostReposCodeScanningSarifsByOwnerByRepo, failOnRequest(t))),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "4b6472266afd7b471e86085a6659e8c7f2b119da",
+				"ref":        "main",
+				"sarif":      sarif,
+			},
+			expectError:    true,
+			expectedErrMsg: "ref must be a full Git reference such as refs/heads/main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UploadSARIF(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			ctx := context.Background()
+			if tc.dryRun {
+				ctx = ContextWithDryRun(ctx)
+			}
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ctx, request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var returned map[string]string
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
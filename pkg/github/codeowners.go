@@ -0,0 +1,225 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// codeOwnersLocations are the paths GitHub looks for a CODEOWNERS file at, the first one found is used.
+var codeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeOwnersRule is a rule of a CODEOWNERS file. A rule without owners leaves the paths it
+// matches without owners.
+type codeOwnersRule struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+
+	re *regexp.Regexp
+}
+
+// codeOwnersRules is the result of get_code_owners without a path, every rule of the file.
+type codeOwnersRules struct {
+	CodeOwnersPath string           `json:"codeowners_path"`
+	Rules          []codeOwnersRule `json:"rules"`
+	InvalidLines   []int            `json:"invalid_lines,omitempty"`
+}
+
+// pathCodeOwners is the result of get_code_owners with a path, its owners and the rule they come
+// from, which is nil when no rule matches path.
+type pathCodeOwners struct {
+	CodeOwnersPath string          `json:"codeowners_path"`
+	Path           string          `json:"path"`
+	Owners         []string        `json:"owners"`
+	MatchedRule    *codeOwnersRule `json:"matched_rule,omitempty"`
+}
+
+// codeOwnersPattern compiles a CODEOWNERS pattern, which follows the gitignore rules except for
+// negation and character ranges, which are not supported. A pattern is anchored to the root of
+// the repository when it holds a slash before its end, and otherwise matches at any depth. A
+// pattern also matches everything under the directories it matches, unless its last segment has
+// a single * wildcard, so docs/* matches the files directly in docs only.
+func codeOwnersPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("pattern %s uses syntax CODEOWNERS does not support", pattern)
+	}
+	pattern = strings.ReplaceAll(pattern, `\#`, "#")
+
+	anchored := strings.HasPrefix(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern matches nothing")
+	}
+	if strings.Contains(pattern, "/") {
+		anchored = true
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+
+	lastSegment := pattern[strings.LastIndex(pattern, "/")+1:]
+	switch {
+	case dirOnly:
+		b.WriteString("/.*")
+	case strings.Contains(lastSegment, "*") && !strings.Contains(lastSegment, "**"):
+	default:
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// parseCodeOwners returns the rules of a CODEOWNERS file, and the numbers of the lines whose
+// pattern is invalid, which GitHub ignores.
+func parseCodeOwners(content string) ([]codeOwnersRule, []int) {
+	var rules []codeOwnersRule
+	var invalid []int
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		owners := []string{}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, "#") {
+				break
+			}
+			owners = append(owners, field)
+		}
+		re, err := codeOwnersPattern(fields[0])
+		if err != nil {
+			invalid = append(invalid, i+1)
+			continue
+		}
+		rules = append(rules, codeOwnersRule{Line: i + 1, Pattern: fields[0], Owners: owners, re: re})
+	}
+	return rules, invalid
+}
+
+// matchCodeOwners returns the rule that applies to path, the last matching one, or nil when no
+// rule matches.
+func matchCodeOwners(rules []codeOwnersRule, path string) *codeOwnersRule {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(path) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// GetCodeOwners creates a tool to get the code owners of a repository, or of a file in it.
+func GetCodeOwners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_code_owners",
+			mcp.WithDescription(t("TOOL_GET_CODE_OWNERS_DESCRIPTION", "Get the rules of the CODEOWNERS file of a GitHub repository, found in .github/, the root or docs/. Given a path, returns the owners of that file instead, from the last rule matching it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CODE_OWNERS_USER_TITLE", "Get code owners"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Path of a file to get the owners of"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to read the CODEOWNERS file at, defaults to the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var file refFile
+			var location string
+			for _, location = range codeOwnersLocations {
+				// A directory named CODEOWNERS is skipped like a missing file
+				file, _, err = getRefFile(ctx, client, owner, repo, location, ref)
+				if err != nil {
+					return nil, err
+				}
+				if file.exists {
+					break
+				}
+			}
+			if !file.exists {
+				return mcp.NewToolResultError(fmt.Sprintf("no CODEOWNERS file found in %s/%s, looked for %s", owner, repo, strings.Join(codeOwnersLocations, ", "))), nil
+			}
+
+			rules, invalid := parseCodeOwners(file.content)
+			var result any
+			if path == "" {
+				if rules == nil {
+					rules = []codeOwnersRule{}
+				}
+				result = codeOwnersRules{CodeOwnersPath: location, Rules: rules, InvalidLines: invalid}
+			} else {
+				owners := pathCodeOwners{CodeOwnersPath: location, Path: path, Owners: []string{}}
+				if rule := matchCodeOwners(rules, path); rule != nil {
+					owners.Owners = rule.Owners
+					owners.MatchedRule = rule
+				}
+				result = owners
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
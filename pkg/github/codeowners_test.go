@@ -0,0 +1,264 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CodeOwnersPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		matches  []string
+		excludes []string
+	}{
+		{
+			pattern: "*",
+			matches: []string{"README.md", "src/main.go"},
+		},
+		{
+			pattern:  "*.js",
+			matches:  []string{"app.js", "src/lib/app.js"},
+			excludes: []string{"app.jsx", "src/app.ts"},
+		},
+		{
+			pattern:  "/build/logs/",
+			matches:  []string{"build/logs/out.log", "build/logs/2024/out.log"},
+			excludes: []string{"src/build/logs/out.log", "build/logs"},
+		},
+		{
+			pattern:  "docs/*",
+			matches:  []string{"docs/getting-started.md"},
+			excludes: []string{"docs/build-app/troubleshooting.md", "src/docs/index.md"},
+		},
+		{
+			pattern:  "apps/",
+			matches:  []string{"apps/web/index.ts", "src/apps/cli/main.go"},
+			excludes: []string{"apps", "myapps/main.go"},
+		},
+		{
+			pattern:  "/docs/",
+			matches:  []string{"docs/index.md", "docs/guides/setup.md"},
+			excludes: []string{"src/docs/index.md"},
+		},
+		{
+			pattern:  "**/logs",
+			matches:  []string{"logs/out.log", "build/logs/out.log", "deeply/nested/logs/out.log"},
+			excludes: []string{"build/logs.txt"},
+		},
+		{
+			pattern:  "/scripts/deploy.sh",
+			matches:  []string{"scripts/deploy.sh"},
+			excludes: []string{"tools/scripts/deploy.sh", "scripts/deploy.sh.bak"},
+		},
+		{
+			pattern:  "Makefile",
+			matches:  []string{"Makefile", "src/Makefile"},
+			excludes: []string{"Makefile.am"},
+		},
+		{
+			pattern:  "src/**/test?.go",
+			matches:  []string{"src/test1.go", "src/a/b/testx.go"},
+			excludes: []string{"src/a/test10.go", "lib/src/test1.go"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := codeOwnersPattern(tc.pattern)
+			require.NoError(t, err)
+			for _, path := range tc.matches {
+				assert.True(t, re.MatchString(path), "%s should match %s", tc.pattern, path)
+			}
+			for _, path := range tc.excludes {
+				assert.False(t, re.MatchString(path), "%s should not match %s", tc.pattern, path)
+			}
+		})
+	}
+
+	for _, pattern := range []string{"!docs/", "[abc].go", "/"} {
+		_, err := codeOwnersPattern(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
+
+// testCodeOwners is a CODEOWNERS file after the example of the GitHub documentation.
+const testCodeOwners = `# Default owners for everything in the repo
+*       @global-owner1 @global-owner2
+
+# Order is important, the last matching pattern takes precedence
+*.js    @js-owner #This is an inline comment.
+*.go docs@example.com
+
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/ @octocat
+/apps/github
+!/secret/ @octocat
+/scripts/ @doctocat @octocat
+`
+
+func Test_MatchCodeOwners(t *testing.T) {
+	rules, invalid := parseCodeOwners(testCodeOwners)
+	assert.Equal(t, []int{12}, invalid)
+	require.Len(t, rules, 8)
+	assert.Equal(t, codeOwnersRule{Line: 5, Pattern: "*.js", Owners: []string{"@js-owner"}}, withoutPattern(rules[1]))
+
+	tests := []struct {
+		path           string
+		expectedLine   int
+		expectedOwners []string
+	}{
+		{path: "README.md", expectedLine: 2, expectedOwners: []string{"@global-owner1", "@global-owner2"}},
+		{path: "src/app.js", expectedLine: 5, expectedOwners: []string{"@js-owner"}},
+		{path: "build/logs/app.js", expectedLine: 8, expectedOwners: []string{"@doctocat"}},
+		{path: "docs/index.md", expectedLine: 9, expectedOwners: []string{"docs@example.com"}},
+		{path: "docs/guides/setup.js", expectedLine: 5, expectedOwners: []string{"@js-owner"}},
+		{path: "apps/web/index.ts", expectedLine: 10, expectedOwners: []string{"@octocat"}},
+		// A later rule without owners leaves the path without owners
+		{path: "apps/github/main.go", expectedLine: 11, expectedOwners: []string{}},
+		{path: "/scripts/deploy.sh", expectedLine: 13, expectedOwners: []string{"@doctocat", "@octocat"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			rule := matchCodeOwners(rules, tc.path)
+			require.NotNil(t, rule)
+			assert.Equal(t, tc.expectedLine, rule.Line)
+			assert.Equal(t, tc.expectedOwners, rule.Owners)
+		})
+	}
+
+	assert.Nil(t, matchCodeOwners(rules[1:2], "README.md"))
+}
+
+// withoutPattern returns rule without its compiled pattern, to compare it.
+func withoutPattern(rule codeOwnersRule) codeOwnersRule {
+	rule.re = nil
+	return rule
+}
+
+func Test_GetCodeOwners(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeOwners(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_code_owners", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// contentsAt serves the CODEOWNERS file at path only, as if the other locations did not exist
+	contentsAt := func(path string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "release", r.URL.Query().Get("ref"))
+			if r.URL.Path != "/repos/owner/repo/contents/"+path {
+				mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, &github.RepositoryContent{
+				Type:     github.Ptr("file"),
+				Path:     github.Ptr(path),
+				Encoding: github.Ptr("base64"),
+				Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte("*.go @gophers\n/docs/ @writers\n"))),
+			})(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		path           string
+		expectError    bool
+		expectedResult any
+		expectedErrMsg string
+	}{
+		{
+			name: "rules of the file in the root",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsAt("CODEOWNERS")),
+			),
+			expectedResult: map[string]any{
+				"codeowners_path": "CODEOWNERS",
+				"rules": []any{
+					map[string]any{"line": float64(1), "pattern": "*.go", "owners": []any{"@gophers"}},
+					map[string]any{"line": float64(2), "pattern": "/docs/", "owners": []any{"@writers"}},
+				},
+			},
+		},
+		{
+			name: "owners of a path from the file in .github",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsAt(".github/CODEOWNERS")),
+			),
+			path: "docs/main.go",
+			expectedResult: map[string]any{
+				"codeowners_path": ".github/CODEOWNERS",
+				"path":            "docs/main.go",
+				"owners":          []any{"@writers"},
+				"matched_rule":    map[string]any{"line": float64(2), "pattern": "/docs/", "owners": []any{"@writers"}},
+			},
+		},
+		{
+			name: "path without owners",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsAt("docs/CODEOWNERS")),
+			),
+			path: "README.md",
+			expectedResult: map[string]any{
+				"codeowners_path": "docs/CODEOWNERS",
+				"path":            "README.md",
+				"owners":          []any{},
+			},
+		},
+		{
+			name: "no CODEOWNERS file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, contentsAt("OWNERS")),
+			),
+			expectError:    true,
+			expectedErrMsg: "no CODEOWNERS file found in owner/repo, looked for .github/CODEOWNERS, CODEOWNERS, docs/CODEOWNERS",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeOwners(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			args := map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "release",
+			}
+			if tc.path != "" {
+				args["path"] = tc.path
+			}
+			result, err := handler(context.Background(), createMCPRequest(args))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCommitComments(t *testing.T) {
+	mockComments := []*github.RepositoryComment{
+		{
+			ID:       github.Ptr(int64(1)),
+			CommitID: github.Ptr("abc123"),
+			Body:     github.Ptr("Nice fix"),
+			User:     &github.User{Login: github.Ptr("octocat")},
+		},
+	}
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCommentsByOwnerByRepoByCommitSha,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockComments),
+			),
+		),
+	))
+	tool, handler := ListCommitComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_commit_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"sha":   "abc123",
+	}))
+	require.NoError(t, err)
+
+	var returned []*github.RepositoryComment
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, mockComments, returned)
+}
+
+func Test_CreateCommitComment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCommitComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_commit_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "position")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha", "body"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockComment := &github.RepositoryComment{
+		ID:       github.Ptr(int64(2)),
+		CommitID: github.Ptr("abc123"),
+		Body:     github.Ptr("This leaks the handle"),
+		Path:     github.Ptr("src/main.go"),
+		Position: github.Ptr(4),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedComment *github.RepositoryComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "comments on a line",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					expectRequestBody(t, map[string]interface{}{
+						"body":     "This leaks the handle",
+						"path":     "src/main.go",
+						"position": float64(4),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sha":      "abc123",
+				"body":     "This leaks the handle",
+				"path":     "src/main.go",
+				"position": float64(4),
+			},
+			expectedComment: mockComment,
+		},
+		{
+			name: "comments on the commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCommitsCommentsByOwnerByRepoByCommitSha,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "Looks good",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.RepositoryComment{
+							ID:   github.Ptr(int64(3)),
+							Body: github.Ptr("Looks good"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Looks good",
+			},
+			expectedComment: &github.RepositoryComment{
+				ID:   github.Ptr(int64(3)),
+				Body: github.Ptr("Looks good"),
+			},
+		},
+		{
+			name:         "position without path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"sha":      "abc123",
+				"body":     "This leaks the handle",
+				"position": float64(4),
+			},
+			expectError:    true,
+			expectedErrMsg: "position can only be used together with path",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateCommitComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned github.RepositoryComment
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedComment, returned)
+		})
+	}
+}
+
+func Test_DeleteCommitComment(t *testing.T) {
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposCommentsByOwnerByRepoByCommentId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/owner/repo/comments/2", r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	tool, handler := DeleteCommitComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_commit_comment", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "comment_id"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"comment_id": float64(2),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted commit comment 2 from owner/repo", getTextResult(t, result).Text)
+}
@@ -0,0 +1,159 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// compareFileContext is the number of unchanged lines around each change of a compare_file_across_refs diff.
+const compareFileContext = 3
+
+// refFile is a file at a ref, with exists false when the path does not exist at the ref.
+type refFile struct {
+	exists  bool
+	content string
+	sha     string
+}
+
+// getRefFile fetches path at ref. A result is returned instead of an error for a directory.
+func getRefFile(ctx context.Context, client *github.Client, owner, repo, path, ref string) (refFile, *mcp.CallToolResult, error) {
+	fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return refFile{}, nil, nil
+		}
+		return refFile{}, nil, fmt.Errorf("failed to get file contents at %s: %w", ref, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if fileContent == nil {
+		return refFile{}, mcp.NewToolResultError(fmt.Sprintf("%s is a directory at %s, only files can be compared", path, ref)), nil
+	}
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return refFile{}, nil, fmt.Errorf("failed to decode file contents at %s: %w", ref, err)
+	}
+	return refFile{exists: true, content: content, sha: fileContent.GetSHA()}, nil, nil
+}
+
+// diffLines splits content into lines keeping their line ending, which the diff needs on the
+// last line too.
+func diffLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
+// CompareFileAcrossRefs creates a tool to diff a single file between two refs of a repository.
+func CompareFileAcrossRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_file_across_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_FILE_ACROSS_REFS_DESCRIPTION", "Get a unified diff of a single file between two branches, tags or commits of a GitHub repository. Binary files are not diffed")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_FILE_ACROSS_REFS_USER_TITLE", "Compare a file across refs"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			baseFile, result, err := getRefFile(ctx, client, owner, repo, path, base)
+			if result != nil || err != nil {
+				return result, err
+			}
+			headFile, result, err := getRefFile(ctx, client, owner, repo, path, head)
+			if result != nil || err != nil {
+				return result, err
+			}
+
+			switch {
+			case !baseFile.exists && !headFile.exists:
+				return mcp.NewToolResultError(fmt.Sprintf("%s not found at %s or %s in %s/%s", path, base, head, owner, repo)), nil
+			case baseFile.exists && headFile.exists && baseFile.sha == headFile.sha:
+				return mcp.NewToolResultText(fmt.Sprintf("%s is identical at %s and %s", path, base, head)), nil
+			case isBinary([]byte(baseFile.content)) || isBinary([]byte(headFile.content)):
+				return mcp.NewToolResultText(fmt.Sprintf("%s is a binary file and differs between %s and %s, binary files are not diffed", path, base, head)), nil
+			}
+
+			// Like git, a file missing on one side is diffed against /dev/null
+			fromFile, toFile := fmt.Sprintf("a/%s (%s)", path, base), fmt.Sprintf("b/%s (%s)", path, head)
+			if !baseFile.exists {
+				fromFile = "/dev/null"
+			}
+			if !headFile.exists {
+				toFile = "/dev/null"
+			}
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        diffLines(baseFile.content),
+				B:        diffLines(headFile.content),
+				FromFile: fromFile,
+				ToFile:   toFile,
+				Context:  compareFileContext,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to diff file: %w", err)
+			}
+			if diff == "" {
+				return mcp.NewToolResultText(fmt.Sprintf("%s is identical at %s and %s", path, base, head)), nil
+			}
+
+			return mcp.NewToolResultText(diff), nil
+		}
+}
@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareFileAcrossRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareFileAcrossRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "compare_file_across_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "base", "head"})
+
+	// Files by path and ref, a missing entry is a file that does not exist at the ref
+	files := map[string]map[string][]byte{
+		"main.go": {
+			"main":    []byte("package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n"),
+			"feature": []byte("package main\n\nfunc main() {\n\tprintln(\"hello, world\")\n}\n"),
+			"copy":    []byte("package main\n\nfunc main() {\n\tprintln(\"hello\")\n}\n"),
+		},
+		"NEW.md": {
+			"feature": []byte("# New"),
+		},
+		"logo.png": {
+			"main":    {0x89, 'P', 'N', 'G', 0x00, 0x01},
+			"feature": {0x89, 'P', 'N', 'G', 0x00, 0x02},
+		},
+	}
+	contentsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents/")
+		if path == "src" {
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode([]*github.RepositoryContent{{Type: github.Ptr("file"), Path: github.Ptr("src/main.go")}})
+			return
+		}
+		ref := r.URL.Query().Get("ref")
+		content, ok := files[path][ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		file := mockFileContent(path, content)
+		// The blob SHA only depends on the content
+		file.SHA = github.Ptr("sha-" + string(content))
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(file)
+	})
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "text file diff",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedText: "--- a/main.go (main)\n" +
+				"+++ b/main.go (feature)\n" +
+				"@@ -1,5 +1,5 @@\n" +
+				" package main\n" +
+				" \n" +
+				" func main() {\n" +
+				"-\tprintln(\"hello\")\n" +
+				"+\tprintln(\"hello, world\")\n" +
+				" }\n",
+		},
+		{
+			name: "file added at head",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "NEW.md",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedText: "--- /dev/null\n" +
+				"+++ b/NEW.md (feature)\n" +
+				"@@ -0,0 +1 @@\n" +
+				"+# New\n",
+		},
+		{
+			name: "identical file",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
+				"base":  "main",
+				"head":  "copy",
+			},
+			expectedText: "main.go is identical at main and copy",
+		},
+		{
+			name: "binary file is not diffed",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "logo.png",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedText: "logo.png is a binary file and differs between main and feature, binary files are not diffed",
+		},
+		{
+			name: "file missing at both refs",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "missing.txt",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing.txt not found at main or feature in owner/repo",
+		},
+		{
+			name: "directory",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError:    true,
+			expectedErrMsg: "src is a directory at main, only files can be compared",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsHandler,
+				),
+			))
+			_, handler := CompareFileAcrossRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
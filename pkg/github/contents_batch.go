@@ -0,0 +1,195 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxBatchFiles caps the number of files a single get_file_contents_batch call may fetch.
+const maxBatchFiles = 50
+
+// batchFetchConcurrency bounds the number of files fetched at the same time, overridden in tests.
+var batchFetchConcurrency = 5
+
+// batchFileRequest is a single entry of a get_file_contents_batch call.
+type batchFileRequest struct {
+	Path string
+	Ref  string
+}
+
+// key is the key of the entry in the batch result: the path, qualified with the ref when there is one
+// so the same file can be requested at several refs.
+func (f batchFileRequest) key() string {
+	if f.Ref == "" {
+		return f.Path
+	}
+	return f.Path + "@" + f.Ref
+}
+
+// batchFileResult is the content of one file of a batch, or the error fetching it. Binary files are
+// returned base64 encoded, text files as is.
+type batchFileResult struct {
+	Content  string `json:"content,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Binary   bool   `json:"binary,omitempty"`
+	Size     int    `json:"size,omitempty"`
+	SHA      string `json:"sha,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// isBinary reports whether data has to be treated as binary rather than text: it has a NUL byte,
+// like git checks, or is not valid UTF-8. Unlike git, text in another encoding such as Latin-1
+// counts as binary, since putting it in a JSON string would replace its invalid bytes.
+func isBinary(data []byte) bool {
+	return bytes.IndexByte(data, 0) >= 0 || !utf8.Valid(data)
+}
+
+// fetchBatchFile fetches a single file of a batch. Errors are reported in the result rather than
+// returned, so one missing file does not fail the whole batch.
+func fetchBatchFile(ctx context.Context, client *github.Client, owner, repo string, file batchFileRequest) batchFileResult {
+	opts := &github.RepositoryContentGetOptions{Ref: file.Ref}
+	fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, file.Path, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return batchFileResult{Error: "file not found"}
+		}
+		return batchFileResult{Error: fmt.Sprintf("failed to get file contents: %s", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if fileContent == nil {
+		return batchFileResult{Error: "path is a directory, use get_file_contents to list it"}
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return batchFileResult{Error: fmt.Sprintf("failed to decode file contents: %s", err)}
+	}
+
+	result := batchFileResult{
+		Size: fileContent.GetSize(),
+		SHA:  fileContent.GetSHA(),
+	}
+	if isBinary([]byte(content)) {
+		result.Binary = true
+		result.Encoding = "base64"
+		result.Content = base64.StdEncoding.EncodeToString([]byte(content))
+	} else {
+		result.Encoding = "utf-8"
+		result.Content = content
+	}
+	return result
+}
+
+// GetFileContentsBatch creates a tool to get the contents of several files of a repository at once.
+func GetFileContentsBatch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_contents_batch",
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_BATCH_DESCRIPTION", fmt.Sprintf("Get the contents of up to %d files from a GitHub repository in one call. Returns a map from path (or path@ref when a ref is given) to the file content; binary files are base64 encoded and files that cannot be fetched have an error instead", maxBatchFiles))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FILE_CONTENTS_BATCH_USER_TITLE", "Get multiple file contents"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("files",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"path"},
+						"properties": map[string]interface{}{
+							"path": map[string]interface{}{
+								"type":        "string",
+								"description": "path to the file",
+							},
+							"ref": map[string]interface{}{
+								"type":        "string",
+								"description": "branch, tag or commit SHA to get the file from, defaults to the default branch",
+							},
+						},
+					}),
+				mcp.Description("Array of file objects to get, each object with path (string) and optional ref (string)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			filesObj, ok := request.GetArguments()["files"].([]interface{})
+			if !ok || len(filesObj) == 0 {
+				return mcp.NewToolResultError("files parameter must be a non-empty array of objects with path"), nil
+			}
+			if len(filesObj) > maxBatchFiles {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d files can be fetched at once, got %d", maxBatchFiles, len(filesObj))), nil
+			}
+
+			files := make([]batchFileRequest, 0, len(filesObj))
+			for _, file := range filesObj {
+				fileMap, ok := file.(map[string]interface{})
+				if !ok {
+					return mcp.NewToolResultError("each file must be an object with path and optional ref"), nil
+				}
+				path, ok := fileMap["path"].(string)
+				if !ok || path == "" {
+					return mcp.NewToolResultError("each file must have a path"), nil
+				}
+				ref, _ := fileMap["ref"].(string)
+				files = append(files, batchFileRequest{Path: path, Ref: ref})
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := make([]batchFileResult, len(files))
+			sem := make(chan struct{}, batchFetchConcurrency)
+			var wg sync.WaitGroup
+			for i, file := range files {
+				wg.Add(1)
+				go func(i int, file batchFileRequest) {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					results[i] = fetchBatchFile(ctx, client, owner, repo, file)
+				}(i, file)
+			}
+			wg.Wait()
+
+			contents := make(map[string]batchFileResult, len(files))
+			for i, file := range files {
+				contents[file.key()] = results[i]
+			}
+
+			r, err := json.Marshal(contents)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
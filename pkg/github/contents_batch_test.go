@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFileContent builds a contents API response for a file with the given content.
+func mockFileContent(path string, content []byte) *github.RepositoryContent {
+	return &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Name:     github.Ptr(path),
+		Path:     github.Ptr(path),
+		SHA:      github.Ptr("sha-" + path),
+		Size:     github.Ptr(len(content)),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString(content)),
+	}
+}
+
+func Test_GetFileContentsBatch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetFileContentsBatch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_file_contents_batch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "files")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "files"})
+
+	binary := []byte{0x89, 'P', 'N', 'G', 0x00, 0x01}
+	contentsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents/")
+		var body interface{}
+		switch path {
+		case "README.md":
+			content := "# Hello"
+			if r.URL.Query().Get("ref") == "v1" {
+				content = "# Hello v1"
+			}
+			body = mockFileContent(path, []byte(content))
+		case "logo.png":
+			body = mockFileContent(path, binary)
+		case "src":
+			body = []*github.RepositoryContent{{Type: github.Ptr("file"), Path: github.Ptr("src/main.go")}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedContents map[string]batchFileResult
+		expectedErrMsg   string
+	}{
+		{
+			name: "text, binary and per-file errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []interface{}{
+					map[string]interface{}{"path": "README.md"},
+					map[string]interface{}{"path": "README.md", "ref": "v1"},
+					map[string]interface{}{"path": "logo.png"},
+					map[string]interface{}{"path": "missing.txt"},
+					map[string]interface{}{"path": "src"},
+				},
+			},
+			expectError: false,
+			expectedContents: map[string]batchFileResult{
+				"README.md":    {Content: "# Hello", Encoding: "utf-8", Size: 7, SHA: "sha-README.md"},
+				"README.md@v1": {Content: "# Hello v1", Encoding: "utf-8", Size: 10, SHA: "sha-README.md"},
+				"logo.png":     {Content: base64.StdEncoding.EncodeToString(binary), Encoding: "base64", Binary: true, Size: 6, SHA: "sha-logo.png"},
+				"missing.txt":  {Error: "file not found"},
+				"src":          {Error: "path is a directory, use get_file_contents to list it"},
+			},
+		},
+		{
+			name:         "empty files",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []interface{}{},
+			},
+			expectError:    false,
+			expectedErrMsg: "files parameter must be a non-empty array of objects with path",
+		},
+		{
+			name:         "file without path",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"files": []interface{}{
+					map[string]interface{}{"ref": "main"},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "each file must have a path",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetFileContentsBatch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var contents map[string]batchFileResult
+			err = json.Unmarshal([]byte(textContent.Text), &contents)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContents, contents)
+		})
+	}
+}
+
+func Test_GetFileContentsBatchBoundsConcurrency(t *testing.T) {
+	originalConcurrency := batchFetchConcurrency
+	batchFetchConcurrency = 2
+	t.Cleanup(func() { batchFetchConcurrency = originalConcurrency })
+
+	var inFlight, maxInFlight, calls int32
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				atomic.AddInt32(&calls, 1)
+				for {
+					observed := atomic.LoadInt32(&maxInFlight)
+					if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+
+				path := strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/contents/")
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(mockFileContent(path, []byte("content")))
+			}),
+		),
+	))
+	_, handler := GetFileContentsBatch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	files := make([]interface{}, 0, 8)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		files = append(files, map[string]interface{}{"path": name + ".txt"})
+	}
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"files": files,
+	}))
+	require.NoError(t, err)
+
+	var contents map[string]batchFileResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &contents))
+	assert.Len(t, contents, 8)
+	assert.Equal(t, int32(8), atomic.LoadInt32(&calls))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+func Test_IsBinary(t *testing.T) {
+	assert.False(t, isBinary([]byte("package main\n")))
+	assert.False(t, isBinary([]byte("caf\xc3\xa9\n")))
+	assert.True(t, isBinary([]byte{0x89, 'P', 'N', 'G', 0x00, 0x01}))
+	// Latin-1 text is not valid UTF-8, so it cannot go in a JSON string as is
+	assert.True(t, isBinary([]byte("caf\xe9\n")))
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -18,7 +19,7 @@ func GetMe(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mc
 			mcp.WithDescription(t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user. Use this when a request include \"me\", \"my\"...")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_ME_USER_TITLE", "Get my user profile"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("reason",
 				mcp.Description("Optional: reason the session was created"),
@@ -51,3 +52,63 @@ func GetMe(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mc
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// RenderMarkdown creates a tool to render markdown text as GitHub would.
+func RenderMarkdown(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("render_markdown",
+			mcp.WithDescription(t("TOOL_RENDER_MARKDOWN_DESCRIPTION", "Render markdown text to HTML the same way GitHub does. Use this to preview comments, issue bodies or release notes before posting them")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RENDER_MARKDOWN_USER_TITLE", "Render markdown"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("text",
+				mcp.Required(),
+				mcp.Description("Markdown text to render"),
+			),
+			mcp.WithString("mode",
+				mcp.Description("Rendering mode: 'markdown' renders like a README, 'gfm' renders like a comment and links issue and user references"),
+				mcp.Enum("markdown", "gfm"),
+			),
+			mcp.WithString("context",
+				mcp.Description("Repository in 'owner/repo' form used to resolve issue and pull request references in 'gfm' mode"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			text, err := requiredParam[string](request, "text")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mode, err := OptionalParam[string](request, "mode")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoContext, err := OptionalParam[string](request, "context")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.MarkdownOptions{
+				Mode:    mode,
+				Context: repoContext,
+			}
+			html, resp, err := client.Markdown.Render(ctx, text, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render markdown: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to render markdown: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(html), nil
+		}
+}
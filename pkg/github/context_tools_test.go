@@ -130,3 +130,93 @@ func Test_GetMe(t *testing.T) {
 		})
 	}
 }
+
+func Test_RenderMarkdown(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := RenderMarkdown(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "render_markdown", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "text")
+	assert.Contains(t, tool.InputSchema.Properties, "mode")
+	assert.Contains(t, tool.InputSchema.Properties, "context")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"text"})
+
+	mockHTML := `<p>Fixes <a href="https://github.com/owner/repo/issues/1">#1</a></p>`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedHTML   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful render with mode and context",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostMarkdown,
+					expectRequestBody(t, map[string]any{
+						"text":    "Fixes #1",
+						"mode":    "gfm",
+						"context": "owner/repo",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Content-Type", "text/html")
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write([]byte(mockHTML))
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"text":    "Fixes #1",
+				"mode":    "gfm",
+				"context": "owner/repo",
+			},
+			expectError:  false,
+			expectedHTML: mockHTML,
+		},
+		{
+			name: "render fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostMarkdown,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"text": "Fixes #1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to render markdown",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RenderMarkdown(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedHTML, textContent.Text)
+		})
+	}
+}
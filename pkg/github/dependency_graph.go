@@ -0,0 +1,299 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sbomExport is the result of export_sbom, a summary of the SBOM along with the SPDX document.
+type sbomExport struct {
+	Name         string `json:"name"`
+	SPDXVersion  string `json:"spdx_version"`
+	PackageCount int    `json:"package_count"`
+	Document     string `json:"document"`
+	Truncated    bool   `json:"truncated"`
+}
+
+// ExportSBOM creates a tool to export the software bill of materials of a repository.
+func ExportSBOM(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("export_sbom",
+			mcp.WithDescription(t("TOOL_EXPORT_SBOM_DESCRIPTION", fmt.Sprintf("Export the software bill of materials of a GitHub repository from its dependency graph, as an SPDX JSON document with a package count. The document is truncated to %d bytes", maxTextResultBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_EXPORT_SBOM_USER_TITLE", "Export SBOM"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// DependencyGraph.GetSBOM decodes into a struct that drops the external references and
+			// relationships of the packages, request the document directly to return it whole
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/dependency-graph/sbom", owner, repo), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var sbom struct {
+				SBOM json.RawMessage `json:"sbom"`
+			}
+			resp, err := client.Do(ctx, req, &sbom)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("no SBOM available for %s/%s, check that the dependency graph is enabled for the repository", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to export SBOM: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to export SBOM: %s", string(body))), nil
+			}
+
+			var document struct {
+				Name        string            `json:"name"`
+				SPDXVersion string            `json:"spdxVersion"`
+				Packages    []json.RawMessage `json:"packages"`
+			}
+			if err := json.Unmarshal(sbom.SBOM, &document); err != nil {
+				return nil, fmt.Errorf("failed to parse SBOM: %w", err)
+			}
+
+			result := sbomExport{
+				Name:         document.Name,
+				SPDXVersion:  document.SPDXVersion,
+				PackageCount: len(document.Packages),
+			}
+			result.Document, result.Truncated = truncateText(string(sbom.SBOM), maxTextResultBytes)
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// dependencyVulnerability is an advisory affecting a dependency, as reported by the dependency
+// review API.
+type dependencyVulnerability struct {
+	Severity        string `json:"severity"`
+	AdvisoryGHSAID  string `json:"advisory_ghsa_id"`
+	AdvisorySummary string `json:"advisory_summary"`
+	AdvisoryURL     string `json:"advisory_url"`
+}
+
+// rawDependencyChange is an entry of the dependency review API response.
+type rawDependencyChange struct {
+	ChangeType      string                    `json:"change_type"`
+	Manifest        string                    `json:"manifest"`
+	Ecosystem       string                    `json:"ecosystem"`
+	Name            string                    `json:"name"`
+	Version         string                    `json:"version"`
+	PackageURL      string                    `json:"package_url"`
+	License         string                    `json:"license"`
+	Scope           string                    `json:"scope"`
+	Vulnerabilities []dependencyVulnerability `json:"vulnerabilities"`
+}
+
+// dependencyChange is a dependency added, removed or updated between two refs.
+type dependencyChange struct {
+	Manifest        string                    `json:"manifest"`
+	Ecosystem       string                    `json:"ecosystem"`
+	Name            string                    `json:"name"`
+	Version         string                    `json:"version"`
+	PreviousVersion string                    `json:"previous_version,omitempty"`
+	PackageURL      string                    `json:"package_url,omitempty"`
+	License         string                    `json:"license,omitempty"`
+	Scope           string                    `json:"scope,omitempty"`
+	Vulnerabilities []dependencyVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// summary converts the entry to the form returned by get_dependency_diff.
+func (c rawDependencyChange) summary() dependencyChange {
+	return dependencyChange{
+		Manifest:        c.Manifest,
+		Ecosystem:       c.Ecosystem,
+		Name:            c.Name,
+		Version:         c.Version,
+		PackageURL:      c.PackageURL,
+		License:         c.License,
+		Scope:           c.Scope,
+		Vulnerabilities: c.Vulnerabilities,
+	}
+}
+
+// dependencyDiff is the result of get_dependency_diff.
+type dependencyDiff struct {
+	Added               []dependencyChange `json:"added"`
+	Removed             []dependencyChange `json:"removed"`
+	Updated             []dependencyChange `json:"updated"`
+	VulnerableAdditions int                `json:"vulnerable_additions"`
+	Warning             string             `json:"warning,omitempty"`
+}
+
+// summarizeDependencyChanges groups the changes of the dependency review API. The API reports an
+// updated dependency as the removal of its old version and the addition of the new one, these
+// are paired up by manifest, ecosystem and name.
+func summarizeDependencyChanges(changes []rawDependencyChange) dependencyDiff {
+	key := func(c rawDependencyChange) string {
+		return c.Manifest + "\x00" + c.Ecosystem + "\x00" + c.Name
+	}
+	removed := make(map[string]rawDependencyChange)
+	for _, c := range changes {
+		if c.ChangeType == "removed" {
+			removed[key(c)] = c
+		}
+	}
+
+	diff := dependencyDiff{
+		Added:   []dependencyChange{},
+		Removed: []dependencyChange{},
+		Updated: []dependencyChange{},
+	}
+	var vulnerable []string
+	for _, c := range changes {
+		if c.ChangeType != "added" {
+			continue
+		}
+		change := c.summary()
+		if old, ok := removed[key(c)]; ok {
+			delete(removed, key(c))
+			change.PreviousVersion = old.Version
+			diff.Updated = append(diff.Updated, change)
+		} else {
+			diff.Added = append(diff.Added, change)
+		}
+		if len(c.Vulnerabilities) > 0 {
+			ids := make([]string, 0, len(c.Vulnerabilities))
+			for _, v := range c.Vulnerabilities {
+				ids = append(ids, fmt.Sprintf("%s %s", v.AdvisoryGHSAID, v.Severity))
+			}
+			vulnerable = append(vulnerable, fmt.Sprintf("%s@%s (%s)", c.Name, c.Version, strings.Join(ids, ", ")))
+		}
+	}
+	// Keep the removals in the order of the response
+	for _, c := range changes {
+		if _, ok := removed[key(c)]; ok && c.ChangeType == "removed" {
+			diff.Removed = append(diff.Removed, c.summary())
+		}
+	}
+
+	diff.VulnerableAdditions = len(vulnerable)
+	if len(vulnerable) > 0 {
+		diff.Warning = fmt.Sprintf("%d added or updated dependencies have known advisories: %s", len(vulnerable), strings.Join(vulnerable, "; "))
+	}
+	return diff
+}
+
+// GetDependencyDiff creates a tool to compare the dependencies of two refs of a repository.
+func GetDependencyDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependency_diff",
+			mcp.WithDescription(t("TOOL_GET_DEPENDENCY_DIFF_DESCRIPTION", "Get the dependencies added, removed or updated between two refs of a GitHub repository, e.g. the base and head of a pull request, flagging added dependencies with known vulnerabilities")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_DEPENDENCY_DIFF_USER_TITLE", "Get dependency changes"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare from"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/dependency-graph/compare/%s...%s", owner, repo, url.PathEscape(base), url.PathEscape(head)), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var changes []rawDependencyChange
+			resp, err := client.Do(ctx, req, &changes)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot compare the dependencies of %s...%s in %s/%s: %s", base, head, owner, repo, errResp.Message)), nil
+				}
+				return nil, fmt.Errorf("failed to compare dependencies: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare dependencies: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeDependencyChanges(changes))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,264 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExportSBOM(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ExportSBOM(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "export_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockDocument := `{"SPDXID":"SPDXRef-DOCUMENT","spdxVersion":"SPDX-2.3","name":"com.github.owner/repo",` +
+		`"packages":[` +
+		`{"SPDXID":"SPDXRef-npm-lodash","name":"npm:lodash","versionInfo":"4.17.21","externalRefs":[{"referenceCategory":"PACKAGE-MANAGER","referenceType":"purl","referenceLocator":"pkg:npm/lodash@4.17.21"}]},` +
+		`{"SPDXID":"SPDXRef-go-testify","name":"go:github.com/stretchr/testify","versionInfo":"1.10.0"}` +
+		`],"relationships":[{"spdxElementId":"SPDXRef-DOCUMENT","relationshipType":"DESCRIBES","relatedSpdxElement":"SPDXRef-npm-lodash"}]}`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult sbomExport
+		expectedErrMsg string
+	}{
+		{
+			name: "exports the SBOM",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphSbomByOwnerByRepo,
+					mockResponse(t, http.StatusOK, json.RawMessage(`{"sbom":`+mockDocument+`}`)),
+				),
+			),
+			expectedResult: sbomExport{
+				Name:         "com.github.owner/repo",
+				SPDXVersion:  "SPDX-2.3",
+				PackageCount: 2,
+				Document:     mockDocument,
+			},
+		},
+		{
+			name: "dependency graph disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphSbomByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "no SBOM available for owner/repo, check that the dependency graph is enabled for the repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ExportSBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned sbomExport
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ExportSBOM_Truncated(t *testing.T) {
+	packages := make([]string, 0, 2000)
+	for range 2000 {
+		packages = append(packages, `{"SPDXID":"SPDXRef-npm-pkg","name":"npm:`+strings.Repeat("p", 60)+`","versionInfo":"1.0.0"}`)
+	}
+	mockDocument := `{"spdxVersion":"SPDX-2.3","name":"com.github.owner/repo","packages":[` + strings.Join(packages, ",") + `]}`
+	require.Greater(t, len(mockDocument), maxTextResultBytes)
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposDependencyGraphSbomByOwnerByRepo,
+			mockResponse(t, http.StatusOK, json.RawMessage(`{"sbom":`+mockDocument+`}`)),
+		),
+	))
+	_, handler := ExportSBOM(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returned sbomExport
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, 2000, returned.PackageCount)
+	assert.True(t, returned.Truncated)
+	assert.Equal(t, mockDocument[:maxTextResultBytes], returned.Document)
+}
+
+func Test_GetDependencyDiff(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependencyDiff(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_dependency_diff", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockChanges := json.RawMessage(`[
+		{"change_type": "removed", "manifest": "package-lock.json", "ecosystem": "npm", "name": "left-pad", "version": "1.3.0", "package_url": "pkg:npm/left-pad@1.3.0", "license": "WTFPL", "scope": "runtime", "vulnerabilities": []},
+		{"change_type": "removed", "manifest": "package-lock.json", "ecosystem": "npm", "name": "lodash", "version": "4.17.21", "package_url": "pkg:npm/lodash@4.17.21", "license": "MIT", "scope": "runtime", "vulnerabilities": []},
+		{"change_type": "added", "manifest": "package-lock.json", "ecosystem": "npm", "name": "lodash", "version": "4.17.15", "package_url": "pkg:npm/lodash@4.17.15", "license": "MIT", "scope": "runtime", "vulnerabilities": [
+			{"severity": "high", "advisory_ghsa_id": "GHSA-p6mc-m468-83gw", "advisory_summary": "Prototype Pollution in lodash", "advisory_url": "https://github.com/advisories/GHSA-p6mc-m468-83gw"}
+		]},
+		{"change_type": "added", "manifest": "go.mod", "ecosystem": "gomod", "name": "github.com/stretchr/testify", "version": "1.10.0", "package_url": "pkg:golang/github.com/stretchr/testify@1.10.0", "license": "MIT", "scope": "development", "vulnerabilities": []}
+	]`)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedDiff   dependencyDiff
+		expectedErrMsg string
+	}{
+		{
+			name: "groups added, removed and updated dependencies",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/dependency-graph/compare/main...feature", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mockChanges)
+					}),
+				),
+			),
+			expectedDiff: dependencyDiff{
+				Added: []dependencyChange{
+					{
+						Manifest:   "go.mod",
+						Ecosystem:  "gomod",
+						Name:       "github.com/stretchr/testify",
+						Version:    "1.10.0",
+						PackageURL: "pkg:golang/github.com/stretchr/testify@1.10.0",
+						License:    "MIT",
+						Scope:      "development",
+					},
+				},
+				Removed: []dependencyChange{
+					{
+						Manifest:   "package-lock.json",
+						Ecosystem:  "npm",
+						Name:       "left-pad",
+						Version:    "1.3.0",
+						PackageURL: "pkg:npm/left-pad@1.3.0",
+						License:    "WTFPL",
+						Scope:      "runtime",
+					},
+				},
+				Updated: []dependencyChange{
+					{
+						Manifest:        "package-lock.json",
+						Ecosystem:       "npm",
+						Name:            "lodash",
+						Version:         "4.17.15",
+						PreviousVersion: "4.17.21",
+						PackageURL:      "pkg:npm/lodash@4.17.15",
+						License:         "MIT",
+						Scope:           "runtime",
+						Vulnerabilities: []dependencyVulnerability{
+							{
+								Severity:        "high",
+								AdvisoryGHSAID:  "GHSA-p6mc-m468-83gw",
+								AdvisorySummary: "Prototype Pollution in lodash",
+								AdvisoryURL:     "https://github.com/advisories/GHSA-p6mc-m468-83gw",
+							},
+						},
+					},
+				},
+				VulnerableAdditions: 1,
+				Warning:             "1 added or updated dependencies have known advisories: lodash@4.17.15 (GHSA-p6mc-m468-83gw high)",
+			},
+		},
+		{
+			name: "no dependency changes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					[]interface{}{},
+				),
+			),
+			expectedDiff: dependencyDiff{
+				Added:   []dependencyChange{},
+				Removed: []dependencyChange{},
+				Updated: []dependencyChange{},
+			},
+		},
+		{
+			name: "dependency review not available",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDependencyGraphCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusForbidden, json.RawMessage(`{"message": "Dependency review is not supported on this repository."}`)),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "cannot compare the dependencies of main...feature in owner/repo: Dependency review is not supported on this repository.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependencyDiff(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned dependencyDiff
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDiff, returned)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// deployKeyTypes are the SSH public key types GitHub accepts as deploy keys.
+var deployKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"sk-ssh-ed25519@openssh.com",
+	"sk-ecdsa-sha2-nistp256@openssh.com",
+}
+
+// validateSSHPublicKey checks that key is an SSH public key in the authorized_keys format, e.g.
+// "ssh-ed25519 AAAA... comment", so that a private key or a mangled paste is never sent.
+func validateSSHPublicKey(key string) error {
+	if strings.Contains(key, "PRIVATE KEY") {
+		return fmt.Errorf("key must be an SSH public key, not a private key")
+	}
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return fmt.Errorf("key must be an SSH public key of the form '<type> <base64 data> [comment]'")
+	}
+	keyType := fields[0]
+	supported := false
+	for _, t := range deployKeyTypes {
+		if keyType == t {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("unsupported key type %s, must be one of %s", keyType, strings.Join(deployKeyTypes, ", "))
+	}
+
+	// The key data starts with the key type again, as a length prefixed string
+	data, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return fmt.Errorf("key data is not valid base64")
+	}
+	mismatch := fmt.Errorf("key data does not match the key type %s", keyType)
+	if len(data) < 4 {
+		return mismatch
+	}
+	n := binary.BigEndian.Uint32(data)
+	if uint64(n) > uint64(len(data)-4) || string(data[4:4+n]) != keyType {
+		return mismatch
+	}
+	return nil
+}
+
+// ListDeployKeys creates a tool to list the deploy keys of a repository.
+func ListDeployKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deploy_keys",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOY_KEYS_DESCRIPTION", "List the deploy keys of a GitHub repository, the SSH keys that give access to that repository only. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_DEPLOY_KEYS_USER_TITLE", "List deploy keys"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			keys, resp, err := client.Repositories.ListKeys(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list deploy keys: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list deploy keys: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(keys)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateDeployKey creates a tool to add a deploy key to a repository.
+func CreateDeployKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_deploy_key",
+			mcp.WithDescription(t("TOOL_CREATE_DEPLOY_KEY_DESCRIPTION", "Add an SSH public key as a deploy key of a GitHub repository, e.g. to give a CI system access to it. Keys are read-only unless read_only is false")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_DEPLOY_KEY_USER_TITLE", "Create deploy key"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Name of the key"),
+			),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("SSH public key, e.g. the contents of id_ed25519.pub"),
+			),
+			mcp.WithBoolean("read_only",
+				mcp.Description("Whether the key can only pull from the repository, false also allows pushing (default true)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key, err := requiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key = strings.TrimSpace(key)
+			if err := validateSSHPublicKey(key); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readOnly, ok, err := OptionalParamOK[bool](request, "read_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// Unlike the API, default to keys that cannot push
+			if !ok {
+				readOnly = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("add deploy key %s to %s/%s, read only: %t", title, owner, repo, readOnly), nil
+			}
+			created, resp, err := client.Repositories.CreateKey(ctx, owner, repo, &github.Key{
+				Title:    github.Ptr(title),
+				Key:      github.Ptr(key),
+				ReadOnly: github.Ptr(readOnly),
+			})
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot add deploy key %s to %s/%s: %s", title, owner, repo, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to create deploy key: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create deploy key: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteDeployKey creates a tool to remove a deploy key from a repository.
+func DeleteDeployKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_deploy_key",
+			mcp.WithDescription(t("TOOL_DELETE_DEPLOY_KEY_DESCRIPTION", "Remove a deploy key from a GitHub repository, revoking its access")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_DEPLOY_KEY_USER_TITLE", "Delete deploy key"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("key_id",
+				mcp.Required(),
+				mcp.Description("The ID of the deploy key, from list_deploy_keys"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keyID, err := RequiredInt(request, "key_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("delete deploy key %d from %s/%s", keyID, owner, repo), nil
+			}
+			resp, err := client.Repositories.DeleteKey(ctx, owner, repo, int64(keyID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("deploy key %d not found in %s/%s", keyID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to delete deploy key: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete deploy key: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted deploy key %d from %s/%s", keyID, owner, repo)), nil
+		}
+}
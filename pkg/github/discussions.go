@@ -0,0 +1,211 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const issueForDiscussionQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    id
+    hasDiscussionsEnabled
+    discussionCategories(first: 100) {
+      nodes {
+        id
+        name
+        slug
+      }
+    }
+    issue(number: $number) {
+      title
+      body
+      state
+    }
+  }
+}`
+
+const createDiscussionMutation = `mutation($repositoryId: ID!, $categoryId: ID!, $title: String!, $body: String!) {
+  createDiscussion(input: {repositoryId: $repositoryId, categoryId: $categoryId, title: $title, body: $body}) {
+    discussion {
+      number
+      url
+    }
+  }
+}`
+
+// discussionCategory is a discussion category of a repository.
+type discussionCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// convertedIssue is the result of convert_issue_to_discussion.
+type convertedIssue struct {
+	IssueNumber      int    `json:"issue_number"`
+	DiscussionNumber int    `json:"discussion_number"`
+	DiscussionURL    string `json:"discussion_url"`
+	Category         string `json:"category"`
+}
+
+// findDiscussionCategory returns the category whose name or slug matches category, ignoring case.
+func findDiscussionCategory(categories []discussionCategory, category string) (discussionCategory, bool) {
+	for _, c := range categories {
+		if strings.EqualFold(c.Name, category) || strings.EqualFold(c.Slug, category) {
+			return c, true
+		}
+	}
+	return discussionCategory{}, false
+}
+
+// ConvertIssueToDiscussion creates a tool to move an issue to a discussion.
+func ConvertIssueToDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_issue_to_discussion",
+			mcp.WithDescription(t("TOOL_CONVERT_ISSUE_TO_DISCUSSION_DESCRIPTION", "Move an issue to a discussion in the same repository: creates a discussion with the issue title and body in the given category, then comments on the issue with a link to it and closes the issue as not planned")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_ISSUE_TO_DISCUSSION_USER_TITLE", "Convert issue to discussion"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number to convert"),
+			),
+			mcp.WithString("category",
+				mcp.Required(),
+				mcp.Description("Name or slug of the discussion category, e.g. Q&A"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			categoryParam, err := requiredParam[string](request, "category")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var data struct {
+				Repository struct {
+					ID                    string `json:"id"`
+					HasDiscussionsEnabled bool   `json:"hasDiscussionsEnabled"`
+					DiscussionCategories  struct {
+						Nodes []discussionCategory `json:"nodes"`
+					} `json:"discussionCategories"`
+					Issue *struct {
+						Title string `json:"title"`
+						Body  string `json:"body"`
+						State string `json:"state"`
+					} `json:"issue"`
+				} `json:"repository"`
+			}
+			err = doGraphQL(ctx, client, issueForDiscussionQuery, map[string]any{
+				"owner":  owner,
+				"repo":   repo,
+				"number": issueNumber,
+			}, &data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+
+			if !data.Repository.HasDiscussionsEnabled {
+				return mcp.NewToolResultError(fmt.Sprintf("discussions are disabled in %s/%s, enable them in the repository settings first", owner, repo)), nil
+			}
+			issue := data.Repository.Issue
+			if issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue #%d not found in %s/%s", issueNumber, owner, repo)), nil
+			}
+			if issue.State != "OPEN" {
+				return mcp.NewToolResultError(fmt.Sprintf("issue #%d is already closed", issueNumber)), nil
+			}
+			categories := data.Repository.DiscussionCategories.Nodes
+			category, ok := findDiscussionCategory(categories, categoryParam)
+			if !ok {
+				names := make([]string, 0, len(categories))
+				for _, c := range categories {
+					names = append(names, c.Name)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("discussion category %s not found in %s/%s, available categories: %s", categoryParam, owner, repo, strings.Join(names, ", "))), nil
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("move issue #%d of %s/%s to a discussion in category %s and close it", issueNumber, owner, repo, category.Name), nil
+			}
+			var created struct {
+				CreateDiscussion struct {
+					Discussion struct {
+						Number int    `json:"number"`
+						URL    string `json:"url"`
+					} `json:"discussion"`
+				} `json:"createDiscussion"`
+			}
+			err = doGraphQL(ctx, client, createDiscussionMutation, map[string]any{
+				"repositoryId": data.Repository.ID,
+				"categoryId":   category.ID,
+				"title":        issue.Title,
+				"body":         fmt.Sprintf("%s\n\n_Moved from #%d._", issue.Body, issueNumber),
+			}, &created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create discussion: %w", err)
+			}
+			discussion := created.CreateDiscussion.Discussion
+
+			// The discussion exists from here on, so errors name it to avoid a retry creating a duplicate
+			_, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{
+				Body: github.Ptr(fmt.Sprintf("Moved to a discussion: %s", discussion.URL)),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("created discussion %s but failed to comment on the issue: %w", discussion.URL, err)
+			}
+			_ = resp.Body.Close()
+
+			_, resp, err = client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{
+				State:       github.Ptr("closed"),
+				StateReason: github.Ptr("not_planned"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("created discussion %s but failed to close the issue: %w", discussion.URL, err)
+			}
+			_ = resp.Body.Close()
+
+			r, err := json.Marshal(convertedIssue{
+				IssueNumber:      issueNumber,
+				DiscussionNumber: discussion.Number,
+				DiscussionURL:    discussion.URL,
+				Category:         category.Name,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
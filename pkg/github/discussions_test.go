@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConvertIssueToDiscussion(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ConvertIssueToDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "convert_issue_to_discussion", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "category")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "category"})
+
+	repositoryData := func(discussionsEnabled bool, state string) map[string]any {
+		return map[string]any{
+			"data": map[string]any{
+				"repository": map[string]any{
+					"id":                    "R_1",
+					"hasDiscussionsEnabled": discussionsEnabled,
+					"discussionCategories": map[string]any{
+						"nodes": []map[string]any{
+							{"id": "DIC_1", "name": "General", "slug": "general"},
+							{"id": "DIC_2", "name": "Q&A", "slug": "q-a"},
+						},
+					},
+					"issue": map[string]any{
+						"title": "How do I configure the cache?",
+						"body":  "I can't find the option.",
+						"state": state,
+					},
+				},
+			},
+		}
+	}
+	// graphQL answers the repository query with repository and checks the createDiscussion variables
+	graphQL := func(repository map[string]any) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mockGraphQLEndpoint,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body graphQLRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				w.WriteHeader(http.StatusOK)
+				if strings.Contains(body.Query, "createDiscussion") {
+					assert.Equal(t, map[string]any{
+						"repositoryId": "R_1",
+						"categoryId":   "DIC_2",
+						"title":        "How do I configure the cache?",
+						"body":         "I can't find the option.\n\n_Moved from #42._",
+					}, body.Variables)
+					b, _ := json.Marshal(map[string]any{
+						"data": map[string]any{
+							"createDiscussion": map[string]any{
+								"discussion": map[string]any{
+									"number": 7,
+									"url":    "https://github.com/owner/repo/discussions/7",
+								},
+							},
+						},
+					})
+					_, _ = w.Write(b)
+					return
+				}
+				assert.Equal(t, map[string]any{"owner": "owner", "repo": "repo", "number": float64(42)}, body.Variables)
+				b, _ := json.Marshal(repository)
+				_, _ = w.Write(b)
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expected       convertedIssue
+		expectedErrMsg string
+	}{
+		{
+			name: "converts the issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				graphQL(repositoryData(true, "OPEN")),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var comment github.IssueComment
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+						assert.Equal(t, "Moved to a discussion: https://github.com/owner/repo/discussions/7", comment.GetBody())
+						w.WriteHeader(http.StatusCreated)
+						_, _ = w.Write([]byte(`{"id": 1}`))
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":        "closed",
+						"state_reason": "not_planned",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{Number: github.Ptr(42)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"category":     "q&a",
+			},
+			expected: convertedIssue{
+				IssueNumber:      42,
+				DiscussionNumber: 7,
+				DiscussionURL:    "https://github.com/owner/repo/discussions/7",
+				Category:         "Q&A",
+			},
+		},
+		{
+			name:         "discussions disabled",
+			mockedClient: mock.NewMockedHTTPClient(graphQL(repositoryData(false, "OPEN"))),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"category":     "q&a",
+			},
+			expectedErrMsg: "discussions are disabled in owner/repo, enable them in the repository settings first",
+		},
+		{
+			name:         "unknown category",
+			mockedClient: mock.NewMockedHTTPClient(graphQL(repositoryData(true, "OPEN"))),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"category":     "Ideas",
+			},
+			expectedErrMsg: "discussion category Ideas not found in owner/repo, available categories: General, Q&A",
+		},
+		{
+			name:         "issue already closed",
+			mockedClient: mock.NewMockedHTTPClient(graphQL(repositoryData(true, "CLOSED"))),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"category":     "q-a",
+			},
+			expectedErrMsg: "issue #42 is already closed",
+		},
+		{
+			name: "issue lookup fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					mockResponse(t, http.StatusOK, map[string]any{
+						"errors": []map[string]any{
+							{"message": "Could not resolve to an Issue with the number of 42."},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"category":     "q-a",
+			},
+			expectError:    true,
+			expectedErrMsg: "Could not resolve to an Issue with the number of 42.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ConvertIssueToDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned convertedIssue
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type dryRunKey struct{}
+
+// ContextWithDryRun returns a context in which mutating tools validate their inputs and make
+// the lookups they depend on, but describe the write they would make instead of making it.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// isDryRun reports whether the tool call of ctx must not make any write.
+func isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// WithDryRun returns a server option running every tool call in dry-run mode, see ContextWithDryRun.
+func WithDryRun() server.ServerOption {
+	return server.WithToolHandlerMiddleware(func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return next(ContextWithDryRun(ctx), request)
+		}
+	})
+}
+
+// dryRunResult is the result of a mutating tool in dry-run mode, describing the write it skipped,
+// e.g. dryRunResult("create issue %q in %s/%s", title, owner, repo).
+func dryRunResult(format string, args ...any) *mcp.CallToolResult {
+	return mcp.NewToolResultText("Dry run, nothing was changed: would " + fmt.Sprintf(format, args...))
+}
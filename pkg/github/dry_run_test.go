@@ -0,0 +1,119 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DryRun(t *testing.T) {
+	assert.False(t, isDryRun(context.Background()))
+	assert.True(t, isDryRun(ContextWithDryRun(context.Background())))
+}
+
+func Test_CreateIssue_DryRun(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostReposIssuesByOwnerByRepo,
+			failOnRequest(t),
+		),
+	)
+	_, handler := CreateIssue(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	// Inputs are still validated
+	result, err := handler(ContextWithDryRun(context.Background()), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, "missing required parameter: title", getTextResult(t, result).Text)
+
+	result, err = handler(ContextWithDryRun(context.Background()), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+		"title": "Crash on empty input",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, `Dry run, nothing was changed: would create issue "Crash on empty input" in owner/repo`, getTextResult(t, result).Text)
+}
+
+func Test_CreateBranch_DryRun(t *testing.T) {
+	var refLookups int
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposGitRefByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				refLookups++
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(mock.MustMarshal(&github.Reference{
+					Ref:    github.Ptr("refs/heads/main"),
+					Object: &github.GitObject{SHA: github.Ptr("abc123")},
+				}))
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.PostReposGitRefsByOwnerByRepo,
+			failOnRequest(t),
+		),
+	)
+	_, handler := CreateBranch(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	result, err := handler(ContextWithDryRun(context.Background()), createMCPRequest(map[string]interface{}{
+		"owner":       "owner",
+		"repo":        "repo",
+		"branch":      "new-feature",
+		"from_branch": "main",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, 1, refLookups, "the source branch is still resolved")
+	assert.Equal(t, "Dry run, nothing was changed: would create branch new-feature from main at abc123 in owner/repo", getTextResult(t, result).Text)
+}
+
+func Test_DeleteBranch_DryRun(t *testing.T) {
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposByOwnerByRepo,
+			&github.Repository{DefaultBranch: github.Ptr("main")},
+			&github.Repository{DefaultBranch: github.Ptr("main")},
+		),
+		mock.WithRequestMatch(
+			mock.GetReposBranchesByOwnerByRepoByBranch,
+			&github.Branch{Name: github.Ptr("feature"), Protected: github.Ptr(true)},
+			&github.Branch{Name: github.Ptr("feature"), Protected: github.Ptr(true)},
+		),
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposGitRefsByOwnerByRepoByRef,
+			failOnRequest(t),
+		),
+	)
+	_, handler := DeleteBranch(stubGetClientFn(github.NewClient(mockedClient)), translations.NullTranslationHelper)
+
+	// The checks that depend on lookups still refuse the deletion
+	result, err := handler(ContextWithDryRun(context.Background()), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"branch": "feature",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, "branch feature is protected, set confirm to true to delete it anyway", getTextResult(t, result).Text)
+
+	result, err = handler(ContextWithDryRun(context.Background()), createMCPRequest(map[string]interface{}{
+		"owner":   "owner",
+		"repo":    "repo",
+		"branch":  "feature",
+		"confirm": true,
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.Equal(t, "Dry run, nothing was changed: would delete branch feature from owner/repo", getTextResult(t, result).Text)
+}
@@ -0,0 +1,329 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// gistFileContent returns the text of a gist file. The gists API cuts the content of files over
+// a megabyte short, their full text is downloaded from the raw URL instead.
+func gistFileContent(ctx context.Context, client *github.Client, file github.GistFile) (string, error) {
+	content := file.GetContent()
+	if len(content) >= file.GetSize() || file.GetRawURL() == "" {
+		return content, nil
+	}
+
+	req, err := client.NewRequest(http.MethodGet, file.GetRawURL(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.BareDo(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download gist file %s: %w", file.GetFilename(), err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Only read one byte past the limit, enough to know the text is truncated
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTextResultBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read gist file %s: %w", file.GetFilename(), err)
+	}
+	return string(data), nil
+}
+
+// flatGistFile is a file of a flat gist, without its content.
+type flatGistFile struct {
+	Filename string `json:"filename"`
+	Language string `json:"language,omitempty"`
+	Size     int    `json:"size"`
+}
+
+// flatGist is the flat form of a gist, an index entry without file contents to browse many gists
+// before fetching one.
+type flatGist struct {
+	ID          string         `json:"id"`
+	Description string         `json:"description"`
+	URL         string         `json:"url"`
+	Files       []flatGistFile `json:"files"`
+}
+
+// flattenGist converts a gist to its flat form, with its files sorted by name.
+func flattenGist(gist *github.Gist) flatGist {
+	files := make([]flatGistFile, 0, len(gist.Files))
+	for name, file := range gist.Files {
+		files = append(files, flatGistFile{
+			Filename: string(name),
+			Language: file.GetLanguage(),
+			Size:     file.GetSize(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Filename < files[j].Filename })
+	return flatGist{
+		ID:          gist.GetID(),
+		Description: gist.GetDescription(),
+		URL:         gist.GetHTMLURL(),
+		Files:       files,
+	}
+}
+
+// gistFileText returns the text of a gist file truncated to maxTextResultBytes, with a note when it is.
+func gistFileText(ctx context.Context, client *github.Client, file github.GistFile) (string, error) {
+	content, err := gistFileContent(ctx, client, file)
+	if err != nil {
+		return "", err
+	}
+	content, truncated := truncateText(content, maxTextResultBytes)
+	if truncated {
+		content += fmt.Sprintf("\n[file truncated to %d bytes]\n", maxTextResultBytes)
+	}
+	return content, nil
+}
+
+// structuredGistFile is a file of a structuredGist.
+type structuredGistFile struct {
+	Filename string `json:"filename"`
+	Language string `json:"language,omitempty"`
+	Size     int    `json:"size"`
+	Content  string `json:"content"`
+}
+
+// structuredGist holds the core fields of a gist, returned by get_gist as structured content.
+type structuredGist struct {
+	ID          string               `json:"id"`
+	Description string               `json:"description"`
+	URL         string               `json:"url"`
+	Public      bool                 `json:"public"`
+	Owner       string               `json:"owner"`
+	Files       []structuredGistFile `json:"files"`
+}
+
+// GetGist creates a tool to get a gist.
+func GetGist(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_gist",
+			mcp.WithDescription(t("TOOL_GET_GIST_DESCRIPTION", "Get a gist with its files")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GIST_USER_TITLE", "Get gist"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("gist_id",
+				mcp.Required(),
+				mcp.Description("The ID of the gist"),
+			),
+			mcp.WithBoolean("content_only",
+				mcp.Description(fmt.Sprintf("Only return a map of file name to file content, each truncated to %d bytes, instead of the whole gist", maxTextResultBytes)),
+			),
+			mcp.WithBoolean("flat",
+				mcp.Description("Only return the ID, description, URL and the name, language and size of each file, without contents"),
+			),
+			mcp.WithBoolean("structured",
+				mcp.Description(fmt.Sprintf("Only return the core fields of the gist, with the content of each file truncated to %d bytes, also as structured content described by the output schema of the tool. Cannot be combined with 'content_only' or 'flat'", maxTextResultBytes)),
+			),
+			mcp.WithOutputSchema[structuredGist](),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			gistID, err := requiredParam[string](request, "gist_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			contentOnly, err := OptionalParam[bool](request, "content_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			flat, err := OptionalParam[bool](request, "flat")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if contentOnly && flat {
+				return mcp.NewToolResultError("content_only and flat cannot both be set"), nil
+			}
+			structured, err := OptionalParam[bool](request, "structured")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if contentOnly && structured {
+				return mcp.NewToolResultError("content_only and structured cannot both be set"), nil
+			}
+			if flat && structured {
+				return mcp.NewToolResultError("flat and structured cannot both be set"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			gist, resp, err := client.Gists.Get(ctx, gistID)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("gist %s not found", gistID)), nil
+				}
+				return nil, fmt.Errorf("failed to get gist: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get gist: %s", string(body))), nil
+			}
+
+			if structured {
+				result := structuredGist{
+					ID:          gist.GetID(),
+					Description: gist.GetDescription(),
+					URL:         gist.GetHTMLURL(),
+					Public:      gist.GetPublic(),
+					Owner:       gist.GetOwner().GetLogin(),
+					Files:       make([]structuredGistFile, 0, len(gist.Files)),
+				}
+				for name, file := range gist.Files {
+					content, err := gistFileText(ctx, client, file)
+					if err != nil {
+						return nil, err
+					}
+					result.Files = append(result.Files, structuredGistFile{
+						Filename: string(name),
+						Language: file.GetLanguage(),
+						Size:     file.GetSize(),
+						Content:  content,
+					})
+				}
+				sort.Slice(result.Files, func(i, j int) bool { return result.Files[i].Filename < result.Files[j].Filename })
+
+				r, err := json.Marshal(result)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultStructured(result, string(r)), nil
+			}
+
+			if !contentOnly {
+				var result any = gist
+				if flat {
+					result = flattenGist(gist)
+				}
+				r, err := json.Marshal(result)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			contents := make(map[string]string, len(gist.Files))
+			for name, file := range gist.Files {
+				content, err := gistFileText(ctx, client, file)
+				if err != nil {
+					return nil, err
+				}
+				contents[string(name)] = content
+			}
+
+			r, err := json.Marshal(contents)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListGists creates a tool to list the gists of a user.
+func ListGists(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_gists",
+			mcp.WithDescription(t("TOOL_LIST_GISTS_DESCRIPTION", "List the gists of a user, or of the authenticated user by default. Set 'flat' for a lightweight index of the gists and their files to browse before fetching one with get_gist")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_GISTS_USER_TITLE", "List gists"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("username",
+				mcp.Description("The user to list the gists of, defaults to the authenticated user"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only list gists updated after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithBoolean("flat",
+				mcp.Description("Only return the ID, description, URL and the name, language and size of each file of each gist"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			flat, err := OptionalParam[bool](request, "flat")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.GistListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if since != "" {
+				timestamp, err := parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list gists: %s", err.Error())), nil
+				}
+				opts.Since = timestamp
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gists, resp, err := client.Gists.List(ctx, username, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("user %s not found", username)), nil
+				}
+				return nil, fmt.Errorf("failed to list gists: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list gists: %s", string(body))), nil
+			}
+
+			var result any = gists
+			if flat {
+				flatGists := make([]flatGist, 0, len(gists))
+				for _, gist := range gists {
+					flatGists = append(flatGists, flattenGist(gist))
+				}
+				result = flatGists
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
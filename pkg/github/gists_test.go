@@ -0,0 +1,402 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetGist(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetGist(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_gist", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "gist_id")
+	assert.Contains(t, tool.InputSchema.Properties, "content_only")
+	assert.Contains(t, tool.InputSchema.Properties, "flat")
+	assert.Contains(t, tool.InputSchema.Properties, "structured")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"gist_id"})
+	assert.Contains(t, string(tool.RawOutputSchema), `"required":["id","description","url","public","owner","files"]`)
+
+	largeContent := strings.Repeat("x", maxTextResultBytes+10)
+	mockGist := &github.Gist{
+		ID:          github.Ptr("abc123"),
+		Description: github.Ptr("snippets"),
+		HTMLURL:     github.Ptr("https://gist.github.com/octocat/abc123"),
+		Files: map[github.GistFilename]github.GistFile{
+			"hello.go": {
+				Filename: github.Ptr("hello.go"),
+				Language: github.Ptr("Go"),
+				Size:     github.Ptr(12),
+				RawURL:   github.Ptr("https://gist.githubusercontent.com/octocat/abc123/raw/1111/hello.go"),
+				Content:  github.Ptr("package main"),
+			},
+			"large.txt": {
+				Filename: github.Ptr("large.txt"),
+				Size:     github.Ptr(len(largeContent)),
+				RawURL:   github.Ptr("https://gist.githubusercontent.com/octocat/abc123/raw/2222/large.txt"),
+				Content:  github.Ptr("xxxx"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedGist       *github.Gist
+		expectedContents   map[string]string
+		expectedFlat       *flatGist
+		expectedStructured *structuredGist
+		expectedErrMsg     string
+	}{
+		{
+			name: "returns the whole gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGistsByGistId,
+					mockGist,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "abc123",
+			},
+			expectedGist: mockGist,
+		},
+		{
+			name: "returns only the file contents",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGistsByGistId,
+					mockGist,
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/octocat/abc123/raw/2222/large.txt",
+						Method:  "GET",
+					},
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(largeContent))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id":      "abc123",
+				"content_only": true,
+			},
+			expectedContents: map[string]string{
+				"hello.go":  "package main",
+				"large.txt": largeContent[:maxTextResultBytes] + "\n[file truncated to 102400 bytes]\n",
+			},
+		},
+		{
+			name: "returns the flat gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGistsByGistId,
+					mockGist,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "abc123",
+				"flat":    true,
+			},
+			expectedFlat: &flatGist{
+				ID:          "abc123",
+				Description: "snippets",
+				URL:         "https://gist.github.com/octocat/abc123",
+				Files: []flatGistFile{
+					{Filename: "hello.go", Language: "Go", Size: 12},
+					{Filename: "large.txt", Size: len(largeContent)},
+				},
+			},
+		},
+		{
+			name:         "content only and flat",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"gist_id":      "abc123",
+				"content_only": true,
+				"flat":         true,
+			},
+			expectError:    true,
+			expectedErrMsg: "content_only and flat cannot both be set",
+		},
+		{
+			name: "returns the structured gist",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGistsByGistId,
+					mockGist,
+				),
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/octocat/abc123/raw/2222/large.txt",
+						Method:  "GET",
+					},
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(largeContent))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id":    "abc123",
+				"structured": true,
+			},
+			expectedStructured: &structuredGist{
+				ID:          "abc123",
+				Description: "snippets",
+				URL:         "https://gist.github.com/octocat/abc123",
+				Files: []structuredGistFile{
+					{Filename: "hello.go", Language: "Go", Size: 12, Content: "package main"},
+					{Filename: "large.txt", Size: len(largeContent), Content: largeContent[:maxTextResultBytes] + "\n[file truncated to 102400 bytes]\n"},
+				},
+			},
+		},
+		{
+			name:         "content only and structured",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"gist_id":      "abc123",
+				"content_only": true,
+				"structured":   true,
+			},
+			expectError:    true,
+			expectedErrMsg: "content_only and structured cannot both be set",
+		},
+		{
+			name:         "flat and structured",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"gist_id":    "abc123",
+				"flat":       true,
+				"structured": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "flat and structured cannot both be set",
+		},
+		{
+			name: "gist not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"gist_id": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "gist missing not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			if tc.expectedStructured != nil {
+				assertMatchesOutputSchema(t, tool, result)
+				assert.Equal(t, *tc.expectedStructured, result.StructuredContent)
+				var returned structuredGist
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
+				require.NoError(t, err)
+				assert.Equal(t, *tc.expectedStructured, returned)
+				return
+			}
+			assert.Nil(t, result.StructuredContent)
+
+			if tc.expectedFlat != nil {
+				var returned flatGist
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
+				require.NoError(t, err)
+				assert.Equal(t, *tc.expectedFlat, returned)
+				return
+			}
+
+			if tc.expectedContents != nil {
+				var returned map[string]string
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedContents, returned)
+				return
+			}
+
+			var returned github.Gist
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedGist, returned)
+		})
+	}
+}
+
+func Test_ListGists(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListGists(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_gists", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "flat")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockGists := []*github.Gist{
+		{
+			ID:          github.Ptr("abc123"),
+			Description: github.Ptr("snippets"),
+			HTMLURL:     github.Ptr("https://gist.github.com/octocat/abc123"),
+			Files: map[github.GistFilename]github.GistFile{
+				"main.go":   {Filename: github.Ptr("main.go"), Language: github.Ptr("Go"), Size: github.Ptr(120)},
+				"README.md": {Filename: github.Ptr("README.md"), Language: github.Ptr("Markdown"), Size: github.Ptr(40)},
+				"build.sh":  {Filename: github.Ptr("build.sh"), Language: github.Ptr("Shell"), Size: github.Ptr(18)},
+			},
+		},
+		{
+			ID:          github.Ptr("def456"),
+			Description: github.Ptr(""),
+			HTMLURL:     github.Ptr("https://gist.github.com/octocat/def456"),
+			Files: map[github.GistFilename]github.GistFile{
+				"notes.txt": {Filename: github.Ptr("notes.txt"), Language: github.Ptr("Text"), Size: github.Ptr(5)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedFlat   []flatGist
+		expectedIDs    []string
+		expectedErrMsg string
+	}{
+		{
+			name: "list the gists of a user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersGistsByUsername,
+					expectQueryParams(t, map[string]string{
+						"since":    "2024-01-01T00:00:00Z",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockGists),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"since":    "2024-01-01",
+				"page":     float64(2),
+				"perPage":  float64(10),
+			},
+			expectedIDs: []string{"abc123", "def456"},
+		},
+		{
+			name: "flat gists of the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGists,
+					mockGists,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"flat": true,
+			},
+			expectedFlat: []flatGist{
+				{
+					ID:          "abc123",
+					Description: "snippets",
+					URL:         "https://gist.github.com/octocat/abc123",
+					Files: []flatGistFile{
+						{Filename: "README.md", Language: "Markdown", Size: 40},
+						{Filename: "build.sh", Language: "Shell", Size: 18},
+						{Filename: "main.go", Language: "Go", Size: 120},
+					},
+				},
+				{
+					ID:  "def456",
+					URL: "https://gist.github.com/octocat/def456",
+					Files: []flatGistFile{
+						{Filename: "notes.txt", Language: "Text", Size: 5},
+					},
+				},
+			},
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersGistsByUsername,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "user missing not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListGists(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			if tc.expectedFlat != nil {
+				var returned []flatGist
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, tc.expectedFlat, returned)
+				return
+			}
+
+			var returned []*github.Gist
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			ids := make([]string, 0, len(returned))
+			for _, gist := range returned {
+				ids = append(ids, gist.GetID())
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
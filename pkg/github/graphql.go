@@ -0,0 +1,103 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// graphQLRequest is the body of a GraphQL API call.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the envelope of a GraphQL API response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// doGraphQL runs a GraphQL query through the REST client, so it shares its authentication, transport and
+// host configuration, and unmarshals the "data" field of the response into result.
+//
+// The GraphQL endpoint is resolved relative to the REST base URL: https://api.github.com/ maps to
+// https://api.github.com/graphql and https://<host>/api/v3/ maps to https://<host>/api/graphql.
+func doGraphQL(ctx context.Context, client *github.Client, query string, variables map[string]any, result any) error {
+	req, err := client.NewRequest(http.MethodPost, "../graphql", &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create GraphQL request: %w", err)
+	}
+
+	var response graphQLResponse
+	resp, err := client.Do(ctx, req, &response)
+	if err != nil {
+		return fmt.Errorf("failed to execute GraphQL query: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("GraphQL query failed: %s", strings.Join(messages, "; "))
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(response.Data, result); err != nil {
+		return fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+	}
+	return nil
+}
+
+// graphQLPageInfo is the pageInfo of a GraphQL connection, to be selected as
+// pageInfo { hasNextPage endCursor }.
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// doGraphQLPages runs a query over a paginated connection, following its cursor for at most maxPages
+// pages. The query must take an $after: String variable, the cursor to start from, which is omitted
+// for the first page unless variables already has one. The data of each page is passed to page,
+// which returns the pageInfo of the connection it reads.
+//
+// The pageInfo of the last page fetched is returned, its endCursor continues the listing when
+// hasNextPage is still set.
+func doGraphQLPages(ctx context.Context, client *github.Client, query string, variables map[string]any, maxPages int, page func(data json.RawMessage) (graphQLPageInfo, error)) (graphQLPageInfo, error) {
+	// Copy the variables, the cursor of each page is set in place of the caller's
+	vars := make(map[string]any, len(variables)+1)
+	for k, v := range variables {
+		vars[k] = v
+	}
+
+	var pageInfo graphQLPageInfo
+	for i := 0; i < maxPages; i++ {
+		var data json.RawMessage
+		if err := doGraphQL(ctx, client, query, vars, &data); err != nil {
+			return graphQLPageInfo{}, err
+		}
+		var err error
+		pageInfo, err = page(data)
+		if err != nil {
+			return graphQLPageInfo{}, err
+		}
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			break
+		}
+		vars["after"] = pageInfo.EndCursor
+	}
+	return pageInfo, nil
+}
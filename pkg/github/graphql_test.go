@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DoGraphQL(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedLogin  string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					expectRequestBody(t, map[string]any{
+						"query":     "query { viewer { login } }",
+						"variables": map[string]any{"first": float64(1)},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string]any{
+							"data": map[string]any{"viewer": map[string]any{"login": "octocat"}},
+						}),
+					),
+				),
+			),
+			expectError:   false,
+			expectedLogin: "octocat",
+		},
+		{
+			name: "query returns errors",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					mockResponse(t, http.StatusOK, map[string]any{
+						"errors": []any{
+							map[string]any{"message": "Field 'viewer' is missing"},
+							map[string]any{"message": "Rate limited"},
+						},
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "GraphQL query failed: Field 'viewer' is missing; Rate limited",
+		},
+		{
+			name: "request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to execute GraphQL query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+
+			var data struct {
+				Viewer struct {
+					Login string `json:"login"`
+				} `json:"viewer"`
+			}
+			err := doGraphQL(context.Background(), client, "query { viewer { login } }", map[string]any{"first": 1}, &data)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLogin, data.Viewer.Login)
+		})
+	}
+}
+
+func Test_DoGraphQL_EnterpriseEndpoint(t *testing.T) {
+	var requestedURL *url.URL
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			requestedURL = r.URL
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		}),
+	}
+	client, err := github.NewClient(httpClient).WithEnterpriseURLs("https://ghes.example.com", "https://ghes.example.com")
+	require.NoError(t, err)
+
+	err = doGraphQL(context.Background(), client, "query { viewer { login } }", nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, requestedURL)
+	assert.Equal(t, "https://ghes.example.com/api/graphql", requestedURL.String())
+}
+
+func Test_DoGraphQLPages(t *testing.T) {
+	const query = "query($after: String) { viewer { repositories(first: 2, after: $after) { nodes { name } pageInfo { hasNextPage endCursor } } } }"
+
+	// pages maps the cursor of each request to the names and pageInfo returned for it
+	pages := map[string]struct {
+		names    []string
+		pageInfo graphQLPageInfo
+	}{
+		"":        {names: []string{"a", "b"}, pageInfo: graphQLPageInfo{HasNextPage: true, EndCursor: "cursor1"}},
+		"cursor1": {names: []string{"c", "d"}, pageInfo: graphQLPageInfo{HasNextPage: true, EndCursor: "cursor2"}},
+		"cursor2": {names: []string{"e"}, pageInfo: graphQLPageInfo{HasNextPage: false, EndCursor: "cursor3"}},
+	}
+	newClient := func(t *testing.T, requests *[]map[string]any) *github.Client {
+		return github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mockGraphQLEndpoint,
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var body graphQLRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					*requests = append(*requests, body.Variables)
+
+					after, _ := body.Variables["after"].(string)
+					page, ok := pages[after]
+					require.True(t, ok, "unexpected cursor %q", after)
+					nodes := make([]any, 0, len(page.names))
+					for _, name := range page.names {
+						nodes = append(nodes, map[string]any{"name": name})
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"data": map[string]any{"viewer": map[string]any{"repositories": map[string]any{
+							"nodes":    nodes,
+							"pageInfo": page.pageInfo,
+						}}},
+					})
+				}),
+			),
+		))
+	}
+	collect := func(names *[]string) func(json.RawMessage) (graphQLPageInfo, error) {
+		return func(data json.RawMessage) (graphQLPageInfo, error) {
+			var page struct {
+				Viewer struct {
+					Repositories struct {
+						Nodes []struct {
+							Name string `json:"name"`
+						} `json:"nodes"`
+						PageInfo graphQLPageInfo `json:"pageInfo"`
+					} `json:"repositories"`
+				} `json:"viewer"`
+			}
+			if err := json.Unmarshal(data, &page); err != nil {
+				return graphQLPageInfo{}, err
+			}
+			for _, node := range page.Viewer.Repositories.Nodes {
+				*names = append(*names, node.Name)
+			}
+			return page.Viewer.Repositories.PageInfo, nil
+		}
+	}
+
+	t.Run("follows the cursor until the last page", func(t *testing.T) {
+		var requests []map[string]any
+		var names []string
+		variables := map[string]any{"first": 2}
+		pageInfo, err := doGraphQLPages(context.Background(), newClient(t, &requests), query, variables, 10, collect(&names))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+		assert.Equal(t, graphQLPageInfo{HasNextPage: false, EndCursor: "cursor3"}, pageInfo)
+		assert.Equal(t, []map[string]any{
+			{"first": float64(2)},
+			{"first": float64(2), "after": "cursor1"},
+			{"first": float64(2), "after": "cursor2"},
+		}, requests)
+		assert.Equal(t, map[string]any{"first": 2}, variables, "the caller's variables are left untouched")
+	})
+
+	t.Run("stops after maxPages with the cursor to continue from", func(t *testing.T) {
+		var requests []map[string]any
+		var names []string
+		pageInfo, err := doGraphQLPages(context.Background(), newClient(t, &requests), query, nil, 2, collect(&names))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c", "d"}, names)
+		assert.Equal(t, graphQLPageInfo{HasNextPage: true, EndCursor: "cursor2"}, pageInfo)
+		assert.Len(t, requests, 2)
+	})
+
+	t.Run("starts from the given cursor", func(t *testing.T) {
+		var requests []map[string]any
+		var names []string
+		pageInfo, err := doGraphQLPages(context.Background(), newClient(t, &requests), query, map[string]any{"after": "cursor2"}, 10, collect(&names))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"e"}, names)
+		assert.False(t, pageInfo.HasNextPage)
+		assert.Len(t, requests, 1)
+	})
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -2,14 +2,22 @@ package github
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// mockGraphQLEndpoint matches calls to the GraphQL API, which go-github-mock has no endpoint pattern for.
+var mockGraphQLEndpoint = mock.EndpointPattern{
+	Pattern: "/graphql",
+	Method:  "POST",
+}
+
 // expectQueryParams is a helper function to create a partial mock that expects a
 // request with the given query parameters, with the ability to chain a response handler.
 func expectQueryParams(t *testing.T, expectedQueryParams map[string]string) *partialMock {
@@ -68,16 +76,18 @@ func mockResponse(t *testing.T, code int, body interface{}) http.HandlerFunc {
 	}
 }
 
+// failOnRequest fails the test if the endpoint it handles is called.
+func failOnRequest(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 // createMCPRequest is a helper function to create a MCP request with the given arguments.
 func createMCPRequest(args map[string]interface{}) mcp.CallToolRequest {
 	return mcp.CallToolRequest{
-		Params: struct {
-			Name      string                 `json:"name"`
-			Arguments map[string]interface{} `json:"arguments,omitempty"`
-			Meta      *struct {
-				ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-			} `json:"_meta,omitempty"`
-		}{
+		Params: mcp.CallToolParams{
 			Arguments: args,
 		},
 	}
@@ -94,6 +104,61 @@ func getTextResult(t *testing.T, result *mcp.CallToolResult) mcp.TextContent {
 	return textContent
 }
 
+// assertMatchesOutputSchema is a helper function that checks the structured content of a tool result
+// against the output schema of the tool: the types of the values, the required properties and that
+// no undeclared property is returned.
+func assertMatchesOutputSchema(t *testing.T, tool mcp.Tool, result *mcp.CallToolResult) {
+	t.Helper()
+	require.NotEmpty(t, tool.RawOutputSchema)
+	require.NotNil(t, result.StructuredContent)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(tool.RawOutputSchema, &schema))
+	r, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	var structured any
+	require.NoError(t, json.Unmarshal(r, &structured))
+
+	assertMatchesSchema(t, "$", schema, structured)
+}
+
+func assertMatchesSchema(t *testing.T, path string, schema map[string]any, value any) {
+	t.Helper()
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		require.Truef(t, ok, "%s: expected an object, got %T", path, value)
+		properties, _ := schema["properties"].(map[string]any)
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			assert.Containsf(t, object, name, "%s: missing required property", path)
+		}
+		for name, v := range object {
+			property, ok := properties[name].(map[string]any)
+			if !assert.Truef(t, ok, "%s: undeclared property %s", path, name) {
+				continue
+			}
+			assertMatchesSchema(t, path+"."+name, property, v)
+		}
+	case "array":
+		items, ok := value.([]any)
+		require.Truef(t, ok, "%s: expected an array, got %T", path, value)
+		itemSchema, _ := schema["items"].(map[string]any)
+		for i, item := range items {
+			assertMatchesSchema(t, fmt.Sprintf("%s[%d]", path, i), itemSchema, item)
+		}
+	case "string":
+		assert.IsTypef(t, "", value, "%s: expected a string", path)
+	case "integer":
+		number, ok := value.(float64)
+		assert.Truef(t, ok && number == float64(int64(number)), "%s: expected an integer, got %v", path, value)
+	case "boolean":
+		assert.IsTypef(t, false, value, "%s: expected a boolean", path)
+	default:
+		t.Fatalf("%s: unsupported schema type %v", path, schema["type"])
+	}
+}
+
 func TestOptionalParamOK(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,368 @@
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // GitHub still signs deliveries with HMAC-SHA1 in X-Hub-Signature
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// hookDeliverySummary is a webhook delivery as listed by list_hook_deliveries, without its payloads.
+type hookDeliverySummary struct {
+	ID          int64             `json:"id"`
+	GUID        string            `json:"guid"`
+	DeliveredAt *github.Timestamp `json:"delivered_at,omitempty"`
+	Redelivery  bool              `json:"redelivery"`
+	Duration    *float64          `json:"duration"`
+	Status      string            `json:"status"`
+	StatusCode  int               `json:"status_code"`
+	Event       string            `json:"event"`
+	Action      string            `json:"action,omitempty"`
+}
+
+// hookDeliveries is a page of deliveries, with the cursor of the next page if there is one.
+type hookDeliveries struct {
+	Deliveries []hookDeliverySummary `json:"deliveries"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+func summarizeHookDelivery(delivery *github.HookDelivery) hookDeliverySummary {
+	return hookDeliverySummary{
+		ID:          delivery.GetID(),
+		GUID:        delivery.GetGUID(),
+		DeliveredAt: delivery.DeliveredAt,
+		Redelivery:  delivery.GetRedelivery(),
+		Duration:    delivery.Duration,
+		Status:      delivery.GetStatus(),
+		StatusCode:  delivery.GetStatusCode(),
+		Event:       delivery.GetEvent(),
+		Action:      delivery.GetAction(),
+	}
+}
+
+// ListHookDeliveries creates a tool to list the deliveries of a repository webhook.
+func ListHookDeliveries(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_hook_deliveries",
+			mcp.WithDescription(t("TOOL_LIST_HOOK_DELIVERIES_DESCRIPTION", "List the deliveries of a webhook of a GitHub repository, newest first, with the status code and duration of each. Returns the cursor of the next page when there are more deliveries")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_HOOK_DELIVERIES_USER_TITLE", "List webhook deliveries"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("ID of the webhook"),
+			),
+			mcp.WithString("cursor",
+				mcp.Description("Cursor to continue from, the next_cursor returned with the previous deliveries"),
+			),
+			mcp.WithNumber("perPage",
+				mcp.Description("Results per page for pagination (min 1, max 100)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			cursor, err := OptionalParam[string](request, "cursor")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamWithDefault(request, "perPage", 30)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			deliveries, resp, err := client.Repositories.ListHookDeliveries(ctx, owner, repo, int64(hookID), &github.ListCursorOptions{
+				Cursor:  cursor,
+				PerPage: perPage,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("webhook %d not found in %s/%s, managing webhooks requires admin access to the repository", hookID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list hook deliveries: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list hook deliveries: %s", string(body))), nil
+			}
+
+			page := hookDeliveries{
+				Deliveries: make([]hookDeliverySummary, 0, len(deliveries)),
+				NextCursor: resp.Cursor,
+			}
+			for _, delivery := range deliveries {
+				page.Deliveries = append(page.Deliveries, summarizeHookDelivery(delivery))
+			}
+
+			r, err := json.Marshal(page)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetHookDelivery creates a tool to get a delivery of a repository webhook with its request and response.
+func GetHookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_hook_delivery",
+			mcp.WithDescription(t("TOOL_GET_HOOK_DELIVERY_DESCRIPTION", "Get a delivery of a webhook of a GitHub repository, including the headers and payloads of the request sent and the response received")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_HOOK_DELIVERY_USER_TITLE", "Get webhook delivery"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("ID of the webhook"),
+			),
+			mcp.WithNumber("delivery_id",
+				mcp.Required(),
+				mcp.Description("ID of the delivery"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			delivery, resp, err := client.Repositories.GetHookDelivery(ctx, owner, repo, int64(hookID), int64(deliveryID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("delivery %d of webhook %d not found in %s/%s", deliveryID, hookID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get hook delivery: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get hook delivery: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(delivery)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RedeliverHookDelivery creates a tool to send a delivery of a repository webhook again.
+func RedeliverHookDelivery(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("redeliver_hook_delivery",
+			mcp.WithDescription(t("TOOL_REDELIVER_HOOK_DELIVERY_DESCRIPTION", "Send a delivery of a webhook of a GitHub repository again, e.g. after fixing the receiving endpoint. The new attempt shows up in list_hook_deliveries as a redelivery")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REDELIVER_HOOK_DELIVERY_USER_TITLE", "Redeliver webhook delivery"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("hook_id",
+				mcp.Required(),
+				mcp.Description("ID of the webhook"),
+			),
+			mcp.WithNumber("delivery_id",
+				mcp.Required(),
+				mcp.Description("ID of the delivery to send again"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			hookID, err := RequiredInt(request, "hook_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deliveryID, err := RequiredInt(request, "delivery_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("redeliver delivery %d of webhook %d of %s/%s", deliveryID, hookID, owner, repo), nil
+			}
+			_, resp, err := client.Repositories.RedeliverHookDelivery(ctx, owner, repo, int64(hookID), int64(deliveryID))
+			// The API answers 202 Accepted, which go-github reports as an AcceptedError
+			if err != nil && !isAcceptedError(err) {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("delivery %d of webhook %d not found in %s/%s", deliveryID, hookID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to redeliver hook delivery: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Redelivery of delivery %d of webhook %d requested, use list_hook_deliveries to see its result", deliveryID, hookID)), nil
+		}
+}
+
+// webhookSignatureCheck is the result of verify_webhook_signature.
+type webhookSignatureCheck struct {
+	Valid     bool   `json:"valid"`
+	Algorithm string `json:"algorithm"`
+	Note      string `json:"note,omitempty"`
+}
+
+// verifyWebhookSignature reports whether signature, the value of the X-Hub-Signature-256 or the
+// legacy X-Hub-Signature header, is the HMAC of payload with secret, and which algorithm it uses.
+func verifyWebhookSignature(payload, signature, secret string) (bool, string, error) {
+	algorithm, digest, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false, "", fmt.Errorf("signature must start with sha256= or sha1=")
+	}
+	var newHash func() hash.Hash
+	switch algorithm {
+	case "sha256":
+		newHash = sha256.New
+	case "sha1":
+		newHash = sha1.New
+	default:
+		return false, "", fmt.Errorf("signature must start with sha256= or sha1=")
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false, "", fmt.Errorf("signature is not hex encoded: %w", err)
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	_, _ = mac.Write([]byte(payload))
+	return hmac.Equal(mac.Sum(nil), expected), algorithm, nil
+}
+
+// VerifyWebhookSignature creates a tool to check the signature of a webhook delivery against its secret.
+func VerifyWebhookSignature(t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("verify_webhook_signature",
+			mcp.WithDescription(t("TOOL_VERIFY_WEBHOOK_SIGNATURE_DESCRIPTION", "Verify that a webhook payload was signed by GitHub with the webhook secret, from the X-Hub-Signature-256 header or the legacy X-Hub-Signature header. The payload must be the raw request body, byte for byte")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_VERIFY_WEBHOOK_SIGNATURE_USER_TITLE", "Verify webhook signature"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("payload",
+				mcp.Required(),
+				mcp.Description("Raw body of the webhook delivery"),
+			),
+			mcp.WithString("signature",
+				mcp.Required(),
+				mcp.Description("Value of the X-Hub-Signature-256 header, sha256=<hex>, or of the X-Hub-Signature header, sha1=<hex>"),
+			),
+			mcp.WithString("secret",
+				mcp.Required(),
+				mcp.Description("Secret of the webhook"),
+			),
+		),
+		func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			payload, err := requiredParam[string](request, "payload")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			signature, err := requiredParam[string](request, "signature")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			secret, err := requiredParam[string](request, "secret")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			valid, algorithm, err := verifyWebhookSignature(payload, strings.TrimSpace(signature), secret)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			result := webhookSignatureCheck{Valid: valid, Algorithm: algorithm}
+			if algorithm == "sha1" {
+				result.Note = "sha1 signatures are kept for compatibility, verify X-Hub-Signature-256 when it is available"
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,442 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListHookDeliveries(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListHookDeliveries(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_hook_deliveries", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "hook_id")
+	assert.Contains(t, tool.InputSchema.Properties, "cursor")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "hook_id"})
+
+	deliveries := []*github.HookDelivery{
+		{
+			ID:          github.Ptr(int64(12)),
+			GUID:        github.Ptr("0b989ba4-242f-11e5-81e1-c7b6966d2516"),
+			DeliveredAt: &github.Timestamp{},
+			Redelivery:  github.Ptr(false),
+			Duration:    github.Ptr(0.27),
+			Status:      github.Ptr("Invalid HTTP Response: 500"),
+			StatusCode:  github.Ptr(500),
+			Event:       github.Ptr("push"),
+		},
+		{
+			ID:         github.Ptr(int64(11)),
+			GUID:       github.Ptr("0b989ba4-242f-11e5-81e1-c7b6966d2517"),
+			Redelivery: github.Ptr(true),
+			Duration:   github.Ptr(0.03),
+			Status:     github.Ptr("OK"),
+			StatusCode: github.Ptr(200),
+			Event:      github.Ptr("issues"),
+			Action:     github.Ptr("opened"),
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedDeliveries hookDeliveries
+		expectedErrMsg     string
+	}{
+		{
+			name: "list deliveries with next page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookId,
+					expectQueryParams(t, map[string]string{
+						"cursor":   "v1_12",
+						"per_page": "2",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/hooks/42/deliveries?cursor=v1_10&per_page=2>; rel="next"`)
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write(mock.MustMarshal(deliveries))
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(42),
+				"cursor":  "v1_12",
+				"perPage": float64(2),
+			},
+			expectedDeliveries: hookDeliveries{
+				Deliveries: []hookDeliverySummary{
+					{
+						ID:          12,
+						GUID:        "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+						DeliveredAt: &github.Timestamp{},
+						Duration:    github.Ptr(0.27),
+						Status:      "Invalid HTTP Response: 500",
+						StatusCode:  500,
+						Event:       "push",
+					},
+					{
+						ID:         11,
+						GUID:       "0b989ba4-242f-11e5-81e1-c7b6966d2517",
+						Redelivery: true,
+						Duration:   github.Ptr(0.03),
+						Status:     "OK",
+						StatusCode: 200,
+						Event:      "issues",
+						Action:     "opened",
+					},
+				},
+				NextCursor: "v1_10",
+			},
+		},
+		{
+			name: "last page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookId,
+					expectQueryParams(t, map[string]string{
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.HookDelivery{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(42),
+			},
+			expectedDeliveries: hookDeliveries{
+				Deliveries: []hookDeliverySummary{},
+			},
+		},
+		{
+			name: "webhook not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"hook_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "webhook 42 not found in owner/repo, managing webhooks requires admin access to the repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListHookDeliveries(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned hookDeliveries
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedDeliveries, returned)
+		})
+	}
+}
+
+func Test_GetHookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetHookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_hook_delivery", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "hook_id")
+	assert.Contains(t, tool.InputSchema.Properties, "delivery_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "hook_id", "delivery_id"})
+
+	delivery := &github.HookDelivery{
+		ID:         github.Ptr(int64(12)),
+		Status:     github.Ptr("Invalid HTTP Response: 500"),
+		StatusCode: github.Ptr(500),
+		Event:      github.Ptr("push"),
+		Response: &github.HookResponse{
+			Headers: map[string]string{"Content-Type": "text/plain"},
+			RawPayload: func() *json.RawMessage {
+				payload := json.RawMessage(`"internal error"`)
+				return &payload
+			}(),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		expectError      bool
+		expectedDelivery *github.HookDelivery
+		expectedErrMsg   string
+	}{
+		{
+			name: "get delivery",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookIdByDeliveryId,
+					delivery,
+				),
+			),
+			expectedDelivery: delivery,
+		},
+		{
+			name: "delivery not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposHooksDeliveriesByOwnerByRepoByHookIdByDeliveryId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "delivery 12 of webhook 42 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetHookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"hook_id":     float64(42),
+				"delivery_id": float64(12),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned github.HookDelivery
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedDelivery.GetID(), returned.GetID())
+			assert.Equal(t, tc.expectedDelivery.GetStatusCode(), returned.GetStatusCode())
+			assert.Equal(t, tc.expectedDelivery.Response.Headers, returned.Response.Headers)
+			assert.JSONEq(t, string(*tc.expectedDelivery.Response.RawPayload), string(*returned.Response.RawPayload))
+		})
+	}
+}
+
+func Test_RedeliverHookDelivery(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RedeliverHookDelivery(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "redeliver_hook_delivery", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "hook_id")
+	assert.Contains(t, tool.InputSchema.Properties, "delivery_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "hook_id", "delivery_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "redeliver by id",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksDeliveriesAttemptsByOwnerByRepoByHookIdByDeliveryId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/hooks/42/deliveries/12/attempts", r.URL.Path)
+						w.WriteHeader(http.StatusAccepted)
+						_, _ = w.Write([]byte(`{}`))
+					}),
+				),
+			),
+			expectedText: "Redelivery of delivery 12 of webhook 42 requested, use list_hook_deliveries to see its result",
+		},
+		{
+			name: "delivery not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposHooksDeliveriesAttemptsByOwnerByRepoByHookIdByDeliveryId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "delivery 12 of webhook 42 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RedeliverHookDelivery(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"hook_id":     float64(42),
+				"delivery_id": float64(12),
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_VerifyWebhookSignature(t *testing.T) {
+	// Verify tool definition once
+	tool, _ := VerifyWebhookSignature(translations.NullTranslationHelper)
+
+	assert.Equal(t, "verify_webhook_signature", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "payload")
+	assert.Contains(t, tool.InputSchema.Properties, "signature")
+	assert.Contains(t, tool.InputSchema.Properties, "secret")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"payload", "signature", "secret"})
+
+	// Test vector from the GitHub documentation on validating webhook deliveries
+	const secret = "It's a Secret to Everybody"
+	const payload = "Hello, World!"
+
+	tests := []struct {
+		name           string
+		payload        string
+		signature      string
+		secret         string
+		expectError    bool
+		expectedCheck  webhookSignatureCheck
+		expectedErrMsg string
+	}{
+		{
+			name:          "valid sha256 signature",
+			payload:       payload,
+			signature:     "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+			secret:        secret,
+			expectedCheck: webhookSignatureCheck{Valid: true, Algorithm: "sha256"},
+		},
+		{
+			name:      "valid legacy sha1 signature",
+			payload:   payload,
+			signature: "sha1=01dc10d0c83e72ed246219cdd91669667fe2ca59",
+			secret:    secret,
+			expectedCheck: webhookSignatureCheck{
+				Valid:     true,
+				Algorithm: "sha1",
+				Note:      "sha1 signatures are kept for compatibility, verify X-Hub-Signature-256 when it is available",
+			},
+		},
+		{
+			name:          "tampered payload",
+			payload:       "Hello, World?",
+			signature:     "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+			secret:        secret,
+			expectedCheck: webhookSignatureCheck{Valid: false, Algorithm: "sha256"},
+		},
+		{
+			name:          "wrong secret",
+			payload:       payload,
+			signature:     "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+			secret:        "not the secret",
+			expectedCheck: webhookSignatureCheck{Valid: false, Algorithm: "sha256"},
+		},
+		{
+			name:           "missing prefix",
+			payload:        payload,
+			signature:      "757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+			secret:         secret,
+			expectError:    true,
+			expectedErrMsg: "signature must start with sha256= or sha1=",
+		},
+		{
+			name:           "unsupported algorithm",
+			payload:        payload,
+			signature:      "md5=65a8e27d8879283831b664bd8b7f0ad4",
+			secret:         secret,
+			expectError:    true,
+			expectedErrMsg: "signature must start with sha256= or sha1=",
+		},
+		{
+			name:           "digest is not hex",
+			payload:        payload,
+			signature:      "sha256=not-hex",
+			secret:         secret,
+			expectError:    true,
+			expectedErrMsg: "signature is not hex encoded: encoding/hex: invalid byte: U+006E 'n'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := VerifyWebhookSignature(translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"payload":   tc.payload,
+				"signature": tc.signature,
+				"secret":    tc.secret,
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned webhookSignatureCheck
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedCheck, returned)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// invitationSummary is the compact form of a repository invitation.
+type invitationSummary struct {
+	ID          int64      `json:"id"`
+	Repository  string     `json:"repository"`
+	Inviter     string     `json:"inviter"`
+	Invitee     string     `json:"invitee"`
+	Permissions string     `json:"permissions"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Expired     bool       `json:"expired"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+// summarizeInvitations converts repository invitations to their compact form.
+func summarizeInvitations(invitations []*github.RepositoryInvitation) []invitationSummary {
+	summaries := make([]invitationSummary, 0, len(invitations))
+	for _, invitation := range invitations {
+		summary := invitationSummary{
+			ID:          invitation.GetID(),
+			Repository:  invitation.GetRepo().GetFullName(),
+			Inviter:     invitation.GetInviter().GetLogin(),
+			Invitee:     invitation.GetInvitee().GetLogin(),
+			Permissions: invitation.GetPermissions(),
+			Expired:     invitation.GetExpired(),
+			HTMLURL:     invitation.GetHTMLURL(),
+		}
+		if invitation.CreatedAt != nil {
+			summary.CreatedAt = &invitation.CreatedAt.Time
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// marshalInvitations returns the result of the invitation listing tools, or a tool error for an unexpected status.
+func marshalInvitations(invitations []*github.RepositoryInvitation, resp *github.Response) (*mcp.CallToolResult, error) {
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list repository invitations: %s", string(body))), nil
+	}
+
+	r, err := json.Marshal(summarizeInvitations(invitations))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// ListRepositoryInvitations creates a tool to list the pending invitations of a repository.
+func ListRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List the pending collaborator invitations of a GitHub repository. Requires admin access to the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_INVITATIONS_USER_TITLE", "List repository invitations"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repository invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalInvitations(invitations, resp)
+		}
+}
+
+// ListUserRepositoryInvitations creates a tool to list the pending repository invitations of the authenticated user.
+func ListUserRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_USER_REPOSITORY_INVITATIONS_DESCRIPTION", "List the pending repository invitations of the authenticated user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_USER_REPOSITORY_INVITATIONS_USER_TITLE", "List my repository invitations"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Users.ListInvitations(ctx, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repository invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalInvitations(invitations, resp)
+		}
+}
+
+// respondToInvitationHandler returns the handler shared by accept_repository_invitation and
+// decline_repository_invitation.
+func respondToInvitationHandler(getClient GetClientFn, accept bool) server.ToolHandlerFunc {
+	action, done := "decline", "Declined"
+	if accept {
+		action, done = "accept", "Accepted"
+	}
+
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		invitationID, err := RequiredInt(request, "invitation_id")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+
+		if isDryRun(ctx) {
+			return dryRunResult("%s repository invitation %d", action, invitationID), nil
+		}
+		var resp *github.Response
+		if accept {
+			resp, err = client.Users.AcceptInvitation(ctx, int64(invitationID))
+		} else {
+			resp, err = client.Users.DeclineInvitation(ctx, int64(invitationID))
+		}
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return mcp.NewToolResultError(fmt.Sprintf("invitation %d not found, use list_user_repository_invitations to see your pending invitations", invitationID)), nil
+			}
+			return nil, fmt.Errorf("failed to %s invitation: %w", action, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusNoContent {
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("failed to %s invitation: %s", action, string(body))), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("%s invitation %d", done, invitationID)), nil
+	}
+}
+
+// AcceptRepositoryInvitation creates a tool to accept a repository invitation of the authenticated user.
+func AcceptRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("accept_repository_invitation",
+			mcp.WithDescription(t("TOOL_ACCEPT_REPOSITORY_INVITATION_DESCRIPTION", "Accept a repository invitation of the authenticated user, making them a collaborator of the repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ACCEPT_REPOSITORY_INVITATION_USER_TITLE", "Accept repository invitation"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("The ID of the invitation, from list_user_repository_invitations"),
+			),
+		),
+		respondToInvitationHandler(getClient, true)
+}
+
+// DeclineRepositoryInvitation creates a tool to decline a repository invitation of the authenticated user.
+func DeclineRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("decline_repository_invitation",
+			mcp.WithDescription(t("TOOL_DECLINE_REPOSITORY_INVITATION_DESCRIPTION", "Decline a repository invitation of the authenticated user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DECLINE_REPOSITORY_INVITATION_USER_TITLE", "Decline repository invitation"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("The ID of the invitation, from list_user_repository_invitations"),
+			),
+		),
+		respondToInvitationHandler(getClient, false)
+}
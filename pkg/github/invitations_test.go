@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	mockInvitationCreatedAt = time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	mockInvitations         = []*github.RepositoryInvitation{
+		{
+			ID:          github.Ptr(int64(1)),
+			Repo:        &github.Repository{FullName: github.Ptr("owner/repo")},
+			Inviter:     &github.User{Login: github.Ptr("octocat")},
+			Invitee:     &github.User{Login: github.Ptr("deploy-bot")},
+			Permissions: github.Ptr("write"),
+			CreatedAt:   &github.Timestamp{Time: mockInvitationCreatedAt},
+			HTMLURL:     github.Ptr("https://github.com/owner/repo/invitations"),
+		},
+		{
+			ID:          github.Ptr(int64(2)),
+			Repo:        &github.Repository{FullName: github.Ptr("owner/other")},
+			Inviter:     &github.User{Login: github.Ptr("octocat")},
+			Invitee:     &github.User{Login: github.Ptr("deploy-bot")},
+			Permissions: github.Ptr("read"),
+			Expired:     github.Ptr(true),
+		},
+	}
+	expectedInvitations = []invitationSummary{
+		{
+			ID:          1,
+			Repository:  "owner/repo",
+			Inviter:     "octocat",
+			Invitee:     "deploy-bot",
+			Permissions: "write",
+			CreatedAt:   &mockInvitationCreatedAt,
+			HTMLURL:     "https://github.com/owner/repo/invitations",
+		},
+		{
+			ID:          2,
+			Repository:  "owner/other",
+			Inviter:     "octocat",
+			Invitee:     "deploy-bot",
+			Permissions: "read",
+			Expired:     true,
+		},
+	}
+)
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposInvitationsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockInvitations),
+			),
+		),
+	))
+	_, handler := ListRepositoryInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returned []invitationSummary
+	err = json.Unmarshal([]byte(textContent.Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, expectedInvitations, returned)
+}
+
+func Test_ListUserRepositoryInvitations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListUserRepositoryInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_user_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectError         bool
+		expectedInvitations []invitationSummary
+		expectedErrMsg      string
+	}{
+		{
+			name: "lists invitations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserRepositoryInvitations,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockInvitations),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"page":    float64(2),
+				"perPage": float64(5),
+			},
+			expectedInvitations: expectedInvitations,
+		},
+		{
+			name: "no invitations",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserRepositoryInvitations,
+					[]*github.RepositoryInvitation{},
+				),
+			),
+			requestArgs:         map[string]interface{}{},
+			expectedInvitations: []invitationSummary{},
+		},
+		{
+			name: "list fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserRepositoryInvitations,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListUserRepositoryInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returned []invitationSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInvitations, returned)
+		})
+	}
+}
+
+func Test_AcceptRepositoryInvitation(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AcceptRepositoryInvitation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "accept_repository_invitation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "invitation_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"invitation_id"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "accepts the invitation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchUserRepositoryInvitationsByInvitationId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/user/repository_invitations/1", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"invitation_id": float64(1),
+			},
+			expectedText: "Accepted invitation 1",
+		},
+		{
+			name: "invitation not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchUserRepositoryInvitationsByInvitationId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"invitation_id": float64(99),
+			},
+			expectedErrMsg: "invitation 99 not found, use list_user_repository_invitations to see your pending invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AcceptRepositoryInvitation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_DeclineRepositoryInvitation(t *testing.T) {
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteUserRepositoryInvitationsByInvitationId,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/user/repository_invitations/2", r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	))
+	tool, handler := DeclineRepositoryInvitation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "decline_repository_invitation", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"invitation_id"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"invitation_id": float64(2),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Declined invitation 2", getTextResult(t, result).Text)
+}
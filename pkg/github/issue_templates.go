@@ -0,0 +1,343 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplatesDir is where GitHub looks for the issue templates of a repository.
+const issueTemplatesDir = ".github/ISSUE_TEMPLATE"
+
+// templateList is a list of the front matter of an issue template, which GitHub accepts either
+// as a YAML sequence or as a comma separated string.
+type templateList []string
+
+func (l *templateList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		var items []string
+		if err := value.Decode(&items); err != nil {
+			return err
+		}
+		*l = items
+		return nil
+	}
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	*l = nil
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
+
+// issueTemplate is a Markdown issue template, its YAML front matter and the body after it.
+type issueTemplate struct {
+	Name      string       `yaml:"name"`
+	About     string       `yaml:"about"`
+	Title     string       `yaml:"title"`
+	Labels    templateList `yaml:"labels"`
+	Assignees templateList `yaml:"assignees"`
+
+	Body string `yaml:"-"`
+}
+
+// parseIssueTemplate splits an issue template into its front matter and its body. A template
+// without front matter is all body.
+func parseIssueTemplate(content string) (issueTemplate, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	var template issueTemplate
+	if !strings.HasPrefix(content, "---\n") {
+		template.Body = content
+		return template, nil
+	}
+	frontMatter, body, found := strings.Cut(content[len("---\n"):], "\n---\n")
+	if !found {
+		// The closing delimiter may end the file
+		if frontMatter, found = strings.CutSuffix(content[len("---\n"):], "\n---"); !found {
+			return issueTemplate{}, fmt.Errorf("front matter is not closed by a --- line")
+		}
+	}
+	if err := yaml.Unmarshal([]byte(frontMatter), &template); err != nil {
+		return issueTemplate{}, fmt.Errorf("invalid front matter: %w", err)
+	}
+	template.Body = strings.TrimLeft(body, "\n")
+	return template, nil
+}
+
+// templateHeadingRE matches the lines that open a section of an issue template, Markdown
+// headings and lines in bold only, such as "**Describe the bug**".
+var templateHeadingRE = regexp.MustCompile(`^(?:#{1,6}\s+(.+?)\s*#*|\*\*(.+?)\*\*:?)\s*$`)
+
+// templateSectionKey returns the key of the section opened by line, its heading lowercased
+// without a trailing colon, and false when line does not open a section.
+func templateSectionKey(line string) (string, bool) {
+	m := templateHeadingRE.FindStringSubmatch(line)
+	if m == nil {
+		return "", false
+	}
+	heading := m[1] + m[2]
+	return strings.ToLower(strings.TrimSpace(strings.TrimSuffix(heading, ":"))), true
+}
+
+// renderIssueTemplate fills the sections of a template body with fields, keyed by section
+// heading case insensitively. The content of a filled section, its placeholder text, is
+// replaced by the value, and sections without a value are left as they are. A field naming no
+// section is an error, so that no value is silently dropped.
+func renderIssueTemplate(body string, fields map[string]string) (string, error) {
+	values := make(map[string]string, len(fields))
+	for heading, value := range fields {
+		values[strings.ToLower(strings.TrimSpace(strings.TrimSuffix(heading, ":")))] = value
+	}
+
+	var b strings.Builder
+	used := make(map[string]bool, len(values))
+	skipping := false
+	for _, line := range strings.SplitAfter(body, "\n") {
+		if key, ok := templateSectionKey(strings.TrimSuffix(line, "\n")); ok {
+			skipping = false
+			if value, ok := values[key]; ok {
+				used[key] = true
+				skipping = true
+				b.WriteString(line)
+				if !strings.HasSuffix(line, "\n") {
+					b.WriteString("\n")
+				}
+				b.WriteString("\n" + strings.TrimSpace(value) + "\n\n")
+				continue
+			}
+		}
+		if !skipping {
+			b.WriteString(line)
+		}
+	}
+
+	var unknown []string
+	for key := range values {
+		if !used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("the template has no section for %s", strings.Join(unknown, ", "))
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n", nil
+}
+
+// templateTitle returns the title of an issue created from template, title after the default
+// title of the template, which is usually a prefix such as "[BUG] ".
+func templateTitle(template issueTemplate, title string) string {
+	if template.Title == "" || strings.HasPrefix(title, template.Title) {
+		return title
+	}
+	if title == "" {
+		return template.Title
+	}
+	prefix := template.Title
+	if !strings.HasSuffix(prefix, " ") {
+		prefix += " "
+	}
+	return prefix + title
+}
+
+// mergeUnique returns the items of a followed by those of b not in a.
+func mergeUnique(a, b []string) []string {
+	merged := append([]string{}, a...)
+	for _, item := range b {
+		found := false
+		for _, existing := range merged {
+			if strings.EqualFold(existing, item) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+// CreateIssueFromTemplate creates a tool to create an issue from one of the issue templates of a repository.
+func CreateIssueFromTemplate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_issue_from_template",
+			mcp.WithDescription(t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_DESCRIPTION", "Create an issue in a GitHub repository from one of its Markdown issue templates in .github/ISSUE_TEMPLATE. The sections of the template are filled with the given fields, and the title, labels and assignees default to those of the template front matter")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_ISSUE_FROM_TEMPLATE_USER_TITLE", "Open new issue from template"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("template",
+				mcp.Required(),
+				mcp.Description("File name of the template in .github/ISSUE_TEMPLATE, e.g. bug_report.md, the .md extension may be left out"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Issue title, appended to the default title of the template"),
+			),
+			mcp.WithObject("fields",
+				mcp.Description("Map from section heading of the template to its content, e.g. {\"Describe the bug\": \"The app crashes on start\"}. Headings are matched case insensitively, and sections left out keep the text of the template"),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("Usernames to assign to this issue, in addition to the assignees of the template"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Labels to apply to this issue, in addition to the labels of the template"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			templateName, err := requiredParam[string](request, "template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldsObj, err := OptionalParam[map[string]interface{}](request, "fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields := make(map[string]string, len(fieldsObj))
+			for heading, valueObj := range fieldsObj {
+				value, ok := valueObj.(string)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("field %q must be a string", heading)), nil
+				}
+				fields[heading] = value
+			}
+			assignees, err := OptionalStringArrayParam(request, "assignees")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplatesDir, nil)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("no issue templates found in %s/%s", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list issue templates: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var available []string
+			var templatePath string
+			for _, entry := range entries {
+				name := entry.GetName()
+				if entry.GetType() != "file" || path.Ext(name) != ".md" {
+					continue
+				}
+				available = append(available, name)
+				if name == templateName || name == templateName+".md" {
+					templatePath = entry.GetPath()
+				}
+			}
+			if templatePath == "" {
+				if len(available) == 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("no issue templates found in %s/%s", owner, repo)), nil
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("issue template %s not found in %s/%s, available templates: %s", templateName, owner, repo, strings.Join(available, ", "))), nil
+			}
+
+			file, _, err := getRefFile(ctx, client, owner, repo, templatePath, "")
+			if err != nil {
+				return nil, err
+			}
+			if !file.exists {
+				return mcp.NewToolResultError(fmt.Sprintf("issue template %s not found in %s/%s", templateName, owner, repo)), nil
+			}
+			template, err := parseIssueTemplate(file.content)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to parse issue template %s: %s", templatePath, err)), nil
+			}
+			body, err := renderIssueTemplate(template.Body, fields)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to render issue template %s: %s", templatePath, err)), nil
+			}
+			title = templateTitle(template, title)
+			if title == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("issue template %s has no default title, a title is required", templatePath)), nil
+			}
+
+			issueRequest := &github.IssueRequest{
+				Title:     github.Ptr(title),
+				Body:      github.Ptr(body),
+				Assignees: github.Ptr(mergeUnique(template.Assignees, assignees)),
+				Labels:    github.Ptr(mergeUnique(template.Labels, labels)),
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("create issue %q from template %s in %s/%s", title, templatePath, owner, repo), nil
+			}
+			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create issue: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,287 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBugReportTemplate is the bug report template GitHub suggests for new repositories.
+const testBugReportTemplate = `---
+name: Bug report
+about: Create a report to help us improve
+title: "[BUG] "
+labels: bug, needs triage
+assignees:
+  - octocat
+---
+
+**Describe the bug**
+A clear and concise description of what the bug is.
+
+**To Reproduce**
+Steps to reproduce the behavior:
+1. Go to '...'
+2. See error
+
+## Additional context
+Add any other context about the problem here.
+`
+
+func Test_ParseIssueTemplate(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		expectError    bool
+		expectedResult issueTemplate
+	}{
+		{
+			name:    "front matter with a label string and an assignee list",
+			content: testBugReportTemplate,
+			expectedResult: issueTemplate{
+				Name:      "Bug report",
+				About:     "Create a report to help us improve",
+				Title:     "[BUG] ",
+				Labels:    templateList{"bug", "needs triage"},
+				Assignees: templateList{"octocat"},
+				Body:      testBugReportTemplate[len("---\nname: Bug report\nabout: Create a report to help us improve\ntitle: \"[BUG] \"\nlabels: bug, needs triage\nassignees:\n  - octocat\n---\n\n"):],
+			},
+		},
+		{
+			name:    "front matter closing the file with CRLF line endings",
+			content: "---\r\nname: Question\r\nlabels: ['question']\r\nassignees: ''\r\n---",
+			expectedResult: issueTemplate{
+				Name:   "Question",
+				Labels: templateList{"question"},
+			},
+		},
+		{
+			name:           "no front matter",
+			content:        "## Summary\n",
+			expectedResult: issueTemplate{Body: "## Summary\n"},
+		},
+		{
+			name:        "front matter not closed",
+			content:     "---\nname: Bug report\n\n**Describe the bug**\n",
+			expectError: true,
+		},
+		{
+			name:        "front matter not YAML",
+			content:     "---\nname: [Bug report\n---\n",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			template, err := parseIssueTemplate(tc.content)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, template)
+		})
+	}
+}
+
+func Test_RenderIssueTemplate(t *testing.T) {
+	template, err := parseIssueTemplate(testBugReportTemplate)
+	require.NoError(t, err)
+
+	body, err := renderIssueTemplate(template.Body, map[string]string{
+		"describe the bug:":  "The server crashes on start.",
+		"Additional Context": "Seen on Linux only.\n",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `**Describe the bug**
+
+The server crashes on start.
+
+**To Reproduce**
+Steps to reproduce the behavior:
+1. Go to '...'
+2. See error
+
+## Additional context
+
+Seen on Linux only.
+`, body)
+
+	// Without fields the template is left as it is
+	body, err = renderIssueTemplate(template.Body, nil)
+	require.NoError(t, err)
+	assert.Equal(t, template.Body, body)
+
+	_, err = renderIssueTemplate(template.Body, map[string]string{"Expected behavior": "No crash", "Screenshots": ""})
+	assert.EqualError(t, err, "the template has no section for expected behavior, screenshots")
+}
+
+func Test_TemplateTitle(t *testing.T) {
+	withTitle := issueTemplate{Title: "[BUG]"}
+	assert.Equal(t, "[BUG] Crash on start", templateTitle(withTitle, "Crash on start"))
+	assert.Equal(t, "[BUG] Crash on start", templateTitle(withTitle, "[BUG] Crash on start"))
+	assert.Equal(t, "[BUG]", templateTitle(withTitle, ""))
+	assert.Equal(t, "Crash on start", templateTitle(issueTemplate{}, "Crash on start"))
+}
+
+func Test_CreateIssueFromTemplate(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateIssueFromTemplate(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_issue_from_template", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "template")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "fields")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "template"})
+
+	// templateContents serves the templates directory and the bug report template in it
+	templateContents := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/contents/.github/ISSUE_TEMPLATE":
+			mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+				{Type: github.Ptr("file"), Name: github.Ptr("bug_report.md"), Path: github.Ptr(".github/ISSUE_TEMPLATE/bug_report.md")},
+				{Type: github.Ptr("file"), Name: github.Ptr("config.yml"), Path: github.Ptr(".github/ISSUE_TEMPLATE/config.yml")},
+				{Type: github.Ptr("file"), Name: github.Ptr("feature_request.md"), Path: github.Ptr(".github/ISSUE_TEMPLATE/feature_request.md")},
+			})(w, r)
+		case "/repos/owner/repo/contents/.github/ISSUE_TEMPLATE/bug_report.md":
+			mockResponse(t, http.StatusOK, &github.RepositoryContent{
+				Type:     github.Ptr("file"),
+				Path:     github.Ptr(".github/ISSUE_TEMPLATE/bug_report.md"),
+				Encoding: github.Ptr("base64"),
+				Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(testBugReportTemplate))),
+			})(w, r)
+		default:
+			mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+		}
+	})
+
+	mockIssue := &github.Issue{
+		Number:  github.Ptr(12),
+		Title:   github.Ptr("[BUG] Crash on start"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/12"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssue  *github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "create issue from template",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, templateContents),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":     "[BUG] Crash on start",
+						"body":      "**Describe the bug**\n\nThe server crashes on start.\n\n**To Reproduce**\nSteps to reproduce the behavior:\n1. Go to '...'\n2. See error\n\n## Additional context\nAdd any other context about the problem here.\n",
+						"labels":    []any{"bug", "needs triage", "server"},
+						"assignees": []any{"octocat"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report",
+				"title":    "Crash on start",
+				"fields": map[string]interface{}{
+					"Describe the bug": "The server crashes on start.",
+				},
+				"labels":    []interface{}{"Bug", "server"},
+				"assignees": []interface{}{"octocat"},
+			},
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "field naming no section",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, templateContents),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report.md",
+				"fields": map[string]interface{}{
+					"Steps": "Start the server",
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to render issue template .github/ISSUE_TEMPLATE/bug_report.md: the template has no section for steps",
+		},
+		{
+			name: "template not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposContentsByOwnerByRepoByPath, templateContents),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "question",
+			},
+			expectError:    true,
+			expectedErrMsg: "issue template question not found in owner/repo, available templates: bug_report.md, feature_request.md",
+		},
+		{
+			name: "repository without templates",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"template": "bug_report",
+			},
+			expectError:    true,
+			expectedErrMsg: "no issue templates found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateIssueFromTemplate(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned github.Issue
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedIssue.GetNumber(), returned.GetNumber())
+			assert.Equal(t, tc.expectedIssue.GetTitle(), returned.GetTitle())
+			assert.Equal(t, tc.expectedIssue.GetHTMLURL(), returned.GetHTMLURL())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -14,13 +15,130 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// linkedPullRequest is a pull request that will close an issue when merged.
+type linkedPullRequest struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	URL        string `json:"url"`
+	Repository string `json:"repository"`
+}
+
+// issueDetails is an issue enriched with whether it is actually a pull request and which pull requests close it.
+type issueDetails struct {
+	*github.Issue
+	IsPullRequest      bool                `json:"is_pull_request"`
+	PullRequestURL     string              `json:"pull_request_url,omitempty"`
+	Note               string              `json:"note,omitempty"`
+	LinkedPullRequests []linkedPullRequest `json:"linked_pull_requests,omitempty"`
+	// Reactions shadows the reactions of the embedded issue, so they are only returned when asked for.
+	Reactions *reactionCounts `json:"reactions,omitempty"`
+}
+
+// reactionCounts are the reaction counts of an issue or pull request.
+type reactionCounts struct {
+	TotalCount int `json:"total_count"`
+	PlusOne    int `json:"+1"`
+	MinusOne   int `json:"-1"`
+	Laugh      int `json:"laugh"`
+	Confused   int `json:"confused"`
+	Heart      int `json:"heart"`
+	Hooray     int `json:"hooray"`
+	Rocket     int `json:"rocket"`
+	Eyes       int `json:"eyes"`
+}
+
+// summarizeReactions returns the counts of reactions, which are zero when there are none.
+func summarizeReactions(reactions *github.Reactions) *reactionCounts {
+	return &reactionCounts{
+		TotalCount: reactions.GetTotalCount(),
+		PlusOne:    reactions.GetPlusOne(),
+		MinusOne:   reactions.GetMinusOne(),
+		Laugh:      reactions.GetLaugh(),
+		Confused:   reactions.GetConfused(),
+		Heart:      reactions.GetHeart(),
+		Hooray:     reactions.GetHooray(),
+		Rocket:     reactions.GetRocket(),
+		Eyes:       reactions.GetEyes(),
+	}
+}
+
+const closingPullRequestsQuery = `query($owner: String!, $repo: String!, $number: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) {
+      closedByPullRequestsReferences(first: 25, after: $after, includeClosedPrs: true) {
+        nodes {
+          number
+          title
+          state
+          url
+          repository { nameWithOwner }
+        }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+// maxClosingPullRequestPages bounds the pages of linked pull requests fetched for an issue.
+const maxClosingPullRequestPages = 4
+
+// getClosingPullRequests returns the pull requests linked to an issue via the GraphQL
+// closedByPullRequestsReferences connection, which has no REST equivalent.
+func getClosingPullRequests(ctx context.Context, client *github.Client, owner, repo string, number int) ([]linkedPullRequest, error) {
+	linked := []linkedPullRequest{}
+	_, err := doGraphQLPages(ctx, client, closingPullRequestsQuery, map[string]any{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}, maxClosingPullRequestPages, func(page json.RawMessage) (graphQLPageInfo, error) {
+		var data struct {
+			Repository struct {
+				Issue struct {
+					ClosedByPullRequestsReferences struct {
+						Nodes []struct {
+							Number     int    `json:"number"`
+							Title      string `json:"title"`
+							State      string `json:"state"`
+							URL        string `json:"url"`
+							Repository struct {
+								NameWithOwner string `json:"nameWithOwner"`
+							} `json:"repository"`
+						} `json:"nodes"`
+						PageInfo graphQLPageInfo `json:"pageInfo"`
+					} `json:"closedByPullRequestsReferences"`
+				} `json:"issue"`
+			} `json:"repository"`
+		}
+		if err := json.Unmarshal(page, &data); err != nil {
+			return graphQLPageInfo{}, fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+		}
+
+		references := data.Repository.Issue.ClosedByPullRequestsReferences
+		for _, node := range references.Nodes {
+			linked = append(linked, linkedPullRequest{
+				Number:     node.Number,
+				Title:      node.Title,
+				State:      node.State,
+				URL:        node.URL,
+				Repository: node.Repository.NameWithOwner,
+			})
+		}
+		return references.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return linked, nil
+}
+
 // GetIssue creates a tool to get details of a specific issue in a GitHub repository.
 func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_issue",
-			mcp.WithDescription(t("TOOL_GET_ISSUE_DESCRIPTION", "Get details of a specific issue in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_GET_ISSUE_DESCRIPTION", "Get details of a specific issue in a GitHub repository, including whether it is a pull request and the pull requests linked to close it.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_ISSUE_USER_TITLE", "Get issue details"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -34,6 +152,9 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				mcp.Required(),
 				mcp.Description("The number of the issue"),
 			),
+			mcp.WithBoolean("include_reactions",
+				mcp.Description("Include the reaction counts of the issue, such as +1 and heart"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -48,6 +169,10 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeReactions, err := OptionalParam[bool](request, "include_reactions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -67,7 +192,24 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issue)
+			details := issueDetails{Issue: issue}
+			if includeReactions {
+				details.Reactions = summarizeReactions(issue.Reactions)
+			}
+			if issue.IsPullRequest() {
+				details.IsPullRequest = true
+				details.PullRequestURL = issue.GetPullRequestLinks().GetHTMLURL()
+				details.Note = fmt.Sprintf("#%d is a pull request, use the pull request tools to get its details", issueNumber)
+			} else {
+				// Linked pull requests are only available through GraphQL and are an enrichment,
+				// so failing to fetch them should not fail the whole call.
+				linked, err := getClosingPullRequests(ctx, client, owner, repo, issueNumber)
+				if err == nil {
+					details.LinkedPullRequests = linked
+				}
+			}
+
+			r, err := json.Marshal(details)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issue: %w", err)
 			}
@@ -79,10 +221,10 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 // AddIssueComment creates a tool to add a comment to an issue.
 func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("add_issue_comment",
-			mcp.WithDescription(t("TOOL_ADD_ISSUE_COMMENT_DESCRIPTION", "Add a comment to a specific issue in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_ADD_ISSUE_COMMENT_DESCRIPTION", "Add a top-level comment to an issue or a pull request in a GitHub repository. Use add_pull_request_review_comment to comment on a line of a pull request's diff.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_ADD_ISSUE_COMMENT_USER_TITLE", "Add comment to issue"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -94,7 +236,7 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 			),
 			mcp.WithNumber("issue_number",
 				mcp.Required(),
-				mcp.Description("Issue number to comment on"),
+				mcp.Description("Number of the issue or pull request to comment on"),
 			),
 			mcp.WithString("body",
 				mcp.Required(),
@@ -118,6 +260,9 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if strings.TrimSpace(body) == "" {
+				return mcp.NewToolResultError("body cannot be empty"), nil
+			}
 
 			comment := &github.IssueComment{
 				Body: github.Ptr(body),
@@ -127,8 +272,15 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("comment on issue #%d in %s/%s", issueNumber, owner, repo), nil
+			}
+			// Pull requests are issues, so the issue comments API posts their top-level comments too
 			createdComment, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, comment)
 			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("issue or pull request #%d not found in %s/%s", issueNumber, owner, repo)), nil
+				}
 				return nil, fmt.Errorf("failed to create comment: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -156,7 +308,7 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues in GitHub repositories.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_ISSUES_USER_TITLE", "Search issues"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("q",
 				mcp.Required(),
@@ -183,6 +335,7 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			WithSearchTrimming(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			query, err := requiredParam[string](request, "q")
@@ -201,6 +354,249 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			trimming, err := optionalSearchTrimmingParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.SearchOptions{
+				Sort:  sort,
+				Order: order,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := client.Search.Issues(ctx, query, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to search issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
+			}
+
+			if trimming.top > 0 && len(result.Issues) > trimming.top {
+				result.Issues = result.Issues[:trimming.top]
+			}
+			if trimming.top > 0 && !trimming.full {
+				summary := searchSummary{
+					TotalCount:        result.GetTotal(),
+					IncompleteResults: result.GetIncompleteResults(),
+					Results:           make([]string, 0, len(result.Issues)),
+				}
+				for _, issue := range result.Issues {
+					summary.Results = append(summary.Results, summarizeIssue(issue))
+				}
+				r, err := json.Marshal(summary)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// issueSearchFilters are the structured parameters of find_issues, turned into search qualifiers.
+type issueSearchFilters struct {
+	repo          string
+	state         string
+	labels        []string
+	assignee      string
+	author        string
+	involves      string
+	createdAfter  string
+	createdBefore string
+}
+
+// searchQualifier returns the qualifier name:value, quoting value when it holds whitespace.
+func searchQualifier(name, value string) (string, error) {
+	if strings.Contains(value, `"`) {
+		return "", fmt.Errorf("%s cannot contain double quotes: %s", name, value)
+	}
+	if strings.ContainsAny(value, " \t\n") {
+		value = `"` + value + `"`
+	}
+	return name + ":" + value, nil
+}
+
+// buildIssueSearchQuery builds the search query matching the filters, e.g.
+// `repo:owner/repo state:open label:bug label:"help wanted" created:>=2024-01-01`.
+func buildIssueSearchQuery(filters issueSearchFilters) (string, error) {
+	for _, date := range []string{filters.createdAfter, filters.createdBefore} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return "", fmt.Errorf("invalid date: %s, must be in the YYYY-MM-DD format", date)
+		}
+	}
+
+	var created string
+	switch {
+	case filters.createdAfter != "" && filters.createdBefore != "":
+		created = filters.createdAfter + ".." + filters.createdBefore
+	case filters.createdAfter != "":
+		created = ">=" + filters.createdAfter
+	case filters.createdBefore != "":
+		created = "<=" + filters.createdBefore
+	}
+
+	qualifiers := [][2]string{
+		{"repo", filters.repo},
+		{"state", filters.state},
+	}
+	for _, label := range filters.labels {
+		qualifiers = append(qualifiers, [2]string{"label", label})
+	}
+	qualifiers = append(qualifiers,
+		[2]string{"assignee", filters.assignee},
+		[2]string{"author", filters.author},
+		[2]string{"involves", filters.involves},
+		[2]string{"created", created},
+	)
+
+	terms := make([]string, 0, len(qualifiers))
+	for _, q := range qualifiers {
+		if q[1] == "" {
+			continue
+		}
+		term, err := searchQualifier(q[0], q[1])
+		if err != nil {
+			return "", err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 0 {
+		return "", fmt.Errorf("at least one of repo, state, labels, assignee, author, involves, created_after or created_before is required")
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// FindIssues creates a tool to search for issues with structured filters instead of search syntax.
+func FindIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_issues",
+			mcp.WithDescription(t("TOOL_FIND_ISSUES_DESCRIPTION", "Find issues and pull requests matching all the given filters, without having to write a GitHub search query. Use search_issues for queries these filters cannot express.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_ISSUES_USER_TITLE", "Find issues"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("repo",
+				mcp.Description("Repository to search in, as owner/repo"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state"),
+				mcp.Enum("open", "closed"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Filter by labels, issues must have all of them"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee username"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Filter by author username"),
+			),
+			mcp.WithString("involves",
+				mcp.Description("Filter by a username that authored, is assigned to, is mentioned in or commented on the issue"),
+			),
+			mcp.WithString("created_after",
+				mcp.Description("Only issues created on or after this date, in the YYYY-MM-DD format"),
+			),
+			mcp.WithString("created_before",
+				mcp.Description("Only issues created on or before this date, in the YYYY-MM-DD format"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, defaults to best match"),
+				mcp.Enum("comments", "reactions", "interactions", "created", "updated"),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assignee, err := OptionalParam[string](request, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			author, err := OptionalParam[string](request, "author")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			involves, err := OptionalParam[string](request, "involves")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			createdAfter, err := OptionalParam[string](request, "created_after")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			createdBefore, err := OptionalParam[string](request, "created_before")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			query, err := buildIssueSearchQuery(issueSearchFilters{
+				repo:          repo,
+				state:         state,
+				labels:        labels,
+				assignee:      assignee,
+				author:        author,
+				involves:      involves,
+				createdAfter:  createdAfter,
+				createdBefore: createdBefore,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			opts := &github.SearchOptions{
 				Sort:  sort,
@@ -217,6 +613,9 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			}
 			result, resp, err := client.Search.Issues(ctx, query, opts)
 			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid search %s, check that the repository and users exist", query)), nil
+				}
 				return nil, fmt.Errorf("failed to search issues: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -244,7 +643,7 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithDescription(t("TOOL_CREATE_ISSUE_DESCRIPTION", "Create a new issue in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_ISSUE_USER_TITLE", "Open new issue"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -337,6 +736,9 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("create issue %q in %s/%s", title, owner, repo), nil
+			}
 			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create issue: %w", err)
@@ -366,7 +768,7 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_ISSUES_USER_TITLE", "List issues"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -447,11 +849,11 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				opts.Since = timestamp
 			}
 
-			if page, ok := request.Params.Arguments["page"].(float64); ok {
+			if page, ok := request.GetArguments()["page"].(float64); ok {
 				opts.Page = int(page)
 			}
 
-			if perPage, ok := request.Params.Arguments["perPage"].(float64); ok {
+			if perPage, ok := request.GetArguments()["perPage"].(float64); ok {
 				opts.PerPage = int(perPage)
 			}
 
@@ -488,7 +890,7 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithDescription(t("TOOL_UPDATE_ISSUE_DESCRIPTION", "Update an existing issue in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_UPDATE_ISSUE_USER_TITLE", "Edit issue"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -506,14 +908,18 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Description("New title"),
 			),
 			mcp.WithString("body",
-				mcp.Description("New description"),
+				mcp.Description("New description, an empty string clears it"),
 			),
 			mcp.WithString("state",
 				mcp.Description("New state"),
 				mcp.Enum("open", "closed"),
 			),
+			mcp.WithString("state_reason",
+				mcp.Description("Reason for the state change: completed or not_planned when closing, reopened when reopening"),
+				mcp.Enum("completed", "not_planned", "reopened"),
+			),
 			mcp.WithArray("labels",
-				mcp.Description("New labels"),
+				mcp.Description("New labels, replacing the current ones, an empty array removes them all"),
 				mcp.Items(
 					map[string]interface{}{
 						"type": "string",
@@ -521,7 +927,7 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				),
 			),
 			mcp.WithArray("assignees",
-				mcp.Description("New assignees"),
+				mcp.Description("New assignees, replacing the current ones, an empty array removes them all"),
 				mcp.Items(
 					map[string]interface{}{
 						"type": "string",
@@ -546,23 +952,18 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Create the issue request with only provided fields
+			// Only the fields given are sent, so the others are left as they are
 			issueRequest := &github.IssueRequest{}
 
-			// Set optional parameters if provided
-			title, err := OptionalParam[string](request, "title")
-			if err != nil {
+			if title, ok, err := OptionalParamOK[string](request, "title"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			}
-			if title != "" {
+			} else if ok {
 				issueRequest.Title = github.Ptr(title)
 			}
 
-			body, err := OptionalParam[string](request, "body")
-			if err != nil {
+			if body, ok, err := OptionalParamOK[string](request, "body"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			}
-			if body != "" {
+			} else if ok {
 				issueRequest.Body = github.Ptr(body)
 			}
 
@@ -570,41 +971,68 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if state != "" {
+			switch state {
+			case "":
+			case "open", "closed":
 				issueRequest.State = github.Ptr(state)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("state must be open or closed, got %s", state)), nil
 			}
 
-			// Get labels
-			labels, err := OptionalStringArrayParam(request, "labels")
+			stateReason, err := OptionalParam[string](request, "state_reason")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if len(labels) > 0 {
-				issueRequest.Labels = &labels
+			switch stateReason {
+			case "":
+			case "completed", "not_planned":
+				if state != "closed" {
+					return mcp.NewToolResultError(fmt.Sprintf("state_reason %s requires state closed", stateReason)), nil
+				}
+				issueRequest.StateReason = github.Ptr(stateReason)
+			case "reopened":
+				if state != "open" {
+					return mcp.NewToolResultError("state_reason reopened requires state open"), nil
+				}
+				issueRequest.StateReason = github.Ptr(stateReason)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("state_reason must be completed, not_planned or reopened, got %s", stateReason)), nil
 			}
 
-			// Get assignees
-			assignees, err := OptionalStringArrayParam(request, "assignees")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+			// An empty array is sent as is, to remove all labels or assignees
+			if _, ok := request.GetArguments()["labels"]; ok {
+				labels, err := OptionalStringArrayParam(request, "labels")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				issueRequest.Labels = &labels
 			}
-			if len(assignees) > 0 {
+
+			if _, ok := request.GetArguments()["assignees"]; ok {
+				assignees, err := OptionalStringArrayParam(request, "assignees")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
 				issueRequest.Assignees = &assignees
 			}
 
-			milestone, err := OptionalIntParam(request, "milestone")
-			if err != nil {
+			if milestone, ok, err := OptionalParamOK[float64](request, "milestone"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				issueRequest.Milestone = github.Ptr(int(milestone))
 			}
-			if milestone != 0 {
-				milestoneNum := milestone
-				issueRequest.Milestone = &milestoneNum
+
+			if *issueRequest == (github.IssueRequest{}) {
+				return mcp.NewToolResultError("no fields to update, give at least one of title, body, state, labels, assignees or milestone"), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("update issue #%d in %s/%s", issueNumber, owner, repo), nil
+			}
 			updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
 			if err != nil {
 				return nil, fmt.Errorf("failed to update issue: %w", err)
@@ -634,7 +1062,7 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 			mcp.WithDescription(t("TOOL_GET_ISSUE_COMMENTS_DESCRIPTION", "Get comments for a specific issue in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_ISSUE_COMMENTS_USER_TITLE", "Get issue comments"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -36,13 +36,48 @@ func Test_GetIssue(t *testing.T) {
 		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42"),
 	}
 
+	// Setup mock issue that is actually a pull request
+	mockPRIssue := &github.Issue{
+		Number:  github.Ptr(43),
+		Title:   github.Ptr("Test PR"),
+		Body:    github.Ptr("This is a test PR"),
+		State:   github.Ptr("open"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/43"),
+		PullRequestLinks: &github.PullRequestLinks{
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/43"),
+		},
+	}
+
+	mockLinkedPRs := map[string]any{
+		"data": map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{
+					"closedByPullRequestsReferences": map[string]any{
+						"nodes": []any{
+							map[string]any{
+								"number":     44,
+								"title":      "Fix the test issue",
+								"state":      "OPEN",
+								"url":        "https://github.com/owner/repo/pull/44",
+								"repository": map[string]any{"nameWithOwner": "owner/repo"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedIssue  *github.Issue
-		expectedErrMsg string
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedIssue     *github.Issue
+		expectedIsPR      bool
+		expectedPRURL     string
+		expectedLinkedPRs []linkedPullRequest
+		expectedErrMsg    string
 	}{
 		{
 			name: "successful issue retrieval",
@@ -60,6 +95,61 @@ func Test_GetIssue(t *testing.T) {
 			expectError:   false,
 			expectedIssue: mockIssue,
 		},
+		{
+			name: "issue with linked pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var body graphQLRequest
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						assert.Contains(t, body.Query, "closedByPullRequestsReferences")
+						assert.Equal(t, map[string]any{"owner": "owner", "repo": "repo", "number": float64(42)}, body.Variables)
+						w.WriteHeader(http.StatusOK)
+						b, _ := json.Marshal(mockLinkedPRs)
+						_, _ = w.Write(b)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+			expectedLinkedPRs: []linkedPullRequest{
+				{
+					Number:     44,
+					Title:      "Fix the test issue",
+					State:      "OPEN",
+					URL:        "https://github.com/owner/repo/pull/44",
+					Repository: "owner/repo",
+				},
+			},
+		},
+		{
+			name: "issue is a pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockPRIssue,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(43),
+			},
+			expectError:   false,
+			expectedIssue: mockPRIssue,
+			expectedIsPR:  true,
+			expectedPRURL: "https://github.com/owner/repo/pull/43",
+		},
 		{
 			name: "issue not found",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -107,6 +197,99 @@ func Test_GetIssue(t *testing.T) {
 			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
 			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
 			assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
+
+			var returnedDetails struct {
+				IsPullRequest      bool                `json:"is_pull_request"`
+				PullRequestURL     string              `json:"pull_request_url"`
+				Note               string              `json:"note"`
+				LinkedPullRequests []linkedPullRequest `json:"linked_pull_requests"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDetails)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIsPR, returnedDetails.IsPullRequest)
+			assert.Equal(t, tc.expectedPRURL, returnedDetails.PullRequestURL)
+			if tc.expectedIsPR {
+				assert.NotEmpty(t, returnedDetails.Note)
+			}
+			assert.Equal(t, tc.expectedLinkedPRs, returnedDetails.LinkedPullRequests)
+		})
+	}
+}
+
+func Test_GetIssue_Reactions(t *testing.T) {
+	mockIssue := &github.Issue{
+		Number: github.Ptr(42),
+		Title:  github.Ptr("Test Issue"),
+		Reactions: &github.Reactions{
+			TotalCount: github.Ptr(7),
+			PlusOne:    github.Ptr(4),
+			Heart:      github.Ptr(2),
+			Eyes:       github.Ptr(1),
+		},
+		PullRequestLinks: &github.PullRequestLinks{
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+		},
+	}
+
+	tests := []struct {
+		name              string
+		requestArgs       map[string]interface{}
+		expectedReactions map[string]any
+	}{
+		{
+			name: "reactions omitted by default",
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+		},
+		{
+			name: "reactions included",
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"issue_number":      float64(42),
+				"include_reactions": true,
+			},
+			expectedReactions: map[string]any{
+				"total_count": float64(7),
+				"+1":          float64(4),
+				"-1":          float64(0),
+				"laugh":       float64(0),
+				"confused":    float64(0),
+				"heart":       float64(2),
+				"hooray":      float64(0),
+				"rocket":      float64(0),
+				"eyes":        float64(1),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+			))
+			_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returned map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			if tc.expectedReactions == nil {
+				assert.NotContains(t, returned, "reactions")
+				return
+			}
+			assert.Equal(t, tc.expectedReactions, returned["reactions"])
 		})
 	}
 }
@@ -159,6 +342,54 @@ func Test_AddIssueComment(t *testing.T) {
 			expectError:     false,
 			expectedComment: mockComment,
 		},
+		{
+			name: "comment on a pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						// Pull requests share the issue comments endpoint
+						assert.Equal(t, "/repos/owner/repo/issues/7/comments", r.URL.Path)
+						w.WriteHeader(http.StatusCreated)
+						_, _ = w.Write(mock.MustMarshal(mockComment))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(7),
+				"body":         "This is a test comment",
+			},
+			expectedComment: mockComment,
+		},
+		{
+			name:         "blank body",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"body":         " \n",
+			},
+			expectedErrMsg: "body cannot be empty",
+		},
+		{
+			name: "issue or pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"body":         "Ping",
+			},
+			expectedErrMsg: "issue or pull request #999 not found in owner/repo",
+		},
 		{
 			name: "comment creation fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -189,13 +420,7 @@ func Test_AddIssueComment(t *testing.T) {
 
 			// Create call request
 			request := mcp.CallToolRequest{
-				Params: struct {
-					Name      string                 `json:"name"`
-					Arguments map[string]interface{} `json:"arguments,omitempty"`
-					Meta      *struct {
-						ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-					} `json:"_meta,omitempty"`
-				}{
+				Params: mcp.CallToolParams{
 					Arguments: tc.requestArgs,
 				},
 			}
@@ -246,6 +471,8 @@ func Test_SearchIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "top")
+	assert.Contains(t, tool.InputSchema.Properties, "full")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
 
 	// Setup mock search results
@@ -390,6 +617,301 @@ func Test_SearchIssues(t *testing.T) {
 	}
 }
 
+func Test_SearchIssues_Trimming(t *testing.T) {
+	updatedAt := &github.Timestamp{Time: time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)}
+	searchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(57),
+		IncompleteResults: github.Ptr(true),
+		Issues: []*github.Issue{
+			{
+				Number:        github.Ptr(42),
+				Title:         github.Ptr("Crash on startup"),
+				Body:          github.Ptr("A long bug report that is trimmed from the summary"),
+				State:         github.Ptr("open"),
+				Comments:      github.Ptr(5),
+				UpdatedAt:     updatedAt,
+				RepositoryURL: github.Ptr("https://api.github.com/repos/owner/repo"),
+			},
+			{
+				Number:           github.Ptr(43),
+				Title:            github.Ptr("Fix crash on startup"),
+				State:            github.Ptr("closed"),
+				UpdatedAt:        updatedAt,
+				RepositoryURL:    github.Ptr("https://api.github.com/repos/owner/repo"),
+				PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/43")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		expectedSummary *searchSummary
+		expectedNumbers []int
+	}{
+		{
+			name: "top result as summary",
+			requestArgs: map[string]interface{}{
+				"q":   "crash",
+				"top": float64(1),
+			},
+			expectedSummary: &searchSummary{
+				TotalCount:        57,
+				IncompleteResults: true,
+				Results: []string{
+					"owner/repo#42 [open issue] Crash on startup (5 comments, updated 2025-03-04)",
+				},
+			},
+		},
+		{
+			name: "issues and pull requests as summaries",
+			requestArgs: map[string]interface{}{
+				"q":   "crash",
+				"top": float64(2),
+			},
+			expectedSummary: &searchSummary{
+				TotalCount:        57,
+				IncompleteResults: true,
+				Results: []string{
+					"owner/repo#42 [open issue] Crash on startup (5 comments, updated 2025-03-04)",
+					"owner/repo#43 [closed pull request] Fix crash on startup (0 comments, updated 2025-03-04)",
+				},
+			},
+		},
+		{
+			name: "top results as complete objects",
+			requestArgs: map[string]interface{}{
+				"q":    "crash",
+				"top":  float64(1),
+				"full": true,
+			},
+			expectedNumbers: []int{42},
+		},
+		{
+			name: "no top returns every result",
+			requestArgs: map[string]interface{}{
+				"q":    "crash",
+				"full": true,
+			},
+			expectedNumbers: []int{42, 43},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchIssues, searchResult),
+			))
+			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedSummary != nil {
+				var returned searchSummary
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, *tc.expectedSummary, returned)
+				return
+			}
+
+			var returned github.IssuesSearchResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			numbers := make([]int, 0, len(returned.Issues))
+			for _, issue := range returned.Issues {
+				numbers = append(numbers, issue.GetNumber())
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.Equal(t, "A long bug report that is trimmed from the summary", returned.Issues[0].GetBody())
+		})
+	}
+}
+
+func Test_BuildIssueSearchQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		filters        issueSearchFilters
+		expectedQuery  string
+		expectedErrMsg string
+	}{
+		{
+			name:          "repository and state",
+			filters:       issueSearchFilters{repo: "owner/repo", state: "open"},
+			expectedQuery: "repo:owner/repo state:open",
+		},
+		{
+			name: "labels with spaces are quoted",
+			filters: issueSearchFilters{
+				repo:   "owner/repo",
+				labels: []string{"bug", "help wanted"},
+			},
+			expectedQuery: `repo:owner/repo label:bug label:"help wanted"`,
+		},
+		{
+			name: "people",
+			filters: issueSearchFilters{
+				assignee: "octocat",
+				author:   "monalisa",
+				involves: "hubot",
+			},
+			expectedQuery: "assignee:octocat author:monalisa involves:hubot",
+		},
+		{
+			name:          "created after",
+			filters:       issueSearchFilters{repo: "owner/repo", createdAfter: "2024-01-01"},
+			expectedQuery: "repo:owner/repo created:>=2024-01-01",
+		},
+		{
+			name:          "created before",
+			filters:       issueSearchFilters{createdBefore: "2024-06-30"},
+			expectedQuery: "created:<=2024-06-30",
+		},
+		{
+			name:          "created between",
+			filters:       issueSearchFilters{state: "closed", createdAfter: "2024-01-01", createdBefore: "2024-06-30"},
+			expectedQuery: "state:closed created:2024-01-01..2024-06-30",
+		},
+		{
+			name:           "invalid date",
+			filters:        issueSearchFilters{createdAfter: "01/02/2024"},
+			expectedErrMsg: "invalid date: 01/02/2024, must be in the YYYY-MM-DD format",
+		},
+		{
+			name:           "double quotes",
+			filters:        issueSearchFilters{labels: []string{`say "hi"`}},
+			expectedErrMsg: `label cannot contain double quotes: say "hi"`,
+		},
+		{
+			name:           "no filters",
+			filters:        issueSearchFilters{},
+			expectedErrMsg: "at least one of repo, state, labels, assignee, author, involves, created_after or created_before is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := buildIssueSearchQuery(tc.filters)
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func Test_FindIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := FindIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "find_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	for _, param := range []string{"repo", "state", "labels", "assignee", "author", "involves", "created_after", "created_before", "sort", "order", "page", "perPage"} {
+		assert.Contains(t, tool.InputSchema.Properties, param)
+	}
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number: github.Ptr(42),
+				Title:  github.Ptr("Crash on empty input"),
+				State:  github.Ptr("open"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult *github.IssuesSearchResult
+		expectedErrMsg string
+	}{
+		{
+			name: "structured filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":        `repo:owner/repo state:open label:"good first issue" author:octocat created:>=2024-01-01`,
+						"sort":     "created",
+						"order":    "desc",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repo":          "owner/repo",
+				"state":         "open",
+				"labels":        []interface{}{"good first issue"},
+				"author":        "octocat",
+				"created_after": "2024-01-01",
+				"sort":          "created",
+				"order":         "desc",
+			},
+			expectedResult: mockSearchResult,
+		},
+		{
+			name:         "no filters",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"sort": "created",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of repo, state, labels, assignee, author, involves, created_after or created_before is required",
+		},
+		{
+			name: "invalid search",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"repo": "owner/missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid search repo:owner/missing, check that the repository and users exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FindIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returnedResult github.IssuesSearchResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedResult))
+			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
+			require.Len(t, returnedResult.Issues, 1)
+			assert.Equal(t, *tc.expectedResult.Issues[0].Number, *returnedResult.Issues[0].Number)
+		})
+	}
+}
+
 func Test_CreateIssue(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -753,6 +1275,7 @@ func Test_UpdateIssue(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "title")
 	assert.Contains(t, tool.InputSchema.Properties, "body")
 	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "state_reason")
 	assert.Contains(t, tool.InputSchema.Properties, "labels")
 	assert.Contains(t, tool.InputSchema.Properties, "assignees")
 	assert.Contains(t, tool.InputSchema.Properties, "milestone")
@@ -784,12 +1307,13 @@ func Test_UpdateIssue(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
 					expectRequestBody(t, map[string]any{
-						"title":     "Updated Issue Title",
-						"body":      "Updated issue description",
-						"state":     "closed",
-						"labels":    []any{"bug", "priority"},
-						"assignees": []any{"assignee1", "assignee2"},
-						"milestone": float64(5),
+						"title":        "Updated Issue Title",
+						"body":         "Updated issue description",
+						"state":        "closed",
+						"state_reason": "not_planned",
+						"labels":       []any{"bug", "priority"},
+						"assignees":    []any{"assignee1", "assignee2"},
+						"milestone":    float64(5),
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockIssue),
 					),
@@ -802,6 +1326,7 @@ func Test_UpdateIssue(t *testing.T) {
 				"title":        "Updated Issue Title",
 				"body":         "Updated issue description",
 				"state":        "closed",
+				"state_reason": "not_planned",
 				"labels":       []any{"bug", "priority"},
 				"assignees":    []any{"assignee1", "assignee2"},
 				"milestone":    float64(5),
@@ -814,12 +1339,17 @@ func Test_UpdateIssue(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusOK, &github.Issue{
-						Number:  github.Ptr(123),
-						Title:   github.Ptr("Only Title Updated"),
-						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
-						State:   github.Ptr("open"),
-					}),
+					// Omitted fields are not sent, so they are not cleared
+					expectRequestBody(t, map[string]any{
+						"title": "Only Title Updated",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Only Title Updated"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("open"),
+						}),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -836,6 +1366,72 @@ func Test_UpdateIssue(t *testing.T) {
 				State:   github.Ptr("open"),
 			},
 		},
+		{
+			name: "clear body and labels",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"body":   "",
+						"labels": []any{},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Issue Title"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"body":         "",
+				"labels":       []any{},
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(123),
+				Title:   github.Ptr("Issue Title"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+				State:   github.Ptr("open"),
+			},
+		},
+		{
+			name: "reopen issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":        "open",
+						"state_reason": "reopened",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Issue Title"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "open",
+				"state_reason": "reopened",
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(123),
+				Title:   github.Ptr("Issue Title"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+				State:   github.Ptr("open"),
+			},
+		},
 		{
 			name: "update issue fails with not found",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -863,7 +1459,7 @@ func Test_UpdateIssue(t *testing.T) {
 					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid state value"}`))
+						_, _ = w.Write([]byte(`{"message": "Invalid milestone"}`))
 					}),
 				),
 			),
@@ -871,11 +1467,47 @@ func Test_UpdateIssue(t *testing.T) {
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(123),
-				"state":        "invalid_state",
+				"milestone":    float64(99),
 			},
 			expectError:    true,
 			expectedErrMsg: "failed to update issue",
 		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "invalid_state",
+			},
+			expectError:    true,
+			expectedErrMsg: "state must be open or closed, got invalid_state",
+		},
+		{
+			name:         "state reason not matching the state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "open",
+				"state_reason": "completed",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason completed requires state closed",
+		},
+		{
+			name:         "no fields to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+			},
+			expectError:    true,
+			expectedErrMsg: "no fields to update",
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,479 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxLabelSyncRepos caps the number of repositories a single sync_labels call may update.
+const maxLabelSyncRepos = 50
+
+// labelSyncConcurrency bounds the number of repositories synced at the same time, overridden in tests.
+var labelSyncConcurrency = 5
+
+var labelColorPattern = regexp.MustCompile(`^[0-9a-f]{6}$`)
+
+// labelSpec is a label of the canonical set of sync_labels. A nil description leaves the
+// description of an existing label as is.
+type labelSpec struct {
+	Name        string
+	Color       string
+	Description *string
+}
+
+// labelUpdate is an existing label to bring in line with its spec, current being its name in the
+// repository, which may differ from the spec in case.
+type labelUpdate struct {
+	current string
+	spec    labelSpec
+}
+
+// labelSyncPlan is what sync_labels has to change in a repository.
+type labelSyncPlan struct {
+	create    []labelSpec
+	update    []labelUpdate
+	delete    []string
+	unchanged int
+}
+
+// planLabelSync compares the labels of a repository with the canonical set. Label names are
+// matched case-insensitively, like GitHub does, so a label differing only in case is renamed.
+func planLabelSync(existing []*github.Label, canonical []labelSpec, prune bool) labelSyncPlan {
+	byName := make(map[string]*github.Label, len(existing))
+	for _, label := range existing {
+		byName[strings.ToLower(label.GetName())] = label
+	}
+
+	var plan labelSyncPlan
+	wanted := make(map[string]bool, len(canonical))
+	for _, spec := range canonical {
+		wanted[strings.ToLower(spec.Name)] = true
+		label, ok := byName[strings.ToLower(spec.Name)]
+		switch {
+		case !ok:
+			plan.create = append(plan.create, spec)
+		case label.GetName() != spec.Name ||
+			!strings.EqualFold(label.GetColor(), spec.Color) ||
+			(spec.Description != nil && label.GetDescription() != *spec.Description):
+			plan.update = append(plan.update, labelUpdate{current: label.GetName(), spec: spec})
+		default:
+			plan.unchanged++
+		}
+	}
+	if prune {
+		for _, label := range existing {
+			if !wanted[strings.ToLower(label.GetName())] {
+				plan.delete = append(plan.delete, label.GetName())
+			}
+		}
+	}
+	return plan
+}
+
+// labelSyncResult is the outcome of sync_labels for one repository. On error, the changes
+// listed are the ones made before the error.
+type labelSyncResult struct {
+	Repository string   `json:"repository"`
+	Created    []string `json:"created,omitempty"`
+	Updated    []string `json:"updated,omitempty"`
+	Deleted    []string `json:"deleted,omitempty"`
+	Unchanged  int      `json:"unchanged"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// labelSyncResults is the result of sync_labels.
+type labelSyncResults struct {
+	DryRun       bool              `json:"dry_run,omitempty"`
+	Repositories []labelSyncResult `json:"repositories"`
+}
+
+// listAllLabels fetches every label of a repository, returning the response of the failed page on error.
+func listAllLabels(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Label, *github.Response, error) {
+	var all []*github.Label
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		labels, resp, err := client.Issues.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, labels...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// syncRepoLabels syncs the labels of a single repository. Errors are reported in the result rather
+// than returned, so one failing repository does not fail the others. In dry-run mode the planned
+// changes are reported without being made.
+func syncRepoLabels(ctx context.Context, client *github.Client, fullName string, canonical []labelSpec, prune bool) labelSyncResult {
+	result := labelSyncResult{Repository: fullName}
+	owner, repo, _ := strings.Cut(fullName, "/")
+
+	existing, resp, err := listAllLabels(ctx, client, owner, repo)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			result.Error = "repository not found"
+			return result
+		}
+		result.Error = fmt.Sprintf("failed to list labels: %s", err)
+		return result
+	}
+	plan := planLabelSync(existing, canonical, prune)
+	result.Unchanged = plan.unchanged
+
+	if isDryRun(ctx) {
+		for _, spec := range plan.create {
+			result.Created = append(result.Created, spec.Name)
+		}
+		for _, update := range plan.update {
+			result.Updated = append(result.Updated, update.spec.Name)
+		}
+		result.Deleted = plan.delete
+		return result
+	}
+
+	for _, spec := range plan.create {
+		label := &github.Label{Name: github.Ptr(spec.Name), Color: github.Ptr(spec.Color), Description: spec.Description}
+		_, resp, err := client.Issues.CreateLabel(ctx, owner, repo, label)
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to create label %q: %s", spec.Name, err)
+			return result
+		}
+		_ = resp.Body.Close()
+		result.Created = append(result.Created, spec.Name)
+	}
+	// go-github does not escape label names in paths, and they may contain e.g. a slash
+	for _, update := range plan.update {
+		label := &github.Label{Name: github.Ptr(update.spec.Name), Color: github.Ptr(update.spec.Color), Description: update.spec.Description}
+		_, resp, err := client.Issues.EditLabel(ctx, owner, repo, url.PathEscape(update.current), label)
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to update label %q: %s", update.current, err)
+			return result
+		}
+		_ = resp.Body.Close()
+		result.Updated = append(result.Updated, update.spec.Name)
+	}
+	for _, name := range plan.delete {
+		resp, err := client.Issues.DeleteLabel(ctx, owner, repo, url.PathEscape(name))
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to delete label %q: %s", name, err)
+			return result
+		}
+		_ = resp.Body.Close()
+		result.Deleted = append(result.Deleted, name)
+	}
+	return result
+}
+
+// parseLabelSpecs validates the labels parameter of sync_labels.
+func parseLabelSpecs(labelsObj []interface{}) ([]labelSpec, error) {
+	specs := make([]labelSpec, 0, len(labelsObj))
+	seen := make(map[string]bool, len(labelsObj))
+	for _, labelObj := range labelsObj {
+		labelMap, ok := labelObj.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each label must be an object with name, color and optional description")
+		}
+		name, ok := labelMap["name"].(string)
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("each label must have a name")
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("label %q is listed more than once", name)
+		}
+		seen[strings.ToLower(name)] = true
+		color, _ := labelMap["color"].(string)
+		color = strings.ToLower(strings.TrimPrefix(color, "#"))
+		if !labelColorPattern.MatchString(color) {
+			return nil, fmt.Errorf("label %q must have a color of 6 hexadecimal digits, e.g. d73a4a", name)
+		}
+		spec := labelSpec{Name: name, Color: color}
+		if description, ok := labelMap["description"].(string); ok {
+			spec.Description = github.Ptr(description)
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// SyncLabels creates a tool to apply a canonical set of labels to several repositories.
+func SyncLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_labels",
+			mcp.WithDescription(t("TOOL_SYNC_LABELS_DESCRIPTION", fmt.Sprintf("Apply a canonical set of labels to up to %d GitHub repositories: missing labels are created, labels with a different color or description are updated, and with prune labels not in the set are deleted. Returns the changes made in each repository", maxLabelSyncRepos))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SYNC_LABELS_USER_TITLE", "Sync labels across repositories"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"name", "color"},
+						"properties": map[string]interface{}{
+							"name": map[string]interface{}{
+								"type":        "string",
+								"description": "name of the label",
+							},
+							"color": map[string]interface{}{
+								"type":        "string",
+								"description": "hexadecimal color of the label, e.g. d73a4a",
+							},
+							"description": map[string]interface{}{
+								"type":        "string",
+								"description": "description of the label, left as is on existing labels when omitted",
+							},
+						},
+					}),
+				mcp.Description("Canonical set of labels, each object with name (string), color (string) and optional description (string)"),
+			),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+				mcp.Description("Repositories to sync, each as owner/repo"),
+			),
+			mcp.WithBoolean("prune",
+				mcp.Description("Delete the labels of the repositories that are not in the canonical set, default false"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			labelsObj, ok := request.GetArguments()["labels"].([]interface{})
+			if !ok || len(labelsObj) == 0 {
+				return mcp.NewToolResultError("labels parameter must be a non-empty array of objects with name and color"), nil
+			}
+			canonical, err := parseLabelSpecs(labelsObj)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repositories, err := OptionalStringArrayParam(request, "repositories")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(repositories) == 0 {
+				return mcp.NewToolResultError("repositories parameter must be a non-empty array of owner/repo"), nil
+			}
+			if len(repositories) > maxLabelSyncRepos {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d repositories can be synced at once, got %d", maxLabelSyncRepos, len(repositories))), nil
+			}
+			for _, fullName := range repositories {
+				owner, repo, ok := strings.Cut(fullName, "/")
+				if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %q must be given as owner/repo", fullName)), nil
+				}
+			}
+			prune, err := OptionalParam[bool](request, "prune")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			results := make([]labelSyncResult, len(repositories))
+			sem := make(chan struct{}, labelSyncConcurrency)
+			var wg sync.WaitGroup
+			for i, fullName := range repositories {
+				wg.Add(1)
+				go func(i int, fullName string) {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					results[i] = syncRepoLabels(ctx, client, fullName, canonical, prune)
+				}(i, fullName)
+			}
+			wg.Wait()
+
+			r, err := json.Marshal(labelSyncResults{DryRun: isDryRun(ctx), Repositories: results})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// defaultLabelKeywords are the keywords suggest_labels looks for when no keywords are given, by
+// label name. They apply to the labels of the repository with that name, ignoring case and a
+// prefix such as "type: " or "kind/".
+var defaultLabelKeywords = map[string][]string{
+	"bug":           {"bug", "crash", "crashes", "error", "exception", "panic", "broken", "fails", "failing", "regression", "stack trace"},
+	"documentation": {"docs", "documentation", "readme", "typo"},
+	"enhancement":   {"feature", "feature request", "enhancement", "proposal", "would be nice", "support for"},
+	"question":      {"question", "how do i", "how to", "is it possible"},
+	"performance":   {"slow", "performance", "latency", "memory leak", "high cpu"},
+	"security":      {"security", "vulnerability", "cve", "xss", "injection"},
+}
+
+// labelSuggestion is a label suggested by suggest_labels, with the keywords found for it.
+type labelSuggestion struct {
+	Label    string   `json:"label"`
+	Keywords []string `json:"matched_keywords"`
+}
+
+// labelBaseName is the name of a label without a prefix such as "type: " or "kind/", lower case.
+func labelBaseName(name string) string {
+	name = strings.ToLower(name)
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSpace(name)
+}
+
+// containsKeyword reports whether text, lower case, contains keyword as whole words.
+func containsKeyword(text, keyword string) bool {
+	pattern := `(^|\W)` + regexp.QuoteMeta(strings.ToLower(keyword)) + `($|\W)`
+	return regexp.MustCompile(pattern).MatchString(text)
+}
+
+// suggestLabels matches the title and body of an issue against labels, each label being suggested
+// when its name or one of its keywords appears in the text. Keywords are looked up by the name of
+// the label, then by its base name. Suggestions are ordered by the number of keywords found.
+func suggestLabels(title, body string, labels []*github.Label, keywords map[string][]string) []labelSuggestion {
+	lowered := make(map[string][]string, len(keywords))
+	for name, words := range keywords {
+		lowered[strings.ToLower(name)] = words
+	}
+	text := strings.ToLower(title + "\n" + body)
+
+	suggestions := []labelSuggestion{}
+	for _, label := range labels {
+		name, base := strings.ToLower(label.GetName()), labelBaseName(label.GetName())
+		words, ok := lowered[name]
+		if !ok {
+			words = lowered[base]
+		}
+		candidates := append([]string{name, base}, words...)
+
+		var matched []string
+		seen := make(map[string]bool, len(candidates))
+		for _, word := range candidates {
+			word = strings.ToLower(strings.TrimSpace(word))
+			if word == "" || seen[word] {
+				continue
+			}
+			seen[word] = true
+			if containsKeyword(text, word) {
+				matched = append(matched, word)
+			}
+		}
+		if len(matched) > 0 {
+			suggestions = append(suggestions, labelSuggestion{Label: label.GetName(), Keywords: matched})
+		}
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if len(suggestions[i].Keywords) != len(suggestions[j].Keywords) {
+			return len(suggestions[i].Keywords) > len(suggestions[j].Keywords)
+		}
+		return suggestions[i].Label < suggestions[j].Label
+	})
+	return suggestions
+}
+
+// SuggestLabels creates a tool to suggest existing labels of a repository for an issue.
+func SuggestLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_labels",
+			mcp.WithDescription(t("TOOL_SUGGEST_LABELS_DESCRIPTION", "Suggest labels of a GitHub repository for an issue or pull request from keywords in its title and body, without applying them. Only existing labels are suggested, with the keywords found for each")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUGGEST_LABELS_USER_TITLE", "Suggest labels"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the issue or pull request"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Body of the issue or pull request"),
+			),
+			mcp.WithObject("keywords",
+				mcp.Description("Map from label name to the keywords suggesting it, e.g. {\"bug\": [\"crash\", \"error\"]}. Replaces the default keywords for common labels such as bug, documentation and enhancement. A label is always suggested when its name appears"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keywordsObj, err := OptionalParam[map[string]interface{}](request, "keywords")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keywords := defaultLabelKeywords
+			if keywordsObj != nil {
+				keywords = make(map[string][]string, len(keywordsObj))
+				for name, wordsObj := range keywordsObj {
+					wordsList, ok := wordsObj.([]interface{})
+					if !ok {
+						return mcp.NewToolResultError(fmt.Sprintf("keywords of label %q must be an array of strings", name)), nil
+					}
+					for _, wordObj := range wordsList {
+						word, ok := wordObj.(string)
+						if !ok {
+							return mcp.NewToolResultError(fmt.Sprintf("keywords of label %q must be an array of strings", name)), nil
+						}
+						keywords[name] = append(keywords[name], word)
+					}
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			labels, resp, err := listAllLabels(ctx, client, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list labels: %w", err)
+			}
+
+			r, err := json.Marshal(suggestLabels(title, body, labels, keywords))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
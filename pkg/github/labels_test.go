@@ -0,0 +1,370 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PlanLabelSync(t *testing.T) {
+	existing := []*github.Label{
+		{Name: github.Ptr("bug"), Color: github.Ptr("D73A4A"), Description: github.Ptr("Something isn't working")},
+		{Name: github.Ptr("Enhancement"), Color: github.Ptr("a2eeef"), Description: github.Ptr("New feature or request")},
+		{Name: github.Ptr("docs"), Color: github.Ptr("0075ca"), Description: github.Ptr("Documentation")},
+		{Name: github.Ptr("area/ui"), Color: github.Ptr("ffffff")},
+	}
+
+	tests := []struct {
+		name              string
+		canonical         []labelSpec
+		prune             bool
+		expectedCreate    []string
+		expectedUpdate    []labelUpdate
+		expectedDelete    []string
+		expectedUnchanged int
+	}{
+		{
+			name: "create missing and update mismatched",
+			canonical: []labelSpec{
+				{Name: "bug", Color: "d73a4a", Description: github.Ptr("Something isn't working")},
+				{Name: "enhancement", Color: "a2eeef", Description: github.Ptr("New feature or request")},
+				{Name: "docs", Color: "1d76db", Description: github.Ptr("Documentation")},
+				{Name: "good first issue", Color: "7057ff"},
+			},
+			expectedCreate: []string{"good first issue"},
+			expectedUpdate: []labelUpdate{
+				{current: "Enhancement", spec: labelSpec{Name: "enhancement", Color: "a2eeef", Description: github.Ptr("New feature or request")}},
+				{current: "docs", spec: labelSpec{Name: "docs", Color: "1d76db", Description: github.Ptr("Documentation")}},
+			},
+			expectedUnchanged: 1,
+		},
+		{
+			name: "omitted description is left as is",
+			canonical: []labelSpec{
+				{Name: "bug", Color: "d73a4a"},
+				{Name: "docs", Color: "0075ca", Description: github.Ptr("Improvements to the documentation")},
+			},
+			expectedUpdate: []labelUpdate{
+				{current: "docs", spec: labelSpec{Name: "docs", Color: "0075ca", Description: github.Ptr("Improvements to the documentation")}},
+			},
+			expectedUnchanged: 1,
+		},
+		{
+			name: "prune deletes extras",
+			canonical: []labelSpec{
+				{Name: "BUG", Color: "d73a4a"},
+				{Name: "docs", Color: "0075ca"},
+			},
+			prune: true,
+			expectedUpdate: []labelUpdate{
+				{current: "bug", spec: labelSpec{Name: "BUG", Color: "d73a4a"}},
+			},
+			expectedDelete:    []string{"Enhancement", "area/ui"},
+			expectedUnchanged: 1,
+		},
+		{
+			name: "extras are kept without prune",
+			canonical: []labelSpec{
+				{Name: "docs", Color: "0075ca"},
+			},
+			expectedUnchanged: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := planLabelSync(existing, tc.canonical, tc.prune)
+
+			var created []string
+			for _, spec := range plan.create {
+				created = append(created, spec.Name)
+			}
+			assert.Equal(t, tc.expectedCreate, created)
+			assert.Equal(t, tc.expectedUpdate, plan.update)
+			assert.Equal(t, tc.expectedDelete, plan.delete)
+			assert.Equal(t, tc.expectedUnchanged, plan.unchanged)
+		})
+	}
+}
+
+func Test_SyncLabels(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SyncLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "sync_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "repositories")
+	assert.Contains(t, tool.InputSchema.Properties, "prune")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"labels", "repositories"})
+
+	originalConcurrency := labelSyncConcurrency
+	labelSyncConcurrency = 2
+	t.Cleanup(func() { labelSyncConcurrency = originalConcurrency })
+
+	// Labels by repository, a missing repository answers 404
+	repoLabels := map[string][]*github.Label{
+		"org/api": {
+			{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")},
+			{Name: github.Ptr("area/ui"), Color: github.Ptr("d876e3")},
+		},
+		"org/web": {
+			{Name: github.Ptr("bug"), Color: github.Ptr("ee0701")},
+			{Name: github.Ptr("docs"), Color: github.Ptr("0075ca")},
+		},
+	}
+	labelsPath := func(r *http.Request) string {
+		return strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/repos/"), "/labels")
+	}
+
+	canonical := []interface{}{
+		map[string]interface{}{"name": "bug", "color": "#D73A4A"},
+		map[string]interface{}{"name": "docs", "color": "0075ca", "description": "Documentation"},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		dryRun          bool
+		expectError     bool
+		expectedResults labelSyncResults
+		expectedWrites  []string
+		expectedErrMsg  string
+	}{
+		{
+			name: "sync with prune",
+			requestArgs: map[string]interface{}{
+				"labels":       canonical,
+				"repositories": []interface{}{"org/api", "org/web", "org/missing"},
+				"prune":        true,
+			},
+			expectedResults: labelSyncResults{
+				Repositories: []labelSyncResult{
+					{Repository: "org/api", Created: []string{"docs"}, Deleted: []string{"area/ui"}, Unchanged: 1},
+					{Repository: "org/web", Updated: []string{"bug", "docs"}},
+					{Repository: "org/missing", Error: "repository not found"},
+				},
+			},
+			expectedWrites: []string{
+				"DELETE /repos/org/api/labels/area%2Fui",
+				"PATCH /repos/org/web/labels/bug",
+				"PATCH /repos/org/web/labels/docs",
+				"POST /repos/org/api/labels",
+			},
+		},
+		{
+			name: "dry run reports the plan",
+			requestArgs: map[string]interface{}{
+				"labels":       canonical,
+				"repositories": []interface{}{"org/api"},
+			},
+			dryRun: true,
+			expectedResults: labelSyncResults{
+				DryRun: true,
+				Repositories: []labelSyncResult{
+					{Repository: "org/api", Created: []string{"docs"}, Unchanged: 1},
+				},
+			},
+		},
+		{
+			name: "invalid color",
+			requestArgs: map[string]interface{}{
+				"labels":       []interface{}{map[string]interface{}{"name": "bug", "color": "red"}},
+				"repositories": []interface{}{"org/api"},
+			},
+			expectError:    true,
+			expectedErrMsg: `label "bug" must have a color of 6 hexadecimal digits, e.g. d73a4a`,
+		},
+		{
+			name: "repository without owner",
+			requestArgs: map[string]interface{}{
+				"labels":       canonical,
+				"repositories": []interface{}{"api"},
+			},
+			expectError:    true,
+			expectedErrMsg: `repository "api" must be given as owner/repo`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var writes []string
+			recordWrite := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				writes = append(writes, r.Method+" "+r.URL.EscapedPath())
+				mu.Unlock()
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{}`))
+			})
+
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLabelsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						labels, ok := repoLabels[labelsPath(r)]
+						if !ok {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(labels))
+					}),
+				),
+				mock.WithRequestMatchHandler(mock.PostReposLabelsByOwnerByRepo, recordWrite),
+				mock.WithRequestMatchHandler(mock.PatchReposLabelsByOwnerByRepoByName, recordWrite),
+				mock.WithRequestMatchHandler(mock.DeleteReposLabelsByOwnerByRepoByName, recordWrite),
+			))
+			_, handler := SyncLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			ctx := context.Background()
+			if tc.dryRun {
+				ctx = ContextWithDryRun(ctx)
+			}
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ctx, request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned labelSyncResults
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResults, returned)
+
+			sort.Strings(writes)
+			assert.Equal(t, tc.expectedWrites, writes)
+		})
+	}
+}
+
+func Test_SuggestLabelsForText(t *testing.T) {
+	labels := []*github.Label{
+		{Name: github.Ptr("type: bug")},
+		{Name: github.Ptr("documentation")},
+		{Name: github.Ptr("enhancement")},
+		{Name: github.Ptr("area/cli")},
+		{Name: github.Ptr("good first issue")},
+	}
+
+	tests := []struct {
+		name     string
+		title    string
+		body     string
+		keywords map[string][]string
+		expected []labelSuggestion
+	}{
+		{
+			name:  "crash report",
+			title: "CLI crashes with a panic on startup",
+			body:  "Running the cli fails with the stack trace below.",
+			expected: []labelSuggestion{
+				{Label: "type: bug", Keywords: []string{"crashes", "panic", "fails", "stack trace"}},
+				{Label: "area/cli", Keywords: []string{"cli"}},
+			},
+		},
+		{
+			name:  "documentation typo",
+			title: "Typo in the README",
+			expected: []labelSuggestion{
+				{Label: "documentation", Keywords: []string{"readme", "typo"}},
+			},
+		},
+		{
+			name:  "keywords only match whole words",
+			title: "Add support for terror mode",
+			body:  "Would be nice to have, see the docstring.",
+			expected: []labelSuggestion{
+				{Label: "enhancement", Keywords: []string{"would be nice", "support for"}},
+			},
+		},
+		{
+			name:     "custom keywords replace the defaults",
+			title:    "Crash when the flag is missing",
+			body:     "Easy one for newcomers",
+			keywords: map[string][]string{"Good First Issue": {"easy", "newcomers"}},
+			expected: []labelSuggestion{
+				{Label: "good first issue", Keywords: []string{"easy", "newcomers"}},
+			},
+		},
+		{
+			name:     "nothing matches",
+			title:    "Meeting notes",
+			expected: []labelSuggestion{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keywords := tc.keywords
+			if keywords == nil {
+				keywords = defaultLabelKeywords
+			}
+			assert.Equal(t, tc.expected, suggestLabels(tc.title, tc.body, labels, keywords))
+		})
+	}
+}
+
+func Test_SuggestLabels(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SuggestLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "suggest_labels", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "keywords")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposLabelsByOwnerByRepo,
+			[]*github.Label{
+				{Name: github.Ptr("bug")},
+				{Name: github.Ptr("performance")},
+			},
+		),
+	))
+	_, handler := SuggestLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]interface{}{
+		"owner":    "owner",
+		"repo":     "repo",
+		"title":    "Search is slow since 2.0",
+		"body":     "Looks like a regression.",
+		"keywords": map[string]interface{}{"bug": []interface{}{"regression"}, "performance": []interface{}{"slow"}},
+	})
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []labelSuggestion
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	assert.Equal(t, []labelSuggestion{
+		{Label: "bug", Keywords: []string{"regression"}},
+		{Label: "performance", Keywords: []string{"slow"}},
+	}, returned)
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// pageSizeParams are the parameters with which list tools take the number of items of a page.
+var pageSizeParams = []string{"perPage", "first"}
+
+// WithMaxItems returns a server option capping the number of items any paginated tool returns to
+// maxItems, however many items of a page are requested, to keep an agent from enumerating a whole
+// organization by accident. A tool is paginated when it takes a page size, one of pageSizeParams;
+// the results of other tools, such as the topics of get_repository, are left as they are. The
+// page size of a tool call is lowered to maxItems, and the lists of a result, either the result
+// itself or its top level fields, are trimmed to maxItems. A result the cap applied to keeps its
+// shape and is followed by a second text content saying it was capped.
+func WithMaxItems(maxItems int) server.ServerOption {
+	return server.WithToolHandlerMiddleware(maxItemsMiddleware(maxItems))
+}
+
+// paginatedTools remembers which tools of a server take a page size, so the tools are only listed
+// again when a tool not seen yet is called, such as one of a toolset enabled since.
+type paginatedTools struct {
+	mu        sync.Mutex
+	paginated map[string]bool
+}
+
+// isPaginated reports whether the tool called as name takes a page size, looking it up among the
+// tools of the server handling the call of ctx.
+func (p *paginatedTools) isPaginated(ctx context.Context, name string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if paginated, ok := p.paginated[name]; ok {
+		return paginated
+	}
+
+	s := server.ServerFromContext(ctx)
+	if s == nil {
+		return false
+	}
+	tools, err := listServerTools(ctx, s)
+	if err != nil {
+		return false
+	}
+	p.paginated = make(map[string]bool, len(tools))
+	for _, tool := range tools {
+		p.paginated[tool.Name] = takesPageSize(tool)
+	}
+	return p.paginated[name]
+}
+
+// takesPageSize reports whether tool has one of the pageSizeParams.
+func takesPageSize(tool mcp.Tool) bool {
+	for _, param := range pageSizeParams {
+		if _, ok := tool.InputSchema.Properties[param]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// cappedNote is the text content following a result the max items cap applied to.
+func cappedNote(maxItems int) string {
+	return fmt.Sprintf("The result is capped to %d items by the server, request the next page for more.", maxItems)
+}
+
+func maxItemsMiddleware(maxItems int) server.ToolHandlerMiddleware {
+	tools := &paginatedTools{}
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !tools.isPaginated(ctx, request.Params.Name) {
+				return next(ctx, request)
+			}
+			capped := false
+			for _, param := range pageSizeParams {
+				size, ok := request.GetArguments()[param].(float64)
+				if !ok || size <= float64(maxItems) {
+					continue
+				}
+				// Copy the arguments rather than changing the map of the caller
+				args := make(map[string]interface{}, len(request.GetArguments()))
+				for k, v := range request.GetArguments() {
+					args[k] = v
+				}
+				args[param] = float64(maxItems)
+				request.Params.Arguments = args
+				capped = true
+			}
+
+			result, err := next(ctx, request)
+			if err != nil || result == nil || result.IsError || len(result.Content) != 1 {
+				return result, err
+			}
+			text, ok := result.Content[0].(mcp.TextContent)
+			if !ok {
+				return result, nil
+			}
+			trimmed, ok := capItems([]byte(text.Text), maxItems, capped)
+			if !ok {
+				return result, nil
+			}
+			result.Content = []mcp.Content{
+				mcp.NewTextContent(string(trimmed)),
+				mcp.NewTextContent(cappedNote(maxItems)),
+			}
+			return result, nil
+		}
+	}
+}
+
+// capItems trims the JSON list data, or the lists among the top level fields of the JSON object
+// data, to maxItems. It returns false when data is left as is, because nothing was trimmed and
+// capped is not set.
+func capItems(data []byte, maxItems int, capped bool) ([]byte, bool) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err == nil {
+		if len(items) <= maxItems {
+			return data, capped
+		}
+		r, err := json.Marshal(items[:maxItems])
+		if err != nil {
+			return nil, false
+		}
+		return r, true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, false
+	}
+	trimmed := false
+	for name, value := range fields {
+		var list []json.RawMessage
+		if err := json.Unmarshal(value, &list); err != nil || len(list) <= maxItems {
+			continue
+		}
+		r, err := json.Marshal(list[:maxItems])
+		if err != nil {
+			return nil, false
+		}
+		fields[name] = r
+		trimmed = true
+	}
+	if !trimmed {
+		return data, capped
+	}
+	r, err := json.Marshal(fields)
+	if err != nil {
+		return nil, false
+	}
+	return r, true
+}
@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// callTool calls the tool name of s with args as a client would, through the middleware of s.
+func callTool(t *testing.T, s *server.MCPServer, name string, args map[string]interface{}) *mcp.CallToolResult {
+	t.Helper()
+	message, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]interface{}{"name": name, "arguments": args},
+	})
+	require.NoError(t, err)
+	response, ok := s.HandleMessage(context.Background(), message).(mcp.JSONRPCResponse)
+	require.True(t, ok)
+	result, ok := response.Result.(mcp.CallToolResult)
+	require.True(t, ok)
+	return &result
+}
+
+func Test_MaxItems_ListBranches(t *testing.T) {
+	branches := make([]*github.Branch, 0, 5)
+	for i := 0; i < 5; i++ {
+		branches = append(branches, &github.Branch{Name: github.Ptr(fmt.Sprintf("branch-%d", i))})
+	}
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]interface{}
+		expectedPerPage  string
+		expectCapped     bool
+		expectedBranches []string
+	}{
+		{
+			name: "large page size is lowered and flagged",
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(100),
+			},
+			expectedPerPage:  "3",
+			expectCapped:     true,
+			expectedBranches: []string{"branch-0", "branch-1", "branch-2"},
+		},
+		{
+			name: "response longer than the cap is trimmed",
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedPerPage:  "30",
+			expectCapped:     true,
+			expectedBranches: []string{"branch-0", "branch-1", "branch-2"},
+		},
+		{
+			name: "page within the cap is left as is",
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(2),
+			},
+			expectedPerPage:  "2",
+			expectedBranches: []string{"branch-0", "branch-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, tc.expectedPerPage, r.URL.Query().Get("per_page"))
+						perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+						require.NoError(t, err)
+						mockResponse(t, http.StatusOK, branches[:min(perPage, len(branches))])(w, r)
+					}),
+				),
+			))
+			s := NewServer("test", WithMaxItems(3))
+			s.AddTool(ListBranches(stubGetClientFn(client), translations.NullTranslationHelper))
+
+			result := callTool(t, s, "list_branches", tc.requestArgs)
+			require.False(t, result.IsError)
+
+			var names []string
+			var returned []*github.Branch
+			if tc.expectCapped {
+				// The trimmed list keeps its shape, the note comes as a content of its own
+				require.Len(t, result.Content, 2)
+				assert.Equal(t, mcp.NewTextContent(cappedNote(3)), result.Content[1])
+				require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &returned))
+			} else {
+				textContent := getTextResult(t, result)
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			}
+			for _, branch := range returned {
+				names = append(names, branch.GetName())
+			}
+			assert.Equal(t, tc.expectedBranches, names)
+		})
+	}
+}
+
+func Test_MaxItems_SearchRepositories(t *testing.T) {
+	searchResult := &github.RepositoriesSearchResult{
+		Total:             github.Ptr(4000),
+		IncompleteResults: github.Ptr(false),
+		Repositories: []*github.Repository{
+			{FullName: github.Ptr("org/repo-0")},
+			{FullName: github.Ptr("org/repo-1")},
+			{FullName: github.Ptr("org/repo-2")},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetSearchRepositories,
+			expectQueryParams(t, map[string]string{
+				"q":        "org:org",
+				"page":     "1",
+				"per_page": "2",
+			}).andThen(
+				// The API returning more than requested still gets trimmed
+				mockResponse(t, http.StatusOK, searchResult),
+			),
+		),
+	))
+	s := NewServer("test", WithMaxItems(2))
+	s.AddTool(SearchRepositories(stubGetClientFn(client), translations.NullTranslationHelper))
+
+	result := callTool(t, s, "search_repositories", map[string]interface{}{
+		"query":   "org:org",
+		"perPage": float64(100),
+	})
+	require.False(t, result.IsError)
+
+	require.Len(t, result.Content, 2)
+	assert.Equal(t, mcp.NewTextContent(cappedNote(2)), result.Content[1])
+	var returned github.RepositoriesSearchResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &returned))
+	assert.Equal(t, 4000, returned.GetTotal())
+	require.Len(t, returned.Repositories, 2)
+	assert.Equal(t, "org/repo-0", returned.Repositories[0].GetFullName())
+	assert.Equal(t, "org/repo-1", returned.Repositories[1].GetFullName())
+}
+
+func Test_MaxItems_NonPaginatedToolLeftAlone(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposByOwnerByRepo,
+			&github.Repository{
+				FullName: github.Ptr("owner/repo"),
+				Topics:   []string{"go", "mcp", "github", "api", "cli"},
+			},
+		),
+	))
+	s := NewServer("test", WithMaxItems(2))
+	s.AddTool(GetRepository(stubGetClientFn(client), translations.NullTranslationHelper))
+
+	// get_repository takes no page size, so its topics are not a page to trim
+	result := callTool(t, s, "get_repository", map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	})
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var returned map[string]any
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+	assert.Equal(t, []any{"go", "mcp", "github", "api", "cli"}, returned["topics"])
+}
+
+func Test_MaxItems_ListsToolsOnce(t *testing.T) {
+	listings := 0
+	s := NewServer("test", WithMaxItems(2), server.WithToolFilter(func(_ context.Context, tools []mcp.Tool) []mcp.Tool {
+		listings++
+		return tools
+	}))
+	list := func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("[1,2,3]"), nil
+	}
+	s.AddTool(mcp.NewTool("list_numbers", WithPagination()), list)
+
+	for i := 0; i < 3; i++ {
+		result := callTool(t, s, "list_numbers", nil)
+		require.Len(t, result.Content, 2)
+	}
+	assert.Equal(t, 1, listings)
+
+	// A tool added since, as by enabling a toolset, is looked up once more
+	s.AddTool(mcp.NewTool("list_more_numbers", WithPagination()), list)
+	result := callTool(t, s, "list_more_numbers", nil)
+	require.Len(t, result.Content, 2)
+	callTool(t, s, "list_numbers", nil)
+	assert.Equal(t, 2, listings)
+}
+
+func Test_CapItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		capped   bool
+		expectOK bool
+		expected string
+	}{
+		{
+			name:     "short list",
+			data:     `[1,2]`,
+			expectOK: false,
+		},
+		{
+			name:     "long list",
+			data:     `[1,2,3]`,
+			expectOK: true,
+			expected: `[1,2]`,
+		},
+		{
+			name:     "short list of a lowered page size",
+			data:     `[1]`,
+			capped:   true,
+			expectOK: true,
+			expected: `[1]`,
+		},
+		{
+			name:     "object with a long list",
+			data:     `{"total_count":3,"items":[1,2,3],"labels":["a"]}`,
+			expectOK: true,
+			expected: `{"items":[1,2],"labels":["a"],"total_count":3}`,
+		},
+		{
+			name:     "object without long lists",
+			data:     `{"number":1,"labels":["a","b"]}`,
+			expectOK: false,
+		},
+		{
+			name:     "object without long lists of a lowered page size",
+			data:     `{"total_count":1,"items":[1]}`,
+			capped:   true,
+			expectOK: true,
+			expected: `{"total_count":1,"items":[1]}`,
+		},
+		{
+			name:     "text",
+			data:     `Branch main already exists`,
+			capped:   true,
+			expectOK: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			trimmed, ok := capItems([]byte(tc.data), 2, tc.capped)
+			require.Equal(t, tc.expectOK, ok)
+			if ok {
+				assert.JSONEq(t, tc.expected, string(trimmed))
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// mergeSettings is the merge policy of a repository, returned by get_merge_settings and update_merge_settings.
+type mergeSettings struct {
+	Repository          string `json:"repository"`
+	AllowMergeCommit    bool   `json:"allow_merge_commit"`
+	AllowSquashMerge    bool   `json:"allow_squash_merge"`
+	AllowRebaseMerge    bool   `json:"allow_rebase_merge"`
+	AllowAutoMerge      bool   `json:"allow_auto_merge"`
+	DeleteBranchOnMerge bool   `json:"delete_branch_on_merge"`
+}
+
+// mergeSettingsOf extracts the merge policy of a repository.
+func mergeSettingsOf(repository *github.Repository) mergeSettings {
+	return mergeSettings{
+		Repository:          repository.GetFullName(),
+		AllowMergeCommit:    repository.GetAllowMergeCommit(),
+		AllowSquashMerge:    repository.GetAllowSquashMerge(),
+		AllowRebaseMerge:    repository.GetAllowRebaseMerge(),
+		AllowAutoMerge:      repository.GetAllowAutoMerge(),
+		DeleteBranchOnMerge: repository.GetDeleteBranchOnMerge(),
+	}
+}
+
+// GetMergeSettings creates a tool to get the merge policy of a repository.
+func GetMergeSettings(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_merge_settings",
+			mcp.WithDescription(t("TOOL_GET_MERGE_SETTINGS_DESCRIPTION", "Get the merge policy of a GitHub repository: the merge methods pull requests can use, whether auto-merge is allowed and whether head branches are deleted once merged")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_MERGE_SETTINGS_USER_TITLE", "Get merge settings"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(mergeSettingsOf(repository))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateMergeSettings creates a tool to change the merge policy of a repository.
+func UpdateMergeSettings(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_merge_settings",
+			mcp.WithDescription(t("TOOL_UPDATE_MERGE_SETTINGS_DESCRIPTION", "Change the merge policy of a GitHub repository. Only the settings given are changed, at least one merge method must stay allowed")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_MERGE_SETTINGS_USER_TITLE", "Update merge settings"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("allow_merge_commit",
+				mcp.Description("Allow merging pull requests with a merge commit"),
+			),
+			mcp.WithBoolean("allow_squash_merge",
+				mcp.Description("Allow squash-merging pull requests"),
+			),
+			mcp.WithBoolean("allow_rebase_merge",
+				mcp.Description("Allow rebase-merging pull requests"),
+			),
+			mcp.WithBoolean("allow_auto_merge",
+				mcp.Description("Allow enabling auto-merge on pull requests"),
+			),
+			mcp.WithBoolean("delete_branch_on_merge",
+				mcp.Description("Delete head branches once their pull request is merged"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			edit := &github.Repository{}
+			settings := []struct {
+				name  string
+				field **bool
+			}{
+				{"allow_merge_commit", &edit.AllowMergeCommit},
+				{"allow_squash_merge", &edit.AllowSquashMerge},
+				{"allow_rebase_merge", &edit.AllowRebaseMerge},
+				{"allow_auto_merge", &edit.AllowAutoMerge},
+				{"delete_branch_on_merge", &edit.DeleteBranchOnMerge},
+			}
+			changed := false
+			for _, setting := range settings {
+				value, ok, err := OptionalParamOK[bool](request, setting.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if ok {
+					*setting.field = github.Ptr(value)
+					changed = true
+				}
+			}
+			if !changed {
+				return mcp.NewToolResultError("no fields to update, give at least one of allow_merge_commit, allow_squash_merge, allow_rebase_merge, allow_auto_merge or delete_branch_on_merge"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("update the merge settings of %s/%s", owner, repo), nil
+			}
+			edited, resp, err := client.Repositories.Edit(ctx, owner, repo, edit)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp):
+					return mcp.NewToolResultError(fmt.Sprintf("cannot update the merge settings of %s/%s: %s", owner, repo, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to update merge settings: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update merge settings: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(mergeSettingsOf(edited))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
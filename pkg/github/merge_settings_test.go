@@ -0,0 +1,203 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetMergeSettings(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetMergeSettings(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_merge_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedResult mergeSettings
+		expectedErrMsg string
+	}{
+		{
+			name: "merge policy of the repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					&github.Repository{
+						FullName:            github.Ptr("owner/repo"),
+						AllowMergeCommit:    github.Ptr(false),
+						AllowSquashMerge:    github.Ptr(true),
+						AllowRebaseMerge:    github.Ptr(true),
+						AllowAutoMerge:      github.Ptr(true),
+						DeleteBranchOnMerge: github.Ptr(true),
+					},
+				),
+			),
+			expectedResult: mergeSettings{
+				Repository:          "owner/repo",
+				AllowSquashMerge:    true,
+				AllowRebaseMerge:    true,
+				AllowAutoMerge:      true,
+				DeleteBranchOnMerge: true,
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "repository owner/repo not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetMergeSettings(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned mergeSettings
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UpdateMergeSettings(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateMergeSettings(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_merge_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "allow_merge_commit")
+	assert.Contains(t, tool.InputSchema.Properties, "allow_squash_merge")
+	assert.Contains(t, tool.InputSchema.Properties, "allow_rebase_merge")
+	assert.Contains(t, tool.InputSchema.Properties, "allow_auto_merge")
+	assert.Contains(t, tool.InputSchema.Properties, "delete_branch_on_merge")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult mergeSettings
+		expectedErrMsg string
+	}{
+		{
+			name: "only the given settings are sent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"allow_merge_commit":     false,
+						"delete_branch_on_merge": true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{
+							FullName:            github.Ptr("owner/repo"),
+							AllowMergeCommit:    github.Ptr(false),
+							AllowSquashMerge:    github.Ptr(true),
+							AllowRebaseMerge:    github.Ptr(true),
+							AllowAutoMerge:      github.Ptr(false),
+							DeleteBranchOnMerge: github.Ptr(true),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+			},
+			expectedResult: mergeSettings{
+				Repository:          "owner/repo",
+				AllowSquashMerge:    true,
+				AllowRebaseMerge:    true,
+				DeleteBranchOnMerge: true,
+			},
+		},
+		{
+			name: "disallowing every merge method",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors":  []any{map[string]any{"message": "At least one merge method must be allowed"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":              "owner",
+				"repo":               "repo",
+				"allow_squash_merge": false,
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot update the merge settings of owner/repo: Validation Failed: At least one merge method must be allowed",
+		},
+		{
+			name:         "no fields to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "no fields to update, give at least one of allow_merge_commit, allow_squash_merge, allow_rebase_merge, allow_auto_merge or delete_branch_on_merge",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateMergeSettings(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned mergeSettings
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
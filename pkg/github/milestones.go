@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxMilestoneIssues caps the number of issues a single assign_milestone call may update.
+const maxMilestoneIssues = 100
+
+// milestoneAssignConcurrency bounds the number of issues updated at the same time, overridden in tests.
+var milestoneAssignConcurrency = 5
+
+// listAllMilestones fetches every milestone of a repository, open and closed, returning the
+// response of the failed page on error.
+func listAllMilestones(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Milestone, *github.Response, error) {
+	var all []*github.Milestone
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, milestones...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findMilestone returns the milestone ref designates, or nil. A title matches before a number, so
+// that a milestone titled e.g. "2024" is found by its title. Titles are compared exactly first, then
+// ignoring case.
+func findMilestone(milestones []*github.Milestone, ref string) *github.Milestone {
+	for _, milestone := range milestones {
+		if milestone.GetTitle() == ref {
+			return milestone
+		}
+	}
+	for _, milestone := range milestones {
+		if strings.EqualFold(milestone.GetTitle(), ref) {
+			return milestone
+		}
+	}
+	if number, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil {
+		for _, milestone := range milestones {
+			if milestone.GetNumber() == number {
+				return milestone
+			}
+		}
+	}
+	return nil
+}
+
+// milestoneAssignResult is the outcome of assign_milestone for one issue or pull request.
+type milestoneAssignResult struct {
+	IssueNumber int    `json:"issue_number"`
+	Error       string `json:"error,omitempty"`
+}
+
+// milestoneAssignResults is the result of assign_milestone.
+type milestoneAssignResults struct {
+	DryRun          bool                    `json:"dry_run,omitempty"`
+	MilestoneNumber int                     `json:"milestone_number"`
+	MilestoneTitle  string                  `json:"milestone_title"`
+	Assigned        int                     `json:"assigned"`
+	Failed          int                     `json:"failed"`
+	Issues          []milestoneAssignResult `json:"issues"`
+}
+
+// assignIssueMilestone sets the milestone of a single issue or pull request. Errors are reported in
+// the result rather than returned, so one failing issue does not fail the others.
+func assignIssueMilestone(ctx context.Context, client *github.Client, owner, repo string, issueNumber, milestone int) milestoneAssignResult {
+	result := milestoneAssignResult{IssueNumber: issueNumber}
+	_, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{Milestone: github.Ptr(milestone)})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			result.Error = "issue not found"
+			return result
+		}
+		result.Error = fmt.Sprintf("failed to set milestone: %s", err)
+		return result
+	}
+	_ = resp.Body.Close()
+	return result
+}
+
+// AssignMilestone creates a tool to set the same milestone on several issues and pull requests of a repository.
+func AssignMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("assign_milestone",
+			mcp.WithDescription(t("TOOL_ASSIGN_MILESTONE_DESCRIPTION", fmt.Sprintf("Set the same milestone on up to %d issues and pull requests of a GitHub repository, e.g. to group them under a release. The milestone is given by title or number. Returns the outcome for each issue, one failing issue does not stop the others", maxMilestoneIssues))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ASSIGN_MILESTONE_USER_TITLE", "Assign milestone"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("issue_numbers",
+				mcp.Required(),
+				mcp.Description("Numbers of the issues and pull requests to set the milestone on"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+			mcp.WithString("milestone",
+				mcp.Required(),
+				mcp.Description("Title or number of the milestone, open or closed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumbers, err := OptionalIntArrayParam(request, "issue_numbers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(issueNumbers) == 0 {
+				return mcp.NewToolResultError("missing required parameter: issue_numbers"), nil
+			}
+			if len(issueNumbers) > maxMilestoneIssues {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d issues can be updated at once, got %d", maxMilestoneIssues, len(issueNumbers))), nil
+			}
+			seen := make(map[int]bool, len(issueNumbers))
+			for _, number := range issueNumbers {
+				if number < 1 {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid issue number: %d", number)), nil
+				}
+				if seen[number] {
+					return mcp.NewToolResultError(fmt.Sprintf("issue %d is listed more than once", number)), nil
+				}
+				seen[number] = true
+			}
+			// Numbers are accepted as well as titles, in case the milestone is passed as a JSON number
+			var ref string
+			switch milestone := request.GetArguments()["milestone"].(type) {
+			case float64:
+				ref = strconv.Itoa(int(milestone))
+			default:
+				ref, err = requiredParam[string](request, "milestone")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			milestones, resp, err := listAllMilestones(ctx, client, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list milestones: %w", err)
+			}
+			milestone := findMilestone(milestones, ref)
+			if milestone == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("milestone %q not found in %s/%s", ref, owner, repo)), nil
+			}
+
+			results := milestoneAssignResults{
+				DryRun:          isDryRun(ctx),
+				MilestoneNumber: milestone.GetNumber(),
+				MilestoneTitle:  milestone.GetTitle(),
+				Issues:          make([]milestoneAssignResult, len(issueNumbers)),
+			}
+			if results.DryRun {
+				for i, number := range issueNumbers {
+					results.Issues[i] = milestoneAssignResult{IssueNumber: number}
+				}
+			} else {
+				sem := make(chan struct{}, milestoneAssignConcurrency)
+				var wg sync.WaitGroup
+				for i, number := range issueNumbers {
+					wg.Add(1)
+					go func(i, number int) {
+						defer wg.Done()
+						sem <- struct{}{}
+						defer func() { <-sem }()
+						results.Issues[i] = assignIssueMilestone(ctx, client, owner, repo, number, milestone.GetNumber())
+					}(i, number)
+				}
+				wg.Wait()
+			}
+			for _, result := range results.Issues {
+				if result.Error != "" {
+					results.Failed++
+				} else {
+					results.Assigned++
+				}
+			}
+
+			r, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
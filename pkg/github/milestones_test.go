@@ -0,0 +1,241 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindMilestone(t *testing.T) {
+	milestones := []*github.Milestone{
+		{Number: github.Ptr(1), Title: github.Ptr("v1.0")},
+		{Number: github.Ptr(2), Title: github.Ptr("V2.0")},
+		{Number: github.Ptr(3), Title: github.Ptr("v2.0")},
+		{Number: github.Ptr(4), Title: github.Ptr("1")},
+	}
+
+	tests := []struct {
+		name           string
+		ref            string
+		expectedNumber int
+	}{
+		{name: "exact title", ref: "v2.0", expectedNumber: 3},
+		{name: "title ignoring case", ref: "V1.0", expectedNumber: 1},
+		{name: "number", ref: "2", expectedNumber: 2},
+		{name: "number with hash", ref: "#3", expectedNumber: 3},
+		{name: "title before number", ref: "1", expectedNumber: 4},
+		{name: "unknown", ref: "v3.0"},
+		{name: "unknown number", ref: "9"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			milestone := findMilestone(milestones, tc.ref)
+			if tc.expectedNumber == 0 {
+				assert.Nil(t, milestone)
+				return
+			}
+			require.NotNil(t, milestone)
+			assert.Equal(t, tc.expectedNumber, milestone.GetNumber())
+		})
+	}
+}
+
+func Test_AssignMilestone(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AssignMilestone(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "assign_milestone", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_numbers")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_numbers", "milestone"})
+
+	originalConcurrency := milestoneAssignConcurrency
+	milestoneAssignConcurrency = 2
+	t.Cleanup(func() { milestoneAssignConcurrency = originalConcurrency })
+
+	milestones := []*github.Milestone{
+		{Number: github.Ptr(1), Title: github.Ptr("v1.0"), State: github.Ptr("closed")},
+		{Number: github.Ptr(4), Title: github.Ptr("v2.0"), State: github.Ptr("open")},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		dryRun          bool
+		expectError     bool
+		expectedResults milestoneAssignResults
+		expectedWrites  []string
+		expectedErrMsg  string
+	}{
+		{
+			name: "assign by title with one missing issue",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(10), float64(11), float64(404)},
+				"milestone":     "v2.0",
+			},
+			expectedResults: milestoneAssignResults{
+				MilestoneNumber: 4,
+				MilestoneTitle:  "v2.0",
+				Assigned:        2,
+				Failed:          1,
+				Issues: []milestoneAssignResult{
+					{IssueNumber: 10},
+					{IssueNumber: 11},
+					{IssueNumber: 404, Error: "issue not found"},
+				},
+			},
+			expectedWrites: []string{
+				"/repos/owner/repo/issues/10 milestone=4",
+				"/repos/owner/repo/issues/11 milestone=4",
+				"/repos/owner/repo/issues/404 milestone=4",
+			},
+		},
+		{
+			name: "assign by number",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(12)},
+				"milestone":     float64(1),
+			},
+			expectedResults: milestoneAssignResults{
+				MilestoneNumber: 1,
+				MilestoneTitle:  "v1.0",
+				Assigned:        1,
+				Issues:          []milestoneAssignResult{{IssueNumber: 12}},
+			},
+			expectedWrites: []string{
+				"/repos/owner/repo/issues/12 milestone=1",
+			},
+		},
+		{
+			name: "dry run resolves the milestone only",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(10)},
+				"milestone":     "V2.0",
+			},
+			dryRun: true,
+			expectedResults: milestoneAssignResults{
+				DryRun:          true,
+				MilestoneNumber: 4,
+				MilestoneTitle:  "v2.0",
+				Assigned:        1,
+				Issues:          []milestoneAssignResult{{IssueNumber: 10}},
+			},
+		},
+		{
+			name: "unknown milestone",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(10)},
+				"milestone":     "v3.0",
+			},
+			expectError:    true,
+			expectedErrMsg: `milestone "v3.0" not found in owner/repo`,
+		},
+		{
+			name: "duplicate issue",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{float64(10), float64(10)},
+				"milestone":     "v2.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "issue 10 is listed more than once",
+		},
+		{
+			name: "no issues",
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []interface{}{},
+				"milestone":     "v2.0",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: issue_numbers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var writes []string
+
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":    "all",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, milestones),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var body struct {
+							Milestone int `json:"milestone"`
+						}
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						mu.Lock()
+						writes = append(writes, fmt.Sprintf("%s milestone=%d", r.URL.Path, body.Milestone))
+						mu.Unlock()
+						if strings.HasSuffix(r.URL.Path, "/404") {
+							w.WriteHeader(http.StatusNotFound)
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(`{}`))
+					}),
+				),
+			))
+			_, handler := AssignMilestone(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			ctx := context.Background()
+			if tc.dryRun {
+				ctx = ContextWithDryRun(ctx)
+			}
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ctx, request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned milestoneAssignResults
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResults, returned)
+
+			sort.Strings(writes)
+			assert.Equal(t, tc.expectedWrites, writes)
+		})
+	}
+}
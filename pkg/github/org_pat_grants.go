@@ -0,0 +1,277 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxRevokedPATGrants caps the number of grants a single revoke_org_pat_grant call may revoke,
+// as the API does.
+const maxRevokedPATGrants = 100
+
+// patGrantSummary is the compact form of the access a fine-grained personal access token was
+// granted to an organization. Repositories is only listed when the grant covers a subset of the
+// repositories of the organization.
+type patGrantSummary struct {
+	ID                    int64                                  `json:"id"`
+	TokenID               int64                                  `json:"token_id"`
+	TokenName             string                                 `json:"token_name"`
+	Owner                 string                                 `json:"owner"`
+	Permissions           *github.PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	RepositorySelection   string                                 `json:"repository_selection"`
+	Repositories          []string                               `json:"repositories,omitempty"`
+	RepositoriesTruncated bool                                   `json:"repositories_truncated,omitempty"`
+	AccessGrantedAt       *time.Time                             `json:"access_granted_at,omitempty"`
+	TokenExpired          bool                                   `json:"token_expired"`
+	TokenExpiresAt        *time.Time                             `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt       *time.Time                             `json:"token_last_used_at,omitempty"`
+}
+
+// summarizePATGrant converts a fine-grained personal access token grant to its compact form.
+func summarizePATGrant(grant *github.PersonalAccessToken) patGrantSummary {
+	summary := patGrantSummary{
+		ID:                  grant.GetID(),
+		TokenID:             grant.GetTokenID(),
+		TokenName:           grant.GetTokenName(),
+		Owner:               grant.GetOwner().GetLogin(),
+		Permissions:         grant.Permissions,
+		RepositorySelection: grant.GetRepositorySelection(),
+		TokenExpired:        grant.GetTokenExpired(),
+	}
+	if grant.AccessGrantedAt != nil {
+		summary.AccessGrantedAt = &grant.AccessGrantedAt.Time
+	}
+	if grant.TokenExpiresAt != nil {
+		summary.TokenExpiresAt = &grant.TokenExpiresAt.Time
+	}
+	if grant.TokenLastUsedAt != nil {
+		summary.TokenLastUsedAt = &grant.TokenLastUsedAt.Time
+	}
+	return summary
+}
+
+// patGrantRepositories returns the full names of the first 100 repositories a grant covers, and
+// whether it covers more.
+func patGrantRepositories(ctx context.Context, client *github.Client, org string, grantID int64) ([]string, bool, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("orgs/%s/personal-access-tokens/%d/repositories?per_page=100", org, grantID), nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	var repos []*github.Repository
+	resp, err := client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list repositories of grant %d: %w", grantID, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	names := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		names = append(names, repo.GetFullName())
+	}
+	return names, resp.NextPage != 0, nil
+}
+
+// ListOrgPATGrants creates a tool to list the fine-grained personal access tokens granted access to an organization.
+func ListOrgPATGrants(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_pat_grants",
+			mcp.WithDescription(t("TOOL_LIST_ORG_PAT_GRANTS_DESCRIPTION", "List the fine-grained personal access tokens granted access to a GitHub organization, with the owner, permissions and repositories of each grant, to audit the access tokens have. Requires a GitHub App token with the organization personal access tokens permission")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_PAT_GRANTS_USER_TITLE", "List organization personal access token grants"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithArray("owners",
+				mcp.Description("Only list the grants of tokens owned by these users"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("repository",
+				mcp.Description("Only list the grants covering this repository of the organization"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only list the grants with this permission, e.g. issues or contents:write"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owners, err := OptionalStringArrayParam(request, "owners")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repository, err := OptionalParam[string](request, "repository")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			grants, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, org, &github.ListFineGrainedPATOptions{
+				Owner:      owners,
+				Repository: repository,
+				Permission: permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("organization %s not found, or it does not restrict access by fine-grained personal access tokens", org)), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot list the personal access token grants of %s, only GitHub Apps with the organization personal access tokens permission can", org)), nil
+				}
+				return nil, fmt.Errorf("failed to list personal access token grants: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list personal access token grants: %s", string(body))), nil
+			}
+
+			summaries := make([]patGrantSummary, 0, len(grants))
+			for _, grant := range grants {
+				summary := summarizePATGrant(grant)
+				if summary.RepositorySelection == "subset" {
+					summary.Repositories, summary.RepositoriesTruncated, err = patGrantRepositories(ctx, client, org, summary.ID)
+					if err != nil {
+						return nil, err
+					}
+				}
+				summaries = append(summaries, summary)
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// revokePATGrantsRequest is the body of a request revoking fine-grained personal access token grants.
+type revokePATGrantsRequest struct {
+	Action string  `json:"action"`
+	PATIDs []int64 `json:"pat_ids"`
+}
+
+// RevokeOrgPATGrant creates a tool to revoke the access of fine-grained personal access tokens to an organization.
+func RevokeOrgPATGrant(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("revoke_org_pat_grant",
+			mcp.WithDescription(t("TOOL_REVOKE_ORG_PAT_GRANT_DESCRIPTION", "Revoke the access of fine-grained personal access tokens to a GitHub organization. GitHub revokes the grants in the background, they stop being listed by list_org_pat_grants once it is done. Requires a GitHub App token with the organization personal access tokens permission")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REVOKE_ORG_PAT_GRANT_USER_TITLE", "Revoke organization personal access token grant"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithArray("grant_ids",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("IDs of the grants to revoke, from list_org_pat_grants, at most %d", maxRevokedPATGrants)),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			grantIDs, err := OptionalIntArrayParam(request, "grant_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(grantIDs) == 0 {
+				return mcp.NewToolResultError("missing required parameter: grant_ids"), nil
+			}
+			if len(grantIDs) > maxRevokedPATGrants {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d grants can be revoked at once, got %d", maxRevokedPATGrants, len(grantIDs))), nil
+			}
+			payload := &revokePATGrantsRequest{Action: "revoke"}
+			ids := make([]string, 0, len(grantIDs))
+			for _, id := range grantIDs {
+				payload.PATIDs = append(payload.PATIDs, int64(id))
+				ids = append(ids, fmt.Sprintf("%d", id))
+			}
+			grants := fmt.Sprintf("grant %s", ids[0])
+			if len(ids) > 1 {
+				grants = fmt.Sprintf("grants %s", strings.Join(ids, ", "))
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("revoke personal access token %s in %s", grants, org), nil
+			}
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("orgs/%s/personal-access-tokens", org), payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			resp, err := client.Do(ctx, req, nil)
+			// The API answers 202 Accepted, which go-github reports as an AcceptedError
+			if err != nil && !isAcceptedError(err) {
+				var errResp *github.ErrorResponse
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusNotFound:
+					return mcp.NewToolResultError(fmt.Sprintf("organization %s not found, or it does not restrict access by fine-grained personal access tokens", org)), nil
+				case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp):
+					return mcp.NewToolResultError(fmt.Sprintf("cannot revoke personal access token %s in %s: %s", grants, org, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to revoke personal access token grants: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusAccepted {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to revoke personal access token grants: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Revocation of personal access token %s in %s accepted, GitHub revokes the access in the background", grants, org)), nil
+		}
+}
@@ -0,0 +1,251 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgPATGrants(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgPATGrants(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_org_pat_grants", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "owners")
+	assert.Contains(t, tool.InputSchema.Properties, "repository")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockGrants := []*github.PersonalAccessToken{
+		{
+			ID:                  github.Ptr(int64(25381)),
+			TokenID:             github.Ptr(int64(98716)),
+			TokenName:           github.Ptr("ci deploy"),
+			Owner:               &github.User{Login: github.Ptr("octocat")},
+			RepositorySelection: github.Ptr("subset"),
+			Permissions: &github.PersonalAccessTokenPermissions{
+				Repo: map[string]string{"contents": "write", "metadata": "read"},
+			},
+			TokenExpired: github.Ptr(false),
+		},
+		{
+			ID:                  github.Ptr(int64(25382)),
+			TokenID:             github.Ptr(int64(98717)),
+			TokenName:           github.Ptr("audit"),
+			Owner:               &github.User{Login: github.Ptr("hubot")},
+			RepositorySelection: github.Ptr("all"),
+			Permissions: &github.PersonalAccessTokenPermissions{
+				Org: map[string]string{"members": "read"},
+			},
+			TokenExpired: github.Ptr(true),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult []patGrantSummary
+		expectedErrMsg string
+	}{
+		{
+			name: "lists the grants with the repositories of subset grants",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPersonalAccessTokensByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, []string{"octocat", "hubot"}, r.URL.Query()["owner[]"])
+						assert.Equal(t, "contents:write", r.URL.Query().Get("permission"))
+						assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+						mockResponse(t, http.StatusOK, mockGrants)(w, r)
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPersonalAccessTokensRepositoriesByOrgByPatId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/orgs/octo-org/personal-access-tokens/25381/repositories", r.URL.Path)
+						mockResponse(t, http.StatusOK, []*github.Repository{
+							{FullName: github.Ptr("octo-org/api")},
+							{FullName: github.Ptr("octo-org/web")},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":        "octo-org",
+				"owners":     []interface{}{"octocat", "hubot"},
+				"permission": "contents:write",
+				"perPage":    float64(50),
+			},
+			expectedResult: []patGrantSummary{
+				{
+					ID:                  25381,
+					TokenID:             98716,
+					TokenName:           "ci deploy",
+					Owner:               "octocat",
+					Permissions:         mockGrants[0].Permissions,
+					RepositorySelection: "subset",
+					Repositories:        []string{"octo-org/api", "octo-org/web"},
+				},
+				{
+					ID:                  25382,
+					TokenID:             98717,
+					TokenName:           "audit",
+					Owner:               "hubot",
+					Permissions:         mockGrants[1].Permissions,
+					RepositorySelection: "all",
+					TokenExpired:        true,
+				},
+			},
+		},
+		{
+			name: "not a GitHub App",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPersonalAccessTokensByOrg,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by personal access token"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot list the personal access token grants of octo-org, only GitHub Apps with the organization personal access tokens permission can",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListOrgPATGrants(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned []patGrantSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_RevokeOrgPATGrant(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RevokeOrgPATGrant(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "revoke_org_pat_grant", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "grant_ids")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "grant_ids"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		requestArgs  map[string]interface{}
+		expectError  bool
+		expectedText string
+	}{
+		{
+			name: "revokes a grant by id",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsPersonalAccessTokensByOrg,
+					expectRequestBody(t, map[string]any{
+						"action":  "revoke",
+						"pat_ids": []any{float64(25381)},
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, map[string]any{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"grant_ids": []interface{}{float64(25381)},
+			},
+			expectedText: "Revocation of personal access token grant 25381 in octo-org accepted, GitHub revokes the access in the background",
+		},
+		{
+			name: "revokes several grants",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsPersonalAccessTokensByOrg,
+					expectRequestBody(t, map[string]any{
+						"action":  "revoke",
+						"pat_ids": []any{float64(25381), float64(25382)},
+					}).andThen(
+						mockResponse(t, http.StatusAccepted, map[string]any{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"grant_ids": []interface{}{float64(25381), float64(25382)},
+			},
+			expectedText: "Revocation of personal access token grants 25381, 25382 in octo-org accepted, GitHub revokes the access in the background",
+		},
+		{
+			name: "grant rejected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsPersonalAccessTokensByOrg,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors":  []any{map[string]any{"message": "pat_ids 1 do not exist"}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"grant_ids": []interface{}{float64(1)},
+			},
+			expectError:  true,
+			expectedText: "cannot revoke personal access token grant 1 in octo-org: Validation Failed: pat_ids 1 do not exist",
+		},
+		{
+			name:         "no grant",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"grant_ids": []interface{}{},
+			},
+			expectError:  true,
+			expectedText: "missing required parameter: grant_ids",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RevokeOrgPATGrant(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectError, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultMaxOrgReposScanned = 500
+	maxOrgReposScanned        = 2000
+)
+
+// orgRepoFilters are the client-side filters of list_org_repositories, echoed back in the result.
+type orgRepoFilters struct {
+	Type        string     `json:"type,omitempty"`
+	Archived    string     `json:"archived"`
+	MinStars    int        `json:"min_stars,omitempty"`
+	PushedSince *time.Time `json:"pushed_since,omitempty"`
+	Language    string     `json:"language,omitempty"`
+}
+
+// matches reports whether repo passes all the filters.
+func (f orgRepoFilters) matches(repo *github.Repository) bool {
+	switch f.Archived {
+	case "exclude":
+		if repo.GetArchived() {
+			return false
+		}
+	case "only":
+		if !repo.GetArchived() {
+			return false
+		}
+	}
+	if repo.GetStargazersCount() < f.MinStars {
+		return false
+	}
+	if f.PushedSince != nil && (repo.PushedAt == nil || repo.PushedAt.Before(*f.PushedSince)) {
+		return false
+	}
+	if f.Language != "" && !strings.EqualFold(repo.GetLanguage(), f.Language) {
+		return false
+	}
+	return true
+}
+
+// orgRepoSummary is the compact form of a repository returned by list_org_repositories.
+type orgRepoSummary struct {
+	FullName string     `json:"full_name"`
+	Language string     `json:"language"`
+	Stars    int        `json:"stars"`
+	Archived bool       `json:"archived"`
+	PushedAt *time.Time `json:"pushed_at,omitempty"`
+	HTMLURL  string     `json:"html_url"`
+}
+
+// orgReposResult is the result of list_org_repositories.
+type orgReposResult struct {
+	Filters      orgRepoFilters   `json:"filters"`
+	Scanned      int              `json:"scanned"`
+	Truncated    bool             `json:"truncated"`
+	Repositories []orgRepoSummary `json:"repositories"`
+}
+
+// parsePushedSince accepts either a date or an RFC 3339 timestamp.
+func parsePushedSince(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("pushed_since must be a date (YYYY-MM-DD) or an RFC 3339 timestamp, got %s", value)
+	}
+	return t, nil
+}
+
+// ListOrgReposAdvanced creates a tool to list the repositories of an organization with filters the API does not offer.
+func ListOrgReposAdvanced(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_repositories",
+			mcp.WithDescription(t("TOOL_LIST_ORG_REPOSITORIES_DESCRIPTION", "List the repositories of an organization, filtered by archived state, minimum stars, last push date and language. Useful for audits such as finding all active, non-archived Go repositories pushed in the last 90 days")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_REPOSITORIES_USER_TITLE", "List organization repositories"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("type",
+				mcp.Description("Type of repositories to list"),
+				mcp.Enum("all", "public", "private", "forks", "sources", "member"),
+			),
+			mcp.WithString("archived",
+				mcp.Description("Whether to include archived repositories, exclude them or only list them (default include)"),
+				mcp.Enum("include", "exclude", "only"),
+			),
+			mcp.WithNumber("min_stars",
+				mcp.Description("Only list repositories with at least this many stars"),
+				mcp.Min(0),
+			),
+			mcp.WithString("pushed_since",
+				mcp.Description("Only list repositories pushed to since this date (YYYY-MM-DD) or RFC 3339 timestamp"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only list repositories whose primary language is this one, e.g. Go"),
+			),
+			mcp.WithNumber("max_scanned",
+				mcp.Description(fmt.Sprintf("Maximum number of repositories to scan before filtering (default %d, max %d)", defaultMaxOrgReposScanned, maxOrgReposScanned)),
+				mcp.Min(1),
+				mcp.Max(maxOrgReposScanned),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoType, err := OptionalParam[string](request, "type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			archived, err := OptionalParam[string](request, "archived")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch archived {
+			case "":
+				archived = "include"
+			case "include", "exclude", "only":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid archived: %s, must be one of include, exclude or only", archived)), nil
+			}
+			minStars, err := OptionalIntParam(request, "min_stars")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pushedSinceValue, err := OptionalParam[string](request, "pushed_since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			language, err := OptionalParam[string](request, "language")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxScanned, err := OptionalIntParamWithDefault(request, "max_scanned", defaultMaxOrgReposScanned)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxScanned < 1 || maxScanned > maxOrgReposScanned {
+				return mcp.NewToolResultError(fmt.Sprintf("max_scanned must be between 1 and %d", maxOrgReposScanned)), nil
+			}
+
+			filters := orgRepoFilters{
+				Type:     repoType,
+				Archived: archived,
+				MinStars: minStars,
+				Language: language,
+			}
+			if pushedSinceValue != "" {
+				pushedSince, err := parsePushedSince(pushedSinceValue)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				filters.PushedSince = &pushedSince
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Most recently pushed first, so a pushed_since filter can stop at the first older repository
+			opts := &github.RepositoryListByOrgOptions{
+				Type:        repoType,
+				Sort:        "pushed",
+				Direction:   "desc",
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			result := orgReposResult{
+				Filters:      filters,
+				Repositories: []orgRepoSummary{},
+			}
+
+		scan:
+			for {
+				repos, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list organization repositories: %w", err)
+				}
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					_ = resp.Body.Close()
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list organization repositories: %s", string(body))), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, repo := range repos {
+					if filters.PushedSince != nil && repo.PushedAt != nil && repo.PushedAt.Before(*filters.PushedSince) {
+						break scan
+					}
+					if result.Scanned == maxScanned {
+						result.Truncated = true
+						break scan
+					}
+					result.Scanned++
+					if !filters.matches(repo) {
+						continue
+					}
+					summary := orgRepoSummary{
+						FullName: repo.GetFullName(),
+						Language: repo.GetLanguage(),
+						Stars:    repo.GetStargazersCount(),
+						Archived: repo.GetArchived(),
+						HTMLURL:  repo.GetHTMLURL(),
+					}
+					if repo.PushedAt != nil {
+						summary.PushedAt = &repo.PushedAt.Time
+					}
+					result.Repositories = append(result.Repositories, summary)
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
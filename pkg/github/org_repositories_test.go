@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOrgRepoPages serves the given pages of organization repositories, linking each page to the next.
+func mockOrgRepoPages(t *testing.T, pages [][]*github.Repository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pushed", r.URL.Query().Get("sort"))
+		assert.Equal(t, "desc", r.URL.Query().Get("direction"))
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			_, _ = fmt.Sscanf(p, "%d", &page)
+		}
+		if page < len(pages) {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/orgs/octo-org/repos?page=%d>; rel="next"`, page+1))
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(pages[page-1])
+	}
+}
+
+func Test_ListOrgReposAdvanced(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgReposAdvanced(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_org_repositories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "type")
+	assert.Contains(t, tool.InputSchema.Properties, "archived")
+	assert.Contains(t, tool.InputSchema.Properties, "min_stars")
+	assert.Contains(t, tool.InputSchema.Properties, "pushed_since")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "max_scanned")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	repo := func(name, language string, stars int, archived bool, pushed string) *github.Repository {
+		pushedAt, _ := time.Parse("2006-01-02", pushed)
+		return &github.Repository{
+			FullName:        github.Ptr("octo-org/" + name),
+			Language:        github.Ptr(language),
+			StargazersCount: github.Ptr(stars),
+			Archived:        github.Ptr(archived),
+			PushedAt:        &github.Timestamp{Time: pushedAt},
+		}
+	}
+	// Sorted by last push, newest first, as requested from the API
+	pages := [][]*github.Repository{
+		{
+			repo("api", "Go", 120, false, "2025-05-01"),
+			repo("web", "TypeScript", 40, false, "2025-04-20"),
+		},
+		{
+			repo("cli", "go", 8, false, "2025-03-15"),
+			repo("old-tool", "Go", 300, true, "2025-03-01"),
+		},
+		{
+			repo("legacy", "Go", 500, true, "2023-01-01"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		expectedNames   []string
+		expectedScanned int
+		expectTruncated bool
+		expectedFilters orgRepoFilters
+		expectedErrMsg  string
+	}{
+		{
+			name: "no filters scans every page",
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectedNames:   []string{"api", "web", "cli", "old-tool", "legacy"},
+			expectedScanned: 5,
+			expectedFilters: orgRepoFilters{Archived: "include"},
+		},
+		{
+			name: "exclude archived",
+			requestArgs: map[string]interface{}{
+				"org":      "octo-org",
+				"archived": "exclude",
+			},
+			expectedNames:   []string{"api", "web", "cli"},
+			expectedScanned: 5,
+			expectedFilters: orgRepoFilters{Archived: "exclude"},
+		},
+		{
+			name: "only archived",
+			requestArgs: map[string]interface{}{
+				"org":      "octo-org",
+				"archived": "only",
+			},
+			expectedNames:   []string{"old-tool", "legacy"},
+			expectedScanned: 5,
+			expectedFilters: orgRepoFilters{Archived: "only"},
+		},
+		{
+			name: "min stars",
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"min_stars": float64(100),
+			},
+			expectedNames:   []string{"api", "old-tool", "legacy"},
+			expectedScanned: 5,
+			expectedFilters: orgRepoFilters{Archived: "include", MinStars: 100},
+		},
+		{
+			name: "language is case insensitive",
+			requestArgs: map[string]interface{}{
+				"org":      "octo-org",
+				"language": "Go",
+			},
+			expectedNames:   []string{"api", "cli", "old-tool", "legacy"},
+			expectedScanned: 5,
+			expectedFilters: orgRepoFilters{Archived: "include", Language: "Go"},
+		},
+		{
+			name: "pushed since stops at the first older repository",
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"pushed_since": "2025-03-10",
+			},
+			expectedNames:   []string{"api", "web", "cli"},
+			expectedScanned: 3,
+			expectedFilters: orgRepoFilters{Archived: "include", PushedSince: github.Ptr(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC))},
+		},
+		{
+			name: "active non-archived go repositories",
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"archived":     "exclude",
+				"language":     "go",
+				"min_stars":    float64(10),
+				"pushed_since": "2025-01-01T00:00:00Z",
+			},
+			expectedNames:   []string{"api"},
+			expectedScanned: 4,
+			expectedFilters: orgRepoFilters{
+				Archived:    "exclude",
+				MinStars:    10,
+				Language:    "go",
+				PushedSince: github.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "max scanned caps the scan",
+			requestArgs: map[string]interface{}{
+				"org":         "octo-org",
+				"max_scanned": float64(3),
+			},
+			expectedNames:   []string{"api", "web", "cli"},
+			expectedScanned: 3,
+			expectTruncated: true,
+			expectedFilters: orgRepoFilters{Archived: "include"},
+		},
+		{
+			name: "invalid pushed since",
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"pushed_since": "90 days ago",
+			},
+			expectedErrMsg: "pushed_since must be a date (YYYY-MM-DD) or an RFC 3339 timestamp, got 90 days ago",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsReposByOrg,
+					mockOrgRepoPages(t, pages),
+				),
+			))
+			_, handler := ListOrgReposAdvanced(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned orgReposResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(returned.Repositories))
+			for _, r := range returned.Repositories {
+				names = append(names, r.FullName[len("octo-org/"):])
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Equal(t, tc.expectedScanned, returned.Scanned)
+			assert.Equal(t, tc.expectTruncated, returned.Truncated)
+			assert.Equal(t, tc.expectedFilters, returned.Filters)
+		})
+	}
+}
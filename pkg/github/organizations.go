@@ -0,0 +1,526 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// organizationSummary is the compact form of an organization returned by get_organization. The
+// pointer fields are only returned to owners of the organization and stay null for everyone else.
+type organizationSummary struct {
+	Login                       string     `json:"login"`
+	Name                        string     `json:"name,omitempty"`
+	Description                 string     `json:"description,omitempty"`
+	HTMLURL                     string     `json:"html_url"`
+	CreatedAt                   *time.Time `json:"created_at,omitempty"`
+	PublicRepos                 int        `json:"public_repos"`
+	PrivateRepos                *int64     `json:"private_repos"`
+	Plan                        *string    `json:"plan"`
+	Seats                       *int       `json:"seats"`
+	FilledSeats                 *int       `json:"filled_seats"`
+	DefaultRepoPermission       *string    `json:"default_repository_permission"`
+	TwoFactorRequirementEnabled *bool      `json:"two_factor_requirement_enabled"`
+	MembersCanCreateRepos       *bool      `json:"members_can_create_repositories"`
+	HiddenFields                []string   `json:"hidden_fields,omitempty"`
+}
+
+// summarizeOrganization converts an organization to its compact form, listing the owner-only
+// fields the API did not return.
+func summarizeOrganization(org *github.Organization) organizationSummary {
+	summary := organizationSummary{
+		Login:                       org.GetLogin(),
+		Name:                        org.GetName(),
+		Description:                 org.GetDescription(),
+		HTMLURL:                     org.GetHTMLURL(),
+		PublicRepos:                 org.GetPublicRepos(),
+		PrivateRepos:                org.TotalPrivateRepos,
+		DefaultRepoPermission:       org.DefaultRepoPermission,
+		TwoFactorRequirementEnabled: org.TwoFactorRequirementEnabled,
+		MembersCanCreateRepos:       org.MembersCanCreateRepos,
+	}
+	if org.CreatedAt != nil {
+		summary.CreatedAt = &org.CreatedAt.Time
+	}
+	if org.Plan != nil {
+		summary.Plan = org.Plan.Name
+		summary.Seats = org.Plan.Seats
+		summary.FilledSeats = org.Plan.FilledSeats
+	}
+
+	ownerOnly := []struct {
+		name    string
+		present bool
+	}{
+		{"private_repos", summary.PrivateRepos != nil},
+		{"plan", summary.Plan != nil},
+		{"seats", summary.Seats != nil},
+		{"filled_seats", summary.FilledSeats != nil},
+		{"default_repository_permission", summary.DefaultRepoPermission != nil},
+		{"two_factor_requirement_enabled", summary.TwoFactorRequirementEnabled != nil},
+		{"members_can_create_repositories", summary.MembersCanCreateRepos != nil},
+	}
+	for _, field := range ownerOnly {
+		if !field.present {
+			summary.HiddenFields = append(summary.HiddenFields, field.name)
+		}
+	}
+	return summary
+}
+
+// GetOrganization creates a tool to get the settings of an organization.
+func GetOrganization(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_organization",
+			mcp.WithDescription(t("TOOL_GET_ORGANIZATION_DESCRIPTION", "Get the plan, repository counts and member settings of a GitHub organization. Fields only visible to organization owners are null and listed in hidden_fields for other users")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ORGANIZATION_USER_TITLE", "Get organization"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			organization, resp, err := client.Organizations.Get(ctx, org)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("organization %s not found", org)), nil
+				}
+				return nil, fmt.Errorf("failed to get organization: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get organization: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeOrganization(organization))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// myOrganization is the compact form of an organization the authenticated user belongs to.
+type myOrganization struct {
+	Login       string `json:"login"`
+	ID          int64  `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// ListMyOrganizations creates a tool to list the organizations of the authenticated user.
+func ListMyOrganizations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_my_organizations",
+			mcp.WithDescription(t("TOOL_LIST_MY_ORGANIZATIONS_DESCRIPTION", "List the GitHub organizations the authenticated user belongs to and the token can see, to find out where it has access. Use get_my_org_membership for the role in one of them. Not available to GitHub App installation tokens")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_MY_ORGANIZATIONS_USER_TITLE", "List my organizations"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// An empty user lists the organizations of the authenticated user
+			orgs, resp, err := client.Organizations.List(ctx, "", &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError("cannot list the organizations of the authenticated user, the token does not act as a user, e.g. it is a GitHub App installation token"), nil
+				}
+				return nil, fmt.Errorf("failed to list organizations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list organizations: %s", string(body))), nil
+			}
+
+			summaries := make([]myOrganization, 0, len(orgs))
+			for _, org := range orgs {
+				summaries = append(summaries, myOrganization{
+					Login:       org.GetLogin(),
+					ID:          org.GetID(),
+					Description: org.GetDescription(),
+				})
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// orgMembershipSummary is the compact form of the membership of the authenticated user in an
+// organization. Role is admin for owners, member or billing_manager otherwise, and State is pending
+// until an invitation is accepted.
+type orgMembershipSummary struct {
+	Organization string `json:"organization"`
+	User         string `json:"user"`
+	Role         string `json:"role"`
+	State        string `json:"state"`
+	Owner        bool   `json:"owner"`
+	Active       bool   `json:"active"`
+}
+
+// summarizeOrgMembership converts an organization membership to its compact form.
+func summarizeOrgMembership(membership *github.Membership) orgMembershipSummary {
+	return orgMembershipSummary{
+		Organization: membership.GetOrganization().GetLogin(),
+		User:         membership.GetUser().GetLogin(),
+		Role:         membership.GetRole(),
+		State:        membership.GetState(),
+		// The API calls organization owners admins
+		Owner:  membership.GetRole() == "admin",
+		Active: membership.GetState() == "active",
+	}
+}
+
+// GetMyOrgMembership creates a tool to get the membership of the authenticated user in an organization.
+func GetMyOrgMembership(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_my_org_membership",
+			mcp.WithDescription(t("TOOL_GET_MY_ORG_MEMBERSHIP_DESCRIPTION", "Get the membership of the authenticated user in a GitHub organization: whether they are an owner (role admin) or a member, and whether the membership is active or still pending")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_MY_ORG_MEMBERSHIP_USER_TITLE", "Get my organization membership"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// An empty user gets the membership of the authenticated user
+			membership, resp, err := client.Organizations.GetOrgMembership(ctx, "", org)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("the authenticated user is not a member of %s, or the organization does not exist", org)), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot get the membership in %s, the token does not act as a user or lacks the read:org scope", org)), nil
+				}
+				return nil, fmt.Errorf("failed to get organization membership: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get organization membership: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeOrgMembership(membership))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// orgInvitationSummary is the compact form of a pending organization invitation.
+type orgInvitationSummary struct {
+	ID        int64      `json:"id"`
+	Login     string     `json:"login,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	Role      string     `json:"role"`
+	Inviter   string     `json:"inviter"`
+	TeamCount int        `json:"team_count"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// summarizeOrgInvitation converts an organization invitation to its compact form.
+func summarizeOrgInvitation(invitation *github.Invitation) orgInvitationSummary {
+	summary := orgInvitationSummary{
+		ID:        invitation.GetID(),
+		Login:     invitation.GetLogin(),
+		Email:     invitation.GetEmail(),
+		Role:      invitation.GetRole(),
+		Inviter:   invitation.GetInviter().GetLogin(),
+		TeamCount: invitation.GetTeamCount(),
+	}
+	if invitation.CreatedAt != nil {
+		summary.CreatedAt = &invitation.CreatedAt.Time
+	}
+	return summary
+}
+
+// ListPendingOrgInvitations creates a tool to list the pending invitations of an organization.
+func ListPendingOrgInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pending_org_invitations",
+			mcp.WithDescription(t("TOOL_LIST_PENDING_ORG_INVITATIONS_DESCRIPTION", "List the pending membership invitations of a GitHub organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PENDING_ORG_INVITATIONS_USER_TITLE", "List pending organization invitations"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, org, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list organization invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization invitations: %s", string(body))), nil
+			}
+
+			summaries := make([]orgInvitationSummary, 0, len(invitations))
+			for _, invitation := range invitations {
+				summaries = append(summaries, summarizeOrgInvitation(invitation))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateOrgInvitation creates a tool to invite a person to an organization.
+func CreateOrgInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_org_invitation",
+			mcp.WithDescription(t("TOOL_CREATE_ORG_INVITATION_DESCRIPTION", "Invite a person to a GitHub organization by email or GitHub user ID, optionally adding them to teams once they accept")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_ORG_INVITATION_USER_TITLE", "Create organization invitation"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("email",
+				mcp.Description("Email address of the person to invite, required unless invitee_id is given"),
+			),
+			mcp.WithNumber("invitee_id",
+				mcp.Description("GitHub user ID of the person to invite, required unless email is given"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Role of the new member (default direct_member)"),
+				mcp.Enum("admin", "direct_member", "billing_manager"),
+			),
+			mcp.WithArray("team_ids",
+				mcp.Description("IDs of the teams the new member joins"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			email, err := OptionalParam[string](request, "email")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			inviteeID, err := OptionalIntParam(request, "invitee_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamIDs, err := OptionalIntArrayParam(request, "team_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CreateOrgInvitationOptions{}
+			switch {
+			case email != "" && inviteeID != 0:
+				return mcp.NewToolResultError("provide either email or invitee_id, not both"), nil
+			case email != "":
+				opts.Email = github.Ptr(email)
+			case inviteeID != 0:
+				opts.InviteeID = github.Ptr(int64(inviteeID))
+			default:
+				return mcp.NewToolResultError("missing required parameter: email or invitee_id"), nil
+			}
+			switch role {
+			case "":
+				role = "direct_member"
+			case "admin", "direct_member", "billing_manager":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid role: %s, must be one of admin, direct_member or billing_manager", role)), nil
+			}
+			opts.Role = github.Ptr(role)
+			for _, id := range teamIDs {
+				opts.TeamID = append(opts.TeamID, int64(id))
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				invitee := email
+				if invitee == "" {
+					invitee = fmt.Sprintf("user %d", inviteeID)
+				}
+				return dryRunResult("invite %s to %s as %s", invitee, org, role), nil
+			}
+			invitation, resp, err := client.Organizations.CreateOrgInvitation(ctx, org, opts)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot invite to %s: %s", org, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to create organization invitation: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create organization invitation: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeOrgInvitation(invitation))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CancelOrgInvitation creates a tool to cancel a pending organization invitation.
+func CancelOrgInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_org_invitation",
+			mcp.WithDescription(t("TOOL_CANCEL_ORG_INVITATION_DESCRIPTION", "Cancel a pending membership invitation of a GitHub organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CANCEL_ORG_INVITATION_USER_TITLE", "Cancel organization invitation"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("The ID of the invitation, from list_pending_org_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("cancel invitation %d to %s", invitationID, org), nil
+			}
+			resp, err := client.Organizations.CancelInvite(ctx, org, int64(invitationID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("invitation %d not found in %s, use list_pending_org_invitations to see the pending invitations", invitationID, org)), nil
+				}
+				return nil, fmt.Errorf("failed to cancel organization invitation: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to cancel organization invitation: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancelled invitation %d to %s", invitationID, org)), nil
+		}
+}
@@ -0,0 +1,583 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetOrganization(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetOrganization(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_organization", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	createdAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	publicOrg := &github.Organization{
+		Login:       github.Ptr("octo-org"),
+		Name:        github.Ptr("Octo Org"),
+		Description: github.Ptr("Building things"),
+		HTMLURL:     github.Ptr("https://github.com/octo-org"),
+		CreatedAt:   &github.Timestamp{Time: createdAt},
+		PublicRepos: github.Ptr(12),
+	}
+	ownerOrg := *publicOrg
+	ownerOrg.TotalPrivateRepos = github.Ptr(int64(30))
+	ownerOrg.Plan = &github.Plan{Name: github.Ptr("team"), Seats: github.Ptr(50), FilledSeats: github.Ptr(42)}
+	ownerOrg.DefaultRepoPermission = github.Ptr("read")
+	ownerOrg.TwoFactorRequirementEnabled = github.Ptr(true)
+	ownerOrg.MembersCanCreateRepos = github.Ptr(false)
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		expectError     bool
+		expectedSummary organizationSummary
+		expectedErrMsg  string
+	}{
+		{
+			name: "owner sees every field",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsByOrg,
+					&ownerOrg,
+				),
+			),
+			expectedSummary: organizationSummary{
+				Login:                       "octo-org",
+				Name:                        "Octo Org",
+				Description:                 "Building things",
+				HTMLURL:                     "https://github.com/octo-org",
+				CreatedAt:                   &createdAt,
+				PublicRepos:                 12,
+				PrivateRepos:                github.Ptr(int64(30)),
+				Plan:                        github.Ptr("team"),
+				Seats:                       github.Ptr(50),
+				FilledSeats:                 github.Ptr(42),
+				DefaultRepoPermission:       github.Ptr("read"),
+				TwoFactorRequirementEnabled: github.Ptr(true),
+				MembersCanCreateRepos:       github.Ptr(false),
+			},
+		},
+		{
+			name: "member sees the public fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsByOrg,
+					publicOrg,
+				),
+			),
+			expectedSummary: organizationSummary{
+				Login:       "octo-org",
+				Name:        "Octo Org",
+				Description: "Building things",
+				HTMLURL:     "https://github.com/octo-org",
+				CreatedAt:   &createdAt,
+				PublicRepos: 12,
+				HiddenFields: []string{
+					"private_repos",
+					"plan",
+					"seats",
+					"filled_seats",
+					"default_repository_permission",
+					"two_factor_requirement_enabled",
+					"members_can_create_repositories",
+				},
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsByOrg,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "organization octo-org not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetOrganization(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"org": "octo-org",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned organizationSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSummary, returned)
+		})
+	}
+}
+
+func Test_ListMyOrganizations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMyOrganizations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_my_organizations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedOrgs   []myOrganization
+		expectedErrMsg string
+	}{
+		{
+			name: "organizations of the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserOrgs,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "5",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Organization{
+							{Login: github.Ptr("octo-org"), ID: github.Ptr(int64(1)), Description: github.Ptr("Octo things")},
+							{Login: github.Ptr("other-org"), ID: github.Ptr(int64(2))},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"page":    float64(2),
+				"perPage": float64(5),
+			},
+			expectedOrgs: []myOrganization{
+				{Login: "octo-org", ID: 1, Description: "Octo things"},
+				{Login: "other-org", ID: 2},
+			},
+		},
+		{
+			name: "installation token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserOrgs,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "cannot list the organizations of the authenticated user, the token does not act as a user, e.g. it is a GitHub App installation token",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListMyOrganizations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned []myOrganization
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedOrgs, returned)
+		})
+	}
+}
+
+func Test_SummarizeOrgMembership(t *testing.T) {
+	membership := func(role, state string) *github.Membership {
+		return &github.Membership{
+			Role:         github.Ptr(role),
+			State:        github.Ptr(state),
+			Organization: &github.Organization{Login: github.Ptr("octo-org")},
+			User:         &github.User{Login: github.Ptr("octocat")},
+		}
+	}
+
+	assert.Equal(t, orgMembershipSummary{Organization: "octo-org", User: "octocat", Role: "admin", State: "active", Owner: true, Active: true},
+		summarizeOrgMembership(membership("admin", "active")))
+	assert.Equal(t, orgMembershipSummary{Organization: "octo-org", User: "octocat", Role: "member", State: "active", Active: true},
+		summarizeOrgMembership(membership("member", "active")))
+	assert.Equal(t, orgMembershipSummary{Organization: "octo-org", User: "octocat", Role: "admin", State: "pending", Owner: true},
+		summarizeOrgMembership(membership("admin", "pending")))
+	assert.Equal(t, orgMembershipSummary{Organization: "octo-org", User: "octocat", Role: "billing_manager", State: "active", Active: true},
+		summarizeOrgMembership(membership("billing_manager", "active")))
+}
+
+func Test_GetMyOrgMembership(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetMyOrgMembership(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_my_org_membership", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedMembership orgMembershipSummary
+		expectedErrMsg     string
+	}{
+		{
+			name: "owner of the organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserMembershipsOrgsByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/user/memberships/orgs/octo-org", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Membership{
+							Role:         github.Ptr("admin"),
+							State:        github.Ptr("active"),
+							Organization: &github.Organization{Login: github.Ptr("octo-org")},
+							User:         &github.User{Login: github.Ptr("octocat")},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectedMembership: orgMembershipSummary{
+				Organization: "octo-org",
+				User:         "octocat",
+				Role:         "admin",
+				State:        "active",
+				Owner:        true,
+				Active:       true,
+			},
+		},
+		{
+			name: "not a member",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserMembershipsOrgsByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "elsewhere",
+			},
+			expectError:    true,
+			expectedErrMsg: "the authenticated user is not a member of elsewhere, or the organization does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetMyOrgMembership(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned orgMembershipSummary
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedMembership, returned)
+		})
+	}
+}
+
+func Test_ListPendingOrgInvitations(t *testing.T) {
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	mockClient := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsInvitationsByOrg,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.Invitation{
+					{
+						ID:        github.Ptr(int64(1)),
+						Email:     github.Ptr("new.hire@example.com"),
+						Role:      github.Ptr("direct_member"),
+						Inviter:   &github.User{Login: github.Ptr("octocat")},
+						TeamCount: github.Ptr(2),
+						CreatedAt: &github.Timestamp{Time: createdAt},
+					},
+				}),
+			),
+		),
+	))
+	tool, handler := ListPendingOrgInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_pending_org_invitations", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org": "octo-org",
+	}))
+	require.NoError(t, err)
+
+	var returned []orgInvitationSummary
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, []orgInvitationSummary{
+		{
+			ID:        1,
+			Email:     "new.hire@example.com",
+			Role:      "direct_member",
+			Inviter:   "octocat",
+			TeamCount: 2,
+			CreatedAt: &createdAt,
+		},
+	}, returned)
+}
+
+func Test_CreateOrgInvitation(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateOrgInvitation(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_org_invitation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "email")
+	assert.Contains(t, tool.InputSchema.Properties, "invitee_id")
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.Contains(t, tool.InputSchema.Properties, "team_ids")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	mockInvitation := &github.Invitation{
+		ID:      github.Ptr(int64(5)),
+		Login:   github.Ptr("newhire"),
+		Role:    github.Ptr("direct_member"),
+		Inviter: &github.User{Login: github.Ptr("octocat")},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedInvitation orgInvitationSummary
+		expectedErrMsg     string
+	}{
+		{
+			name: "invites by email",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsInvitationsByOrg,
+					expectRequestBody(t, map[string]interface{}{
+						"email":    "new.hire@example.com",
+						"role":     "direct_member",
+						"team_ids": []interface{}{float64(10), float64(11)},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Invitation{
+							ID:      github.Ptr(int64(4)),
+							Email:   github.Ptr("new.hire@example.com"),
+							Role:    github.Ptr("direct_member"),
+							Inviter: &github.User{Login: github.Ptr("octocat")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":      "octo-org",
+				"email":    "new.hire@example.com",
+				"team_ids": []interface{}{float64(10), float64(11)},
+			},
+			expectedInvitation: orgInvitationSummary{
+				ID:      4,
+				Email:   "new.hire@example.com",
+				Role:    "direct_member",
+				Inviter: "octocat",
+			},
+		},
+		{
+			name: "invites by user ID",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsInvitationsByOrg,
+					expectRequestBody(t, map[string]interface{}{
+						"invitee_id": float64(42),
+						"role":       "billing_manager",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockInvitation),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":        "octo-org",
+				"invitee_id": float64(42),
+				"role":       "billing_manager",
+			},
+			expectedInvitation: orgInvitationSummary{
+				ID:      5,
+				Login:   "newhire",
+				Role:    "direct_member",
+				Inviter: "octocat",
+			},
+		},
+		{
+			name:         "both email and user ID",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":        "octo-org",
+				"email":      "new.hire@example.com",
+				"invitee_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "provide either email or invitee_id, not both",
+		},
+		{
+			name:         "neither email nor user ID",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: email or invitee_id",
+		},
+		{
+			name:         "invalid role",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":   "octo-org",
+				"email": "new.hire@example.com",
+				"role":  "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid role: owner, must be one of admin, direct_member or billing_manager",
+		},
+		{
+			name: "already a member",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsInvitationsByOrg,
+					mockResponse(t, http.StatusUnprocessableEntity, json.RawMessage(`{"message": "Validation Failed", "errors": [{"resource": "OrganizationInvitation", "code": "unprocessable", "field": "data", "message": "Invitee is already a part of this organization"}]}`)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":        "octo-org",
+				"invitee_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot invite to octo-org: Validation Failed: Invitee is already a part of this organization",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateOrgInvitation(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned orgInvitationSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInvitation, returned)
+		})
+	}
+}
+
+func Test_CancelOrgInvitation(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockedClient *http.Client
+		expectError  bool
+		expectedText string
+	}{
+		{
+			name: "cancels the invitation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsInvitationsByOrgByInvitationId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/orgs/octo-org/invitations/7", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			expectedText: "Cancelled invitation 7 to octo-org",
+		},
+		{
+			name: "invitation not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsInvitationsByOrgByInvitationId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:  true,
+			expectedText: "invitation 7 not found in octo-org, use list_pending_org_invitations to see the pending invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			tool, handler := CancelOrgInvitation(stubGetClientFn(client), translations.NullTranslationHelper)
+			assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "invitation_id"})
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"org":           "octo-org",
+				"invitation_id": float64(7),
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectError, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// packageTypes are the package types accepted by the packages API.
+var packageTypes = []string{"npm", "maven", "rubygems", "docker", "nuget", "container"}
+
+// packageOwner is the organization or user owning packages. The organization and user package
+// endpoints are identical apart from the owner, an empty user being the authenticated user.
+type packageOwner struct {
+	org  string
+	user string
+}
+
+// packageOwnerFromRequest reads the org and user parameters, of which at most one may be set.
+func packageOwnerFromRequest(request mcp.CallToolRequest) (packageOwner, error) {
+	org, err := OptionalParam[string](request, "org")
+	if err != nil {
+		return packageOwner{}, err
+	}
+	user, err := OptionalParam[string](request, "user")
+	if err != nil {
+		return packageOwner{}, err
+	}
+	if org != "" && user != "" {
+		return packageOwner{}, fmt.Errorf("only one of org and user can be provided")
+	}
+	return packageOwner{org: org, user: user}, nil
+}
+
+func (o packageOwner) listPackages(ctx context.Context, client *github.Client, opts *github.PackageListOptions) ([]*github.Package, *github.Response, error) {
+	if o.org != "" {
+		return client.Organizations.ListPackages(ctx, o.org, opts)
+	}
+	return client.Users.ListPackages(ctx, o.user, opts)
+}
+
+func (o packageOwner) getPackage(ctx context.Context, client *github.Client, packageType, packageName string) (*github.Package, *github.Response, error) {
+	if o.org != "" {
+		return client.Organizations.GetPackage(ctx, o.org, packageType, packageName)
+	}
+	return client.Users.GetPackage(ctx, o.user, packageType, packageName)
+}
+
+func (o packageOwner) listVersions(ctx context.Context, client *github.Client, packageType, packageName string, opts *github.PackageListOptions) ([]*github.PackageVersion, *github.Response, error) {
+	if o.org != "" {
+		return client.Organizations.PackageGetAllVersions(ctx, o.org, packageType, packageName, opts)
+	}
+	return client.Users.PackageGetAllVersions(ctx, o.user, packageType, packageName, opts)
+}
+
+func (o packageOwner) deleteVersion(ctx context.Context, client *github.Client, packageType, packageName string, versionID int64) (*github.Response, error) {
+	if o.org != "" {
+		return client.Organizations.PackageDeleteVersion(ctx, o.org, packageType, packageName, versionID)
+	}
+	return client.Users.PackageDeleteVersion(ctx, o.user, packageType, packageName, versionID)
+}
+
+// withPackageOwner adds the org and user parameters shared by the package tools.
+func withPackageOwner() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("org",
+			mcp.Description("Organization owning the packages"),
+		)(tool)
+		mcp.WithString("user",
+			mcp.Description("User owning the packages, defaults to the authenticated user when org is not provided either"),
+		)(tool)
+	}
+}
+
+// withPackage adds the package_type and package_name parameters identifying a package.
+func withPackage() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("package_type",
+			mcp.Required(),
+			mcp.Description("Type of the package"),
+			mcp.Enum(packageTypes...),
+		)(tool)
+		mcp.WithString("package_name",
+			mcp.Required(),
+			mcp.Description("Name of the package"),
+		)(tool)
+	}
+}
+
+// packageSummary is the compact form of a package returned by list_packages.
+type packageSummary struct {
+	ID           int64      `json:"id"`
+	Name         string     `json:"name"`
+	PackageType  string     `json:"package_type"`
+	Visibility   string     `json:"visibility"`
+	VersionCount int64      `json:"version_count"`
+	Repository   string     `json:"repository,omitempty"`
+	HTMLURL      string     `json:"html_url"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// packageVersionSummary is the compact form of a package version returned by list_package_versions.
+type packageVersionSummary struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Tags      []string   `json:"tags,omitempty"`
+	HTMLURL   string     `json:"html_url"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListPackages creates a tool to list the packages of an organization or user.
+func ListPackages(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_packages",
+			mcp.WithDescription(t("TOOL_LIST_PACKAGES_DESCRIPTION", "List the packages of a given type owned by an organization or user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PACKAGES_USER_TITLE", "List packages"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			withPackageOwner(),
+			mcp.WithString("package_type",
+				mcp.Required(),
+				mcp.Description("Type of the packages to list"),
+				mcp.Enum(packageTypes...),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Only list packages with this visibility"),
+				mcp.Enum("public", "private", "internal"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := packageOwnerFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := requiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibility, err := OptionalParam[string](request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PackageListOptions{
+				PackageType: github.Ptr(packageType),
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if visibility != "" {
+				opts.Visibility = github.Ptr(visibility)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			packages, resp, err := owner.listPackages(ctx, client, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list packages: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list packages: %s", string(body))), nil
+			}
+
+			summaries := make([]packageSummary, 0, len(packages))
+			for _, pkg := range packages {
+				summary := packageSummary{
+					ID:           pkg.GetID(),
+					Name:         pkg.GetName(),
+					PackageType:  pkg.GetPackageType(),
+					Visibility:   pkg.GetVisibility(),
+					VersionCount: pkg.GetVersionCount(),
+					Repository:   pkg.GetRepository().GetFullName(),
+					HTMLURL:      pkg.GetHTMLURL(),
+				}
+				if pkg.UpdatedAt != nil {
+					summary.UpdatedAt = &pkg.UpdatedAt.Time
+				}
+				summaries = append(summaries, summary)
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetPackage creates a tool to get a package of an organization or user.
+func GetPackage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_package",
+			mcp.WithDescription(t("TOOL_GET_PACKAGE_DESCRIPTION", "Get details of a package owned by an organization or user")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PACKAGE_USER_TITLE", "Get package"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			withPackageOwner(),
+			withPackage(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := packageOwnerFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := requiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageName, err := requiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pkg, resp, err := owner.getPackage(ctx, client, packageType, packageName)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("%s package %s not found", packageType, packageName)), nil
+				}
+				return nil, fmt.Errorf("failed to get package: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(pkg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListPackageVersions creates a tool to list the versions of a package.
+func ListPackageVersions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_package_versions",
+			mcp.WithDescription(t("TOOL_LIST_PACKAGE_VERSIONS_DESCRIPTION", "List the versions of a package owned by an organization or user, newest first")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PACKAGE_VERSIONS_USER_TITLE", "List package versions"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			withPackageOwner(),
+			withPackage(),
+			mcp.WithString("state",
+				mcp.Description("Only list versions in this state, defaults to active"),
+				mcp.Enum("active", "deleted"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := packageOwnerFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := requiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageName, err := requiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PackageListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if state != "" {
+				opts.State = github.Ptr(state)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			versions, resp, err := owner.listVersions(ctx, client, packageType, packageName, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list package versions: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list package versions: %s", string(body))), nil
+			}
+
+			summaries := make([]packageVersionSummary, 0, len(versions))
+			for _, version := range versions {
+				summary := packageVersionSummary{
+					ID:      version.GetID(),
+					Name:    version.GetName(),
+					HTMLURL: version.GetHTMLURL(),
+				}
+				if version.Metadata != nil && version.Metadata.Container != nil {
+					summary.Tags = version.Metadata.Container.Tags
+				}
+				if version.CreatedAt != nil {
+					summary.CreatedAt = &version.CreatedAt.Time
+				}
+				if version.UpdatedAt != nil {
+					summary.UpdatedAt = &version.UpdatedAt.Time
+				}
+				summaries = append(summaries, summary)
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeletePackageVersion creates a tool to delete a version of a package.
+func DeletePackageVersion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_package_version",
+			mcp.WithDescription(t("TOOL_DELETE_PACKAGE_VERSION_DESCRIPTION", "Delete a version of a package owned by an organization or user. Refuses to delete the latest or only version of the package unless force is set")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DELETE_PACKAGE_VERSION_USER_TITLE", "Delete package version"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			withPackageOwner(),
+			withPackage(),
+			mcp.WithNumber("version_id",
+				mcp.Required(),
+				mcp.Description("ID of the package version to delete"),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Delete the version even if it is the latest or only version of the package"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := packageOwnerFromRequest(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageType, err := requiredParam[string](request, "package_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageName, err := requiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			versionID, err := RequiredInt(request, "version_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			force, err := OptionalParam[bool](request, "force")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if !force {
+				// Versions are listed newest first, so the first two tell whether the version
+				// is the latest one and whether any other would remain
+				opts := &github.PackageListOptions{
+					State:       github.Ptr("active"),
+					ListOptions: github.ListOptions{PerPage: 2},
+				}
+				versions, resp, err := owner.listVersions(ctx, client, packageType, packageName, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list package versions: %w", err)
+				}
+				_ = resp.Body.Close()
+
+				if len(versions) > 0 && versions[0].GetID() == int64(versionID) {
+					which := "latest"
+					if len(versions) == 1 {
+						which = "only"
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("refusing to delete the %s version of %s package %s, set force to true to delete it anyway", which, packageType, packageName)), nil
+				}
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("delete version %d of %s package %s", versionID, packageType, packageName), nil
+			}
+			resp, err := owner.deleteVersion(ctx, client, packageType, packageName, int64(versionID))
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete package version: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete package version: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted version %d of %s package %s", versionID, packageType, packageName)), nil
+		}
+}
@@ -0,0 +1,486 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListPackages(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPackages(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_packages", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "user")
+	assert.Contains(t, tool.InputSchema.Properties, "package_type")
+	assert.Contains(t, tool.InputSchema.Properties, "visibility")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"package_type"})
+
+	mockPackages := []*github.Package{
+		{
+			ID:           github.Ptr(int64(1)),
+			Name:         github.Ptr("api"),
+			PackageType:  github.Ptr("container"),
+			Visibility:   github.Ptr("private"),
+			VersionCount: github.Ptr(int64(12)),
+			Repository:   &github.Repository{FullName: github.Ptr("octo-org/api")},
+			HTMLURL:      github.Ptr("https://github.com/orgs/octo-org/packages/container/package/api"),
+		},
+	}
+	expectedSummaries := []packageSummary{
+		{
+			ID:           1,
+			Name:         "api",
+			PackageType:  "container",
+			Visibility:   "private",
+			VersionCount: 12,
+			Repository:   "octo-org/api",
+			HTMLURL:      "https://github.com/orgs/octo-org/packages/container/package/api",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedPackages []packageSummary
+		expectedErrMsg   string
+	}{
+		{
+			name: "org packages filtered by type and visibility",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesByOrg,
+					expectQueryParams(t, map[string]string{
+						"package_type": "container",
+						"visibility":   "private",
+						"page":         "1",
+						"per_page":     "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPackages),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"visibility":   "private",
+			},
+			expectError:      false,
+			expectedPackages: expectedSummaries,
+		},
+		{
+			name: "user packages filtered by type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersPackagesByUsername,
+					expectQueryParams(t, map[string]string{
+						"package_type": "npm",
+						"page":         "2",
+						"per_page":     "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Package{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"user":         "octocat",
+				"package_type": "npm",
+				"page":         float64(2),
+				"perPage":      float64(10),
+			},
+			expectError:      false,
+			expectedPackages: []packageSummary{},
+		},
+		{
+			name: "authenticated user packages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserPackages,
+					mockPackages,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"package_type": "container",
+			},
+			expectError:      false,
+			expectedPackages: expectedSummaries,
+		},
+		{
+			name:         "both org and user",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"user":         "octocat",
+				"package_type": "npm",
+			},
+			expectError:    false,
+			expectedErrMsg: "only one of org and user can be provided",
+		},
+		{
+			name:         "missing package type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: package_type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPackages(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned []packageSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPackages, returned)
+		})
+	}
+}
+
+func Test_GetPackage(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPackage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_package", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "user")
+	assert.Contains(t, tool.InputSchema.Properties, "package_type")
+	assert.Contains(t, tool.InputSchema.Properties, "package_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"package_type", "package_name"})
+
+	mockPackage := &github.Package{
+		ID:           github.Ptr(int64(1)),
+		Name:         github.Ptr("api"),
+		PackageType:  github.Ptr("container"),
+		VersionCount: github.Ptr(int64(12)),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedPackage *github.Package
+		expectedErrMsg  string
+	}{
+		{
+			name: "org package",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsPackagesByOrgByPackageTypeByPackageName,
+					mockPackage,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"package_name": "api",
+			},
+			expectError:     false,
+			expectedPackage: mockPackage,
+		},
+		{
+			name: "package not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersPackagesByUsernameByPackageTypeByPackageName,
+					mockResponse(t, http.StatusNotFound, `{"message": "Package not found."}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"user":         "octocat",
+				"package_type": "npm",
+				"package_name": "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "npm package missing not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPackage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned github.Package
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedPackage.ID, *returned.ID)
+			assert.Equal(t, *tc.expectedPackage.Name, *returned.Name)
+			assert.Equal(t, *tc.expectedPackage.VersionCount, *returned.VersionCount)
+		})
+	}
+}
+
+func Test_ListPackageVersions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPackageVersions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_package_versions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "package_type")
+	assert.Contains(t, tool.InputSchema.Properties, "package_name")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"package_type", "package_name"})
+
+	mockVersions := []*github.PackageVersion{
+		{
+			ID:       github.Ptr(int64(20)),
+			Name:     github.Ptr("sha256:bbb"),
+			HTMLURL:  github.Ptr("https://github.com/orgs/octo-org/packages/container/api/20"),
+			Metadata: &github.PackageMetadata{Container: &github.PackageContainerMetadata{Tags: []string{"latest", "v2"}}},
+		},
+		{
+			ID:      github.Ptr(int64(10)),
+			Name:    github.Ptr("sha256:aaa"),
+			HTMLURL: github.Ptr("https://github.com/orgs/octo-org/packages/container/api/10"),
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsPackagesVersionsByOrgByPackageTypeByPackageName,
+			expectQueryParams(t, map[string]string{
+				"state":    "active",
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, mockVersions),
+			),
+		),
+	))
+	_, handler := ListPackageVersions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":          "octo-org",
+		"package_type": "container",
+		"package_name": "api",
+		"state":        "active",
+	}))
+	require.NoError(t, err)
+
+	var returned []packageVersionSummary
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+	require.NoError(t, err)
+	assert.Equal(t, []packageVersionSummary{
+		{ID: 20, Name: "sha256:bbb", Tags: []string{"latest", "v2"}, HTMLURL: "https://github.com/orgs/octo-org/packages/container/api/20"},
+		{ID: 10, Name: "sha256:aaa", HTMLURL: "https://github.com/orgs/octo-org/packages/container/api/10"},
+	}, returned)
+}
+
+func Test_DeletePackageVersion(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeletePackageVersion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_package_version", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "user")
+	assert.Contains(t, tool.InputSchema.Properties, "package_type")
+	assert.Contains(t, tool.InputSchema.Properties, "package_name")
+	assert.Contains(t, tool.InputSchema.Properties, "version_id")
+	assert.Contains(t, tool.InputSchema.Properties, "force")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"package_type", "package_name", "version_id"})
+
+	twoVersions := []*github.PackageVersion{
+		{ID: github.Ptr(int64(20))},
+		{ID: github.Ptr(int64(10))},
+	}
+	oneVersion := []*github.PackageVersion{
+		{ID: github.Ptr(int64(10))},
+	}
+	versionsEndpoint := func(versions []*github.PackageVersion) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetOrgsPackagesVersionsByOrgByPackageTypeByPackageName,
+			expectQueryParams(t, map[string]string{
+				"state":    "active",
+				"per_page": "2",
+			}).andThen(
+				mockResponse(t, http.StatusOK, versions),
+			),
+		)
+	}
+	deleteEndpoint := mock.WithRequestMatchHandler(
+		mock.DeleteOrgsPackagesVersionsByOrgByPackageTypeByPackageNameByPackageVersionId,
+		mockResponse(t, http.StatusNoContent, nil),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name:         "deletes an older version",
+			mockedClient: mock.NewMockedHTTPClient(versionsEndpoint(twoVersions), deleteEndpoint),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"package_name": "api",
+				"version_id":   float64(10),
+			},
+			expectError:  false,
+			expectedText: "Deleted version 10 of container package api",
+		},
+		{
+			name:         "refuses to delete the latest version",
+			mockedClient: mock.NewMockedHTTPClient(versionsEndpoint(twoVersions)),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"package_name": "api",
+				"version_id":   float64(20),
+			},
+			expectError:    false,
+			expectedErrMsg: "refusing to delete the latest version of container package api, set force to true to delete it anyway",
+		},
+		{
+			name:         "refuses to delete the only version",
+			mockedClient: mock.NewMockedHTTPClient(versionsEndpoint(oneVersion)),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"package_name": "api",
+				"version_id":   float64(10),
+			},
+			expectError:    false,
+			expectedErrMsg: "refusing to delete the only version of container package api, set force to true to delete it anyway",
+		},
+		{
+			name:         "force deletes the only version without checking",
+			mockedClient: mock.NewMockedHTTPClient(deleteEndpoint),
+			requestArgs: map[string]interface{}{
+				"org":          "octo-org",
+				"package_type": "container",
+				"package_name": "api",
+				"version_id":   float64(10),
+				"force":        true,
+			},
+			expectError:  false,
+			expectedText: "Deleted version 10 of container package api",
+		},
+		{
+			name: "user package version",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUserPackagesVersionsByPackageTypeByPackageName,
+					twoVersions,
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteUserPackagesVersionsByPackageTypeByPackageNameByPackageVersionId,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"package_type": "npm",
+				"package_name": "cli",
+				"version_id":   float64(10),
+			},
+			expectError:  false,
+			expectedText: "Deleted version 10 of npm package cli",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeletePackageVersion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
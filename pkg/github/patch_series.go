@@ -0,0 +1,175 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// defaultMaxPatchSeriesCommits caps the commits get_patch_series returns, since each one costs
+	// a request for its patch.
+	defaultMaxPatchSeriesCommits = 20
+	maxPatchSeriesCommits        = 100
+)
+
+// commitPatch is a commit of a patch series with its patch in the git format-patch format.
+type commitPatch struct {
+	SHA     string `json:"sha"`
+	Subject string `json:"subject"`
+	Patch   string `json:"patch"`
+}
+
+// patchSeries is the result of get_patch_series, the patches of the commits of a range oldest first.
+// When a cap is hit, the series stops at a whole commit, so that the patches returned still apply
+// in order.
+type patchSeries struct {
+	Base         string        `json:"base"`
+	Head         string        `json:"head"`
+	TotalCommits int           `json:"total_commits"`
+	Patches      []commitPatch `json:"patches"`
+	Truncated    bool          `json:"truncated,omitempty"`
+	Note         string        `json:"note,omitempty"`
+}
+
+// commitSubject returns the first line of a commit message.
+func commitSubject(message string) string {
+	subject, _, _ := strings.Cut(message, "\n")
+	return subject
+}
+
+// GetPatchSeries creates a tool to get the commits between two refs of a repository as a series of patches.
+func GetPatchSeries(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_patch_series",
+			mcp.WithDescription(t("TOOL_GET_PATCH_SERIES_DESCRIPTION", fmt.Sprintf("Get the commits between two branches, tags or commits of a GitHub repository as a series of patches in the git format-patch format, oldest first, to apply them commit by commit elsewhere. Unlike get_diff_between_refs, commit boundaries and messages are kept. The series stops at a whole commit once it holds max_commits commits or %d bytes of patches", maxTextResultBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PATCH_SERIES_USER_TITLE", "Get patch series"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA the series applies on"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA the series ends at"),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description(fmt.Sprintf("Maximum number of commits to return, oldest first (default %d, max %d)", defaultMaxPatchSeriesCommits, maxPatchSeriesCommits)),
+				mcp.Min(1),
+				mcp.Max(maxPatchSeriesCommits),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxCommits, err := OptionalIntParamWithDefault(request, "max_commits", defaultMaxPatchSeriesCommits)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxCommits < 1 || maxCommits > maxPatchSeriesCommits {
+				return mcp.NewToolResultError(fmt.Sprintf("max_commits must be between 1 and %d", maxPatchSeriesCommits)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, &github.ListOptions{PerPage: maxCommits})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot compare %s...%s in %s/%s, check that both refs exist", base, head, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to compare commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare commits: %s", string(body))), nil
+			}
+
+			series := patchSeries{
+				Base:         base,
+				Head:         head,
+				TotalCommits: comparison.GetTotalCommits(),
+				Patches:      []commitPatch{},
+			}
+			// stop ends the series after the last patch added
+			stop := func(reason string) {
+				last := series.Patches[len(series.Patches)-1].SHA
+				series.Truncated = true
+				series.Note = fmt.Sprintf("%s, call again with base %s for the rest of the series", reason, last)
+			}
+
+			// The comparison lists commits oldest first, its first page holds the first max_commits
+			size := 0
+			for i, commit := range comparison.Commits {
+				if i == maxCommits {
+					break
+				}
+				patch, resp, err := client.Repositories.GetCommitRaw(ctx, owner, repo, commit.GetSHA(), github.RawOptions{Type: github.Patch})
+				if err != nil {
+					return nil, fmt.Errorf("failed to get patch of commit %s: %w", commit.GetSHA(), err)
+				}
+				_ = resp.Body.Close()
+
+				if size+len(patch) > maxTextResultBytes {
+					if i == 0 {
+						return mcp.NewToolResultError(fmt.Sprintf("the patch of commit %s is larger than %d bytes, use get_commit to see its changes", commit.GetSHA(), maxTextResultBytes)), nil
+					}
+					stop(fmt.Sprintf("the patches reached %d bytes", maxTextResultBytes))
+					break
+				}
+				size += len(patch)
+				series.Patches = append(series.Patches, commitPatch{
+					SHA:     commit.GetSHA(),
+					Subject: commitSubject(commit.GetCommit().GetMessage()),
+					Patch:   patch,
+				})
+			}
+			if !series.Truncated && len(series.Patches) < series.TotalCommits {
+				stop("max_commits reached")
+			}
+
+			r, err := json.Marshal(series)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
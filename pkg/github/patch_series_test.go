@@ -0,0 +1,209 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPatchSeries(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPatchSeries(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_patch_series", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "max_commits")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockComparison := &github.CommitsComparison{
+		TotalCommits: github.Ptr(3),
+		Commits: []*github.RepositoryCommit{
+			{SHA: github.Ptr("aaa111"), Commit: &github.Commit{Message: github.Ptr("Add the parser\n\nIt parses.")}},
+			{SHA: github.Ptr("bbb222"), Commit: &github.Commit{Message: github.Ptr("Fix the parser")}},
+			{SHA: github.Ptr("ccc333"), Commit: &github.Commit{Message: github.Ptr("Document the parser")}},
+		},
+	}
+	// patches serves the patch of each commit, of the given size when set
+	patches := func(sizes map[string]int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Contains(t, r.Header.Get("Accept"), "patch")
+			sha := path.Base(r.URL.Path)
+			patch := fmt.Sprintf("From %s Mon Sep 17 00:00:00 2001\nSubject: [PATCH] %s\n", sha, sha)
+			if size, ok := sizes[sha]; ok {
+				patch += strings.Repeat("+", size-len(patch))
+			}
+			_, _ = w.Write([]byte(patch))
+		}
+	}
+	patchOf := func(sha string) string {
+		return fmt.Sprintf("From %s Mon Sep 17 00:00:00 2001\nSubject: [PATCH] %s\n", sha, sha)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedSHAs   []string
+		expectedResult *patchSeries
+		expectedErrMsg string
+	}{
+		{
+			name: "every commit of the range as a patch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, mockComparison),
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, patches(nil)),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedResult: &patchSeries{
+				Base:         "main",
+				Head:         "feature",
+				TotalCommits: 3,
+				Patches: []commitPatch{
+					{SHA: "aaa111", Subject: "Add the parser", Patch: patchOf("aaa111")},
+					{SHA: "bbb222", Subject: "Fix the parser", Patch: patchOf("bbb222")},
+					{SHA: "ccc333", Subject: "Document the parser", Patch: patchOf("ccc333")},
+				},
+			},
+		},
+		{
+			name: "stops at max_commits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					// Only the commits of the series are requested
+					expectQueryParams(t, map[string]string{"per_page": "2"}).andThen(
+						mockResponse(t, http.StatusOK, &github.CommitsComparison{
+							TotalCommits: mockComparison.TotalCommits,
+							Commits:      mockComparison.Commits[:2],
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, patches(nil)),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base":        "main",
+				"head":        "feature",
+				"max_commits": float64(2),
+			},
+			expectedResult: &patchSeries{
+				Base:         "main",
+				Head:         "feature",
+				TotalCommits: 3,
+				Patches: []commitPatch{
+					{SHA: "aaa111", Subject: "Add the parser", Patch: patchOf("aaa111")},
+					{SHA: "bbb222", Subject: "Fix the parser", Patch: patchOf("bbb222")},
+				},
+				Truncated: true,
+				Note:      "max_commits reached, call again with base bbb222 for the rest of the series",
+			},
+		},
+		{
+			name: "stops at a whole commit once the patches reach the size cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, mockComparison),
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, patches(map[string]int{
+					"aaa111": maxTextResultBytes / 2,
+					"bbb222": maxTextResultBytes / 2,
+					"ccc333": 1024,
+				})),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedSHAs: []string{"aaa111", "bbb222"},
+			expectedResult: &patchSeries{
+				Truncated: true,
+				Note:      fmt.Sprintf("the patches reached %d bytes, call again with base bbb222 for the rest of the series", maxTextResultBytes),
+			},
+		},
+		{
+			name: "first patch over the size cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposCompareByOwnerByRepoByBasehead, mockComparison),
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, patches(map[string]int{
+					"aaa111": maxTextResultBytes + 1,
+				})),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectError:    true,
+			expectedErrMsg: fmt.Sprintf("the patch of commit aaa111 is larger than %d bytes, use get_commit to see its changes", maxTextResultBytes),
+		},
+		{
+			name:         "max_commits out of range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"base":        "main",
+				"head":        "feature",
+				"max_commits": float64(500),
+			},
+			expectError:    true,
+			expectedErrMsg: fmt.Sprintf("max_commits must be between 1 and %d", maxPatchSeriesCommits),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPatchSeries(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned patchSeries
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			if tc.expectedSHAs == nil {
+				assert.Equal(t, *tc.expectedResult, returned)
+				return
+			}
+			// The patches are too large to compare, only check which commits made it
+			shas := make([]string, 0, len(returned.Patches))
+			for _, patch := range returned.Patches {
+				shas = append(shas, patch.SHA)
+			}
+			assert.Equal(t, tc.expectedSHAs, shas)
+			assert.Equal(t, tc.expectedResult.Truncated, returned.Truncated)
+			assert.Equal(t, tc.expectedResult.Note, returned.Note)
+		})
+	}
+}
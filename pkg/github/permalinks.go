@@ -0,0 +1,179 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// defaultPermalinkContext is the number of lines expand_permalink returns around the cited ones by default.
+	defaultPermalinkContext = 3
+	maxPermalinkContext     = 50
+)
+
+// lineAnchorPattern matches the line anchor of a blob URL, e.g. L10 or L10-L20. Anchors made by
+// selecting text in the GitHub UI may carry columns, e.g. L10C5-L20C8, which are ignored.
+var lineAnchorPattern = regexp.MustCompile(`^L(\d+)(?:C\d+)?(?:-L?(\d+)(?:C\d+)?)?$`)
+
+// blobPermalink is a link to lines of a file at a ref, i.e.
+// https://github.com/<owner>/<repo>/blob/<ref>/<path>#L<start>-L<end>.
+type blobPermalink struct {
+	Owner     string
+	Repo      string
+	Ref       string
+	Path      string
+	StartLine int
+	EndLine   int
+}
+
+// parseBlobPermalink parses a link to lines of a file. A single line anchor gives the same start
+// and end line. The ref is the path segment after blob, so a branch name with a slash is not
+// supported, which permalinks made with the commit SHA never have.
+func parseBlobPermalink(raw string) (blobPermalink, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return blobPermalink{}, fmt.Errorf("invalid permalink %q, expected https://github.com/<owner>/<repo>/blob/<sha>/<path>#L<start>-L<end>", raw)
+	}
+	segments := strings.SplitN(strings.Trim(u.Path, "/"), "/", 5)
+	if len(segments) < 5 || segments[2] != "blob" || segments[3] == "" || segments[4] == "" {
+		return blobPermalink{}, fmt.Errorf("%q is not a link to a file, expected https://github.com/<owner>/<repo>/blob/<sha>/<path>#L<start>-L<end>", raw)
+	}
+	link := blobPermalink{
+		Owner: segments[0],
+		Repo:  segments[1],
+		Ref:   segments[3],
+		Path:  strings.TrimSuffix(segments[4], "/"),
+	}
+
+	if u.Fragment == "" {
+		return blobPermalink{}, fmt.Errorf("%q does not point at lines, add an anchor such as #L10 or #L10-L20", raw)
+	}
+	match := lineAnchorPattern.FindStringSubmatch(u.Fragment)
+	if match == nil {
+		return blobPermalink{}, fmt.Errorf("invalid line anchor #%s, expected #L10 or #L10-L20", u.Fragment)
+	}
+	link.StartLine, _ = strconv.Atoi(match[1])
+	link.EndLine = link.StartLine
+	if match[2] != "" {
+		link.EndLine, _ = strconv.Atoi(match[2])
+	}
+	if link.StartLine < 1 || link.EndLine < link.StartLine {
+		return blobPermalink{}, fmt.Errorf("invalid line range #%s", u.Fragment)
+	}
+	return link, nil
+}
+
+// permalinkExcerpt is the result of expand_permalink. Content holds the lines FromLine to ToLine of
+// the file, the cited lines StartLine to EndLine with their context.
+type permalinkExcerpt struct {
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	Ref        string `json:"ref"`
+	StartLine  int    `json:"start_line"`
+	EndLine    int    `json:"end_line"`
+	FromLine   int    `json:"from_line"`
+	ToLine     int    `json:"to_line"`
+	Content    string `json:"content"`
+}
+
+// excerptLines returns the lines start to end of content with up to contextLines lines around
+// them, and the numbers of the first and last line returned. An end past the end of the file is
+// clamped to the last line.
+func excerptLines(content string, start, end, contextLines int) (string, int, int, error) {
+	lines := strings.Split(content, "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if start > len(lines) {
+		return "", 0, 0, fmt.Errorf("line %d does not exist, the file has %d lines", start, len(lines))
+	}
+	end = min(end, len(lines))
+	from := max(start-contextLines, 1)
+	to := min(end+contextLines, len(lines))
+	return strings.Join(lines[from-1:to], "\n"), from, to, nil
+}
+
+// ExpandPermalink creates a tool to get the lines a GitHub blob permalink points at.
+func ExpandPermalink(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("expand_permalink",
+			mcp.WithDescription(t("TOOL_EXPAND_PERMALINK_DESCRIPTION", "Get the lines of code a GitHub permalink points at, e.g. https://github.com/owner/repo/blob/<sha>/main.go#L10-L20 cited in an issue or a review, with a few lines of context around them. The file is read at the ref of the link, so the lines are the ones cited even if the file changed since")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_EXPAND_PERMALINK_USER_TITLE", "Expand permalink"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("url",
+				mcp.Required(),
+				mcp.Description("Link to lines of a file, with a #L10 or #L10-L20 anchor"),
+			),
+			mcp.WithNumber("context",
+				mcp.Description(fmt.Sprintf("Number of lines to return before and after the cited lines (default %d, max %d)", defaultPermalinkContext, maxPermalinkContext)),
+				mcp.Min(0),
+				mcp.Max(maxPermalinkContext),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			rawURL, err := requiredParam[string](request, "url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			link, err := parseBlobPermalink(rawURL)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// 0 is a valid context, which OptionalIntParamWithDefault would replace with the default
+			contextLines := defaultPermalinkContext
+			if value, ok, err := OptionalParamOK[float64](request, "context"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				contextLines = int(value)
+			}
+			if contextLines < 0 || contextLines > maxPermalinkContext {
+				return mcp.NewToolResultError(fmt.Sprintf("context must be between 0 and %d", maxPermalinkContext)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			file, result, err := getRefFile(ctx, client, link.Owner, link.Repo, link.Path, link.Ref)
+			if result != nil || err != nil {
+				return result, err
+			}
+			if !file.exists {
+				return mcp.NewToolResultError(fmt.Sprintf("%s not found at %s in %s/%s", link.Path, link.Ref, link.Owner, link.Repo)), nil
+			}
+			if isBinary([]byte(file.content)) {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is a binary file, it has no lines to cite", link.Path)), nil
+			}
+
+			content, from, to, err := excerptLines(file.content, link.StartLine, link.EndLine, contextLines)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("cannot expand %s: %s", rawURL, err)), nil
+			}
+
+			r, err := json.Marshal(permalinkExcerpt{
+				Repository: link.Owner + "/" + link.Repo,
+				Path:       link.Path,
+				Ref:        link.Ref,
+				StartLine:  link.StartLine,
+				EndLine:    min(link.EndLine, to),
+				FromLine:   from,
+				ToLine:     to,
+				Content:    content,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
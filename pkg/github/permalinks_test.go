@@ -0,0 +1,224 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseBlobPermalink(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		expected       blobPermalink
+		expectedErrMsg string
+	}{
+		{
+			name:     "line range",
+			url:      "https://github.com/owner/repo/blob/0123abc/pkg/main.go#L10-L20",
+			expected: blobPermalink{Owner: "owner", Repo: "repo", Ref: "0123abc", Path: "pkg/main.go", StartLine: 10, EndLine: 20},
+		},
+		{
+			name:     "single line",
+			url:      "https://github.com/owner/repo/blob/0123abc/README.md#L7",
+			expected: blobPermalink{Owner: "owner", Repo: "repo", Ref: "0123abc", Path: "README.md", StartLine: 7, EndLine: 7},
+		},
+		{
+			name:     "range with columns",
+			url:      "https://github.com/owner/repo/blob/main/a.go#L3C5-L4C9",
+			expected: blobPermalink{Owner: "owner", Repo: "repo", Ref: "main", Path: "a.go", StartLine: 3, EndLine: 4},
+		},
+		{
+			name:     "escaped path",
+			url:      "https://github.com/owner/repo/blob/0123abc/docs/my%20notes.md#L1-2",
+			expected: blobPermalink{Owner: "owner", Repo: "repo", Ref: "0123abc", Path: "docs/my notes.md", StartLine: 1, EndLine: 2},
+		},
+		{
+			name:           "no anchor",
+			url:            "https://github.com/owner/repo/blob/0123abc/main.go",
+			expectedErrMsg: `"https://github.com/owner/repo/blob/0123abc/main.go" does not point at lines, add an anchor such as #L10 or #L10-L20`,
+		},
+		{
+			name:           "not a blob",
+			url:            "https://github.com/owner/repo/tree/main/pkg#L1",
+			expectedErrMsg: `"https://github.com/owner/repo/tree/main/pkg#L1" is not a link to a file, expected https://github.com/<owner>/<repo>/blob/<sha>/<path>#L<start>-L<end>`,
+		},
+		{
+			name:           "reversed range",
+			url:            "https://github.com/owner/repo/blob/0123abc/main.go#L20-L10",
+			expectedErrMsg: "invalid line range #L20-L10",
+		},
+		{
+			name:           "invalid anchor",
+			url:            "https://github.com/owner/repo/blob/0123abc/main.go#readme",
+			expectedErrMsg: "invalid line anchor #readme, expected #L10 or #L10-L20",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			link, err := parseBlobPermalink(tc.url)
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, link)
+		})
+	}
+}
+
+func Test_ExcerptLines(t *testing.T) {
+	content := "one\ntwo\nthree\nfour\nfive\nsix\nseven\n"
+
+	tests := []struct {
+		name           string
+		start, end     int
+		context        int
+		expected       string
+		expectedFrom   int
+		expectedTo     int
+		expectedErrMsg string
+	}{
+		{name: "single line with context", start: 4, end: 4, context: 1, expected: "three\nfour\nfive", expectedFrom: 3, expectedTo: 5},
+		{name: "range with context", start: 3, end: 4, context: 2, expected: "one\ntwo\nthree\nfour\nfive\nsix", expectedFrom: 1, expectedTo: 6},
+		{name: "context clamped to the file", start: 1, end: 7, context: 3, expected: "one\ntwo\nthree\nfour\nfive\nsix\nseven", expectedFrom: 1, expectedTo: 7},
+		{name: "without context", start: 2, end: 2, context: 0, expected: "two", expectedFrom: 2, expectedTo: 2},
+		{name: "end past the file", start: 6, end: 12, context: 0, expected: "six\nseven", expectedFrom: 6, expectedTo: 7},
+		{name: "start past the file", start: 8, end: 8, expectedErrMsg: "line 8 does not exist, the file has 7 lines"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			excerpt, from, to, err := excerptLines(content, tc.start, tc.end, tc.context)
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, excerpt)
+			assert.Equal(t, tc.expectedFrom, from)
+			assert.Equal(t, tc.expectedTo, to)
+		})
+	}
+}
+
+func Test_ExpandPermalink(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ExpandPermalink(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "expand_permalink", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "url")
+	assert.Contains(t, tool.InputSchema.Properties, "context")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"url"})
+
+	source := "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n"
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult permalinkExcerpt
+		expectedErrMsg string
+	}{
+		{
+			name: "range with default context",
+			requestArgs: map[string]interface{}{
+				"url": "https://github.com/owner/repo/blob/0123abc/main.go#L5-L6",
+			},
+			expectedResult: permalinkExcerpt{
+				Repository: "owner/repo",
+				Path:       "main.go",
+				Ref:        "0123abc",
+				StartLine:  5,
+				EndLine:    6,
+				FromLine:   2,
+				ToLine:     7,
+				Content:    "\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hi\")\n}",
+			},
+		},
+		{
+			name: "single line without context",
+			requestArgs: map[string]interface{}{
+				"url":     "https://github.com/owner/repo/blob/0123abc/main.go#L3",
+				"context": float64(0),
+			},
+			expectedResult: permalinkExcerpt{
+				Repository: "owner/repo",
+				Path:       "main.go",
+				Ref:        "0123abc",
+				StartLine:  3,
+				EndLine:    3,
+				FromLine:   3,
+				ToLine:     3,
+				Content:    "import \"fmt\"",
+			},
+		},
+		{
+			name: "file missing at the ref",
+			requestArgs: map[string]interface{}{
+				"url": "https://github.com/owner/repo/blob/0123abc/gone.go#L1",
+			},
+			expectError:    true,
+			expectedErrMsg: "gone.go not found at 0123abc in owner/repo",
+		},
+		{
+			name: "line past the end of the file",
+			requestArgs: map[string]interface{}{
+				"url": "https://github.com/owner/repo/blob/0123abc/main.go#L40",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot expand https://github.com/owner/repo/blob/0123abc/main.go#L40: line 40 does not exist, the file has 7 lines",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "0123abc", r.URL.Query().Get("ref"))
+						if r.URL.Path != "/repos/owner/repo/contents/main.go" {
+							mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type:     github.Ptr("file"),
+							Path:     github.Ptr("main.go"),
+							Encoding: github.Ptr("base64"),
+							Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(source))),
+						})(w, r)
+					}),
+				),
+			))
+			_, handler := ExpandPermalink(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned permalinkExcerpt
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
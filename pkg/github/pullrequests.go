@@ -3,9 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -13,13 +16,74 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// pullRequestDetails is a pull request, optionally with its reaction counts.
+type pullRequestDetails struct {
+	*github.PullRequest
+	Reactions *reactionCounts `json:"reactions,omitempty"`
+}
+
+// pullRequestCompact is the default view of get_pull_request, with the fields needed to review and
+// merge a pull request rather than the whole API object.
+type pullRequestCompact struct {
+	Number             int             `json:"number"`
+	Title              string          `json:"title"`
+	State              string          `json:"state"`
+	Draft              bool            `json:"draft"`
+	Author             string          `json:"author"`
+	Base               string          `json:"base"`
+	Head               string          `json:"head"`
+	HeadSHA            string          `json:"head_sha"`
+	Mergeable          *bool           `json:"mergeable"`
+	MergeableState     string          `json:"mergeable_state"`
+	Additions          int             `json:"additions"`
+	Deletions          int             `json:"deletions"`
+	ChangedFiles       int             `json:"changed_files"`
+	Labels             []string        `json:"labels"`
+	RequestedReviewers []string        `json:"requested_reviewers"`
+	RequestedTeams     []string        `json:"requested_teams"`
+	HTMLURL            string          `json:"html_url"`
+	Reactions          *reactionCounts `json:"reactions,omitempty"`
+}
+
+// compactPullRequest projects a pull request onto its compact view.
+func compactPullRequest(pr *github.PullRequest) pullRequestCompact {
+	summary := summarizePullRequest(pr)
+	reviewers := make([]string, 0, len(pr.RequestedReviewers))
+	for _, reviewer := range pr.RequestedReviewers {
+		reviewers = append(reviewers, reviewer.GetLogin())
+	}
+	teams := make([]string, 0, len(pr.RequestedTeams))
+	for _, team := range pr.RequestedTeams {
+		teams = append(teams, team.GetSlug())
+	}
+	return pullRequestCompact{
+		Number:             summary.Number,
+		Title:              summary.Title,
+		State:              summary.State,
+		Draft:              summary.Draft,
+		Author:             summary.Author,
+		Base:               summary.Base,
+		Head:               summary.Head,
+		HeadSHA:            pr.GetHead().GetSHA(),
+		Mergeable:          pr.Mergeable,
+		MergeableState:     pr.GetMergeableState(),
+		Additions:          pr.GetAdditions(),
+		Deletions:          pr.GetDeletions(),
+		ChangedFiles:       pr.GetChangedFiles(),
+		Labels:             summary.Labels,
+		RequestedReviewers: reviewers,
+		RequestedTeams:     teams,
+		HTMLURL:            summary.HTMLURL,
+	}
+}
+
 // GetPullRequest creates a tool to get details of a specific pull request.
 func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DESCRIPTION", "Get details of a specific pull request in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DESCRIPTION", "Get details of a specific pull request in a GitHub repository. Returns the number, title, state, draft flag, author, base and head branches, mergeability, size, labels and requested reviewers, set full to true to get the whole pull request including its body.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_USER_TITLE", "Get pull request details"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -33,6 +97,15 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			mcp.WithBoolean("include_reactions",
+				mcp.Description("Include the reaction counts of the pull request, such as +1 and heart"),
+			),
+			mcp.WithBoolean("full",
+				mcp.Description("Return the whole pull request instead of the compact view"),
+			),
+			mcp.WithBoolean("wait_for_mergeable",
+				mcp.Description("Wait a few seconds for GitHub to compute the mergeability of an open pull request when it is not known yet"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -47,6 +120,18 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeReactions, err := OptionalParam[bool](request, "include_reactions")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			full, err := OptionalParam[bool](request, "full")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			wait, err := OptionalParam[bool](request, "wait_for_mergeable")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -66,7 +151,34 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(pr)
+			if wait {
+				pr, err = waitForMergeable(ctx, client, owner, repo, pr)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			var reactions *reactionCounts
+			if includeReactions {
+				// Pull request objects have no reactions, they are on the issue backing the pull request
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, pullNumber)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get pull request reactions: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+				reactions = summarizeReactions(issue.Reactions)
+			}
+
+			var details any
+			if full {
+				details = pullRequestDetails{PullRequest: pr, Reactions: reactions}
+			} else {
+				compact := compactPullRequest(pr)
+				compact.Reactions = reactions
+				details = compact
+			}
+
+			r, err := json.Marshal(details)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -78,10 +190,10 @@ func GetPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc)
 // UpdatePullRequest creates a tool to update an existing pull request.
 func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("update_pull_request",
-			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_DESCRIPTION", "Update an existing pull request in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_DESCRIPTION", "Update an existing pull request in a GitHub repository, changing only the fields given, e.g. to retarget it to another base branch")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_UPDATE_PULL_REQUEST_USER_TITLE", "Edit pull request"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -147,13 +259,18 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			if state, ok, err := OptionalParamOK[string](request, "state"); err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			} else if ok {
+				if state != "open" && state != "closed" {
+					return mcp.NewToolResultError(fmt.Sprintf("state must be open or closed, got %s", state)), nil
+				}
 				update.State = github.Ptr(state)
 				updateNeeded = true
 			}
 
-			if base, ok, err := OptionalParamOK[string](request, "base"); err != nil {
+			base, baseOK, err := OptionalParamOK[string](request, "base")
+			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
-			} else if ok {
+			}
+			if baseOK {
 				update.Base = &github.PullRequestBranch{Ref: github.Ptr(base)}
 				updateNeeded = true
 			}
@@ -173,8 +290,16 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("update pull request #%d in %s/%s", pullNumber, owner, repo), nil
+			}
 			pr, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, update)
 			if err != nil {
+				// A base branch that does not exist, or that the head cannot be compared to, is rejected
+				var errResp *github.ErrorResponse
+				if baseOK && resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot change the base of pull request #%d in %s/%s to %s, check that the branch exists: %s", pullNumber, owner, repo, base, describeErrorResponse(errResp))), nil
+				}
 				return nil, fmt.Errorf("failed to update pull request: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -196,13 +321,50 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// pullRequestSummary is the compact representation of a pull request returned by list_pull_requests.
+type pullRequestSummary struct {
+	Number  int      `json:"number"`
+	Title   string   `json:"title"`
+	State   string   `json:"state"`
+	Author  string   `json:"author"`
+	Draft   bool     `json:"draft"`
+	Base    string   `json:"base"`
+	Head    string   `json:"head"`
+	Labels  []string `json:"labels"`
+	HTMLURL string   `json:"html_url"`
+}
+
+// summarizePullRequest projects a pull request onto the fields needed to triage it.
+func summarizePullRequest(pr *github.PullRequest) pullRequestSummary {
+	labels := make([]string, 0, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels = append(labels, label.GetName())
+	}
+	// The head label is "user:branch", which identifies branches of forks unambiguously
+	head := pr.GetHead().GetLabel()
+	if head == "" {
+		head = pr.GetHead().GetRef()
+	}
+	return pullRequestSummary{
+		Number:  pr.GetNumber(),
+		Title:   pr.GetTitle(),
+		State:   pr.GetState(),
+		Author:  pr.GetUser().GetLogin(),
+		Draft:   pr.GetDraft(),
+		Base:    pr.GetBase().GetRef(),
+		Head:    head,
+		Labels:  labels,
+		HTMLURL: pr.GetHTMLURL(),
+	}
+}
+
 // ListPullRequests creates a tool to list and filter repository pull requests.
 func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_pull_requests",
-			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_DESCRIPTION", "List pull requests in a GitHub repository.")),
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_DESCRIPTION", "List pull requests in a GitHub repository. Returns the number, title, state, author, draft flag, base and head branches and labels of each pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_PULL_REQUESTS_USER_TITLE", "List pull requests"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -217,7 +379,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Enum("open", "closed", "all"),
 			),
 			mcp.WithString("head",
-				mcp.Description("Filter by head user/org and branch"),
+				mcp.Description("Filter by head user/org and branch, in 'user:branch' form"),
 			),
 			mcp.WithString("base",
 				mcp.Description("Filter by base branch"),
@@ -296,7 +458,90 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(prs)
+			summaries := make([]pullRequestSummary, 0, len(prs))
+			for _, pr := range prs {
+				summaries = append(summaries, summarizePullRequest(pr))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListPullRequestsForCommit creates a tool to list the pull requests that contain a commit.
+func ListPullRequestsForCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_commit_pull_requests",
+			mcp.WithDescription(t("TOOL_LIST_COMMIT_PULL_REQUESTS_DESCRIPTION", "List the pull requests of a GitHub repository that contain a commit, e.g. to find the pull request that introduced it. Merged pull requests are listed along with open ones.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_COMMIT_PULL_REQUESTS_USER_TITLE", "List pull requests containing a commit"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("commit %s not found in %s/%s", sha, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to list pull requests for commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests for commit: %s", string(body))), nil
+			}
+
+			summaries := make([]pullRequestSummary, 0, len(prs))
+			for _, pr := range prs {
+				summaries = append(summaries, summarizePullRequest(pr))
+			}
+
+			r, err := json.Marshal(summaries)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -311,7 +556,7 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			mcp.WithDescription(t("TOOL_MERGE_PULL_REQUEST_DESCRIPTION", "Merge a pull request in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_MERGE_PULL_REQUEST_USER_TITLE", "Merge pull request"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -371,6 +616,9 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("merge pull request #%d in %s/%s", pullNumber, owner, repo), nil
+			}
 			result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pullNumber, commitMessage, options)
 			if err != nil {
 				return nil, fmt.Errorf("failed to merge pull request: %w", err)
@@ -400,7 +648,7 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the files changed in a specific pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_FILES_USER_TITLE", "Get pull request files"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -457,13 +705,120 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 		}
 }
 
-// GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
+// mergeablePollInterval and mergeablePollTimeout bound how long get_pull_request_status and get_pull_request
+// wait for GitHub to compute the mergeability of a pull request, which happens asynchronously after it is
+// first requested.
+var (
+	mergeablePollInterval = 500 * time.Millisecond
+	mergeablePollTimeout  = 10 * time.Second
+)
+
+// pullRequestStatus summarizes whether a pull request is ready to be merged.
+type pullRequestStatus struct {
+	Mergeable       *bool                `json:"mergeable"`
+	MergeableState  string               `json:"mergeable_state"`
+	Rebaseable      *bool                `json:"rebaseable"`
+	ReviewDecision  string               `json:"review_decision"`
+	State           string               `json:"state"`
+	TotalCount      int                  `json:"total_count"`
+	Statuses        []*github.RepoStatus `json:"statuses"`
+	CheckRuns       []*github.CheckRun   `json:"check_runs"`
+	FailingContexts []string             `json:"failing_contexts"`
+}
+
+// waitForMergeable re-fetches an open pull request with a doubling backoff until GitHub has computed
+// its mergeability or mergeablePollTimeout elapses, returning the latest pull request either way.
+func waitForMergeable(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) (*github.PullRequest, error) {
+	deadline := time.Now().Add(mergeablePollTimeout)
+	interval := mergeablePollInterval
+	for pr.Mergeable == nil && pr.GetState() == "open" && time.Now().Add(interval).Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+
+		refreshed, resp, err := client.PullRequests.Get(ctx, owner, repo, pr.GetNumber())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", err)
+		}
+		_ = resp.Body.Close()
+		pr = refreshed
+	}
+	return pr, nil
+}
+
+// listAllReviews fetches every review of a pull request, oldest first, returning the response of the
+// failed page on error.
+func listAllReviews(ctx context.Context, client *github.Client, owner, repo string, number int) ([]*github.PullRequestReview, *github.Response, error) {
+	var all []*github.PullRequestReview
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, reviews...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listAllCheckRuns lists the check runs of a ref, reading every page.
+func listAllCheckRuns(ctx context.Context, client *github.Client, owner, repo, ref string) ([]*github.CheckRun, *github.Response, error) {
+	var all []*github.CheckRun
+	opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, result.CheckRuns...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// reviewDecision derives the review decision of a pull request from the latest review of each reviewer,
+// approximating the GraphQL reviewDecision field which has no REST equivalent.
+func reviewDecision(pr *github.PullRequest, reviews []*github.PullRequestReview) string {
+	latest := make(map[string]string)
+	for _, review := range reviews {
+		switch review.GetState() {
+		case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+			latest[review.GetUser().GetLogin()] = review.GetState()
+		}
+	}
+
+	approved := false
+	for _, state := range latest {
+		if state == "CHANGES_REQUESTED" {
+			return "CHANGES_REQUESTED"
+		}
+		if state == "APPROVED" {
+			approved = true
+		}
+	}
+	if approved && len(pr.RequestedReviewers) == 0 && len(pr.RequestedTeams) == 0 {
+		return "APPROVED"
+	}
+	return "REVIEW_REQUIRED"
+}
+
+// GetPullRequestStatus creates a tool to get the merge readiness and combined status of a pull request.
 func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_status",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the status of a specific pull request.")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the status of a specific pull request: whether it can be merged, the review decision, its status checks and check runs, and which of them are failing.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_STATUS_USER_TITLE", "Get pull request status checks"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -510,6 +865,12 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
 
+			// GitHub returns a null mergeable until it has computed it in the background
+			pr, err = waitForMergeable(ctx, client, owner, repo, pr)
+			if err != nil {
+				return nil, err
+			}
+
 			// Get combined status for the head SHA
 			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, *pr.Head.SHA, nil)
 			if err != nil {
@@ -525,7 +886,45 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(status)
+			// Get check runs for the head SHA, which are not part of the combined status. A failure
+			// can be on any page.
+			checkRuns, _, err := listAllCheckRuns(ctx, client, owner, repo, pr.GetHead().GetSHA())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get check runs: %w", err)
+			}
+
+			// The decision depends on the latest review of each reviewer, which can be on any page
+			reviews, _, err := listAllReviews(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
+			}
+
+			failingContexts := []string{}
+			for _, s := range status.Statuses {
+				if s.GetState() == "failure" || s.GetState() == "error" {
+					failingContexts = append(failingContexts, s.GetContext())
+				}
+			}
+			for _, run := range checkRuns {
+				switch run.GetConclusion() {
+				case "failure", "timed_out", "cancelled", "action_required", "startup_failure":
+					failingContexts = append(failingContexts, run.GetName())
+				}
+			}
+
+			result := pullRequestStatus{
+				Mergeable:       pr.Mergeable,
+				MergeableState:  pr.GetMergeableState(),
+				Rebaseable:      pr.Rebaseable,
+				ReviewDecision:  reviewDecision(pr, reviews),
+				State:           status.GetState(),
+				TotalCount:      status.GetTotalCount(),
+				Statuses:        status.Statuses,
+				CheckRuns:       checkRuns,
+				FailingContexts: failingContexts,
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -540,7 +939,7 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_UPDATE_PULL_REQUEST_BRANCH_USER_TITLE", "Update pull request branch"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -584,6 +983,9 @@ func UpdatePullRequestBranch(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("update the branch of pull request #%d in %s/%s with its base branch", pullNumber, owner, repo), nil
+			}
 			result, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
@@ -618,7 +1020,7 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_COMMENTS_DESCRIPTION", "Get comments for a specific pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_COMMENTS_USER_TITLE", "Get pull request comments"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -686,7 +1088,7 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 			mcp.WithDescription(t("TOOL_ADD_PULL_REQUEST_REVIEW_COMMENT_DESCRIPTION", "Add a review comment to a pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_ADD_PULL_REQUEST_REVIEW_COMMENT_USER_TITLE", "Add review comment to pull request"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -756,9 +1158,12 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 			}
 
 			// Check if this is a reply to an existing comment
-			if replyToFloat, ok := request.Params.Arguments["in_reply_to"].(float64); ok {
+			if replyToFloat, ok := request.GetArguments()["in_reply_to"].(float64); ok {
 				// Use the specialized method for reply comments due to inconsistency in underlying go-github library: https://github.com/google/go-github/pull/950
 				commentID := int64(replyToFloat)
+				if isDryRun(ctx) {
+					return dryRunResult("reply to review comment %d on pull request #%d in %s/%s", commentID, pullNumber, owner, repo), nil
+				}
 				createdReply, resp, err := client.PullRequests.CreateCommentInReplyTo(ctx, owner, repo, pullNumber, body, commentID)
 				if err != nil {
 					return nil, fmt.Errorf("failed to reply to pull request comment: %w", err)
@@ -803,10 +1208,10 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 			if subjectType != "file" {
-				line, lineExists := request.Params.Arguments["line"].(float64)
-				startLine, startLineExists := request.Params.Arguments["start_line"].(float64)
-				side, sideExists := request.Params.Arguments["side"].(string)
-				startSide, startSideExists := request.Params.Arguments["start_side"].(string)
+				line, lineExists := request.GetArguments()["line"].(float64)
+				startLine, startLineExists := request.GetArguments()["start_line"].(float64)
+				side, sideExists := request.GetArguments()["side"].(string)
+				startSide, startSideExists := request.GetArguments()["start_side"].(string)
 
 				if !lineExists {
 					return mcp.NewToolResultError("line parameter is required unless using subject_type:file"), nil
@@ -831,6 +1236,9 @@ func AddPullRequestReviewComment(getClient GetClientFn, t translations.Translati
 				}
 			}
 
+			if isDryRun(ctx) {
+				return dryRunResult("comment on %s in pull request #%d of %s/%s", path, pullNumber, owner, repo), nil
+			}
 			createdComment, resp, err := client.PullRequests.CreateComment(ctx, owner, repo, pullNumber, comment)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create pull request comment: %w", err)
@@ -860,7 +1268,7 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEWS_DESCRIPTION", "Get reviews for a specific pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_PULL_REQUEST_REVIEWS_USER_TITLE", "Get pull request reviews"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -922,7 +1330,7 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_REVIEW_DESCRIPTION", "Create a review for a pull request.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_PULL_REQUEST_REVIEW_USER_TITLE", "Submit pull request review"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -1045,7 +1453,7 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 			}
 
 			// Add comments if provided
-			if commentsObj, ok := request.Params.Arguments["comments"].([]interface{}); ok && len(commentsObj) > 0 {
+			if commentsObj, ok := request.GetArguments()["comments"].([]interface{}); ok && len(commentsObj) > 0 {
 				comments := []*github.DraftReviewComment{}
 
 				for _, c := range commentsObj {
@@ -1109,6 +1517,9 @@ func CreatePullRequestReview(getClient GetClientFn, t translations.TranslationHe
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("submit a %s review on pull request #%d in %s/%s", event, pullNumber, owner, repo), nil
+			}
 			review, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, reviewRequest)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create pull request review: %w", err)
@@ -1138,7 +1549,7 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_DESCRIPTION", "Create a new pull request in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_CREATE_PULL_REQUEST_USER_TITLE", "Open new pull request"),
-				ReadOnlyHint: false,
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -1224,6 +1635,9 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("open pull request %q from %s into %s in %s/%s", title, head, base, owner, repo), nil
+			}
 			pr, resp, err := client.PullRequests.Create(ctx, owner, repo, newPR)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create pull request: %w", err)
@@ -1246,3 +1660,375 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// CreatePullRequestFromIssue creates a tool to turn an existing issue into a pull request.
+func CreatePullRequestFromIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pull_request_from_issue",
+			mcp.WithDescription(t("TOOL_CREATE_PULL_REQUEST_FROM_ISSUE_DESCRIPTION", "Turn an existing issue into a pull request from the head branch, keeping the issue's title, body and discussion history.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PULL_REQUEST_FROM_ISSUE_USER_TITLE", "Open pull request from issue"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the issue to turn into a pull request"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch containing changes"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch to merge into"),
+			),
+			mcp.WithBoolean("draft",
+				mcp.Description("Create as draft PR"),
+			),
+			mcp.WithBoolean("maintainer_can_modify",
+				mcp.Description("Allow maintainer edits"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			draft, err := OptionalParam[bool](request, "draft")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maintainerCanModify, err := OptionalParam[bool](request, "maintainer_can_modify")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// The title and body come from the issue, the API rejects them alongside an issue number
+			newPR := &github.NewPullRequest{
+				Issue:               github.Ptr(issueNumber),
+				Head:                github.Ptr(head),
+				Base:                github.Ptr(base),
+				Draft:               github.Ptr(draft),
+				MaintainerCanModify: github.Ptr(maintainerCanModify),
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("open a pull request for issue #%d from %s into %s in %s/%s", issueNumber, head, base, owner, repo), nil
+			}
+			pr, resp, err := client.PullRequests.Create(ctx, owner, repo, newPR)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot create a pull request from issue #%d, it may already be a pull request or have one open: %s", issueNumber, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to create pull request: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create pull request: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(pr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// describeErrorResponse joins the message of an API error with the messages of its individual errors.
+func describeErrorResponse(errResp *github.ErrorResponse) string {
+	messages := []string{errResp.Message}
+	for _, e := range errResp.Errors {
+		if e.Message != "" {
+			messages = append(messages, e.Message)
+		}
+	}
+	return strings.Join(messages, ": ")
+}
+
+// DismissPullRequestReview creates a tool to dismiss a review on a pull request.
+func DismissPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("dismiss_pull_request_review",
+			mcp.WithDescription(t("TOOL_DISMISS_PULL_REQUEST_REVIEW_DESCRIPTION", "Dismiss an approving or change-requesting review on a pull request, e.g. once the requested changes have been addressed")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DISMISS_PULL_REQUEST_REVIEW_USER_TITLE", "Dismiss pull request review"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("reviewId",
+				mcp.Required(),
+				mcp.Description("ID of the review to dismiss"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Reason for dismissing the review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewID, err := RequiredInt(request, "reviewId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("dismiss review %d of pull request #%d in %s/%s", reviewID, pullNumber, owner, repo), nil
+			}
+			dismissal := &github.PullRequestReviewDismissalRequest{
+				Message: github.Ptr(message),
+			}
+			review, resp, err := client.PullRequests.DismissReview(ctx, owner, repo, pullNumber, int64(reviewID), dismissal)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("review %d cannot be dismissed: only APPROVE and REQUEST_CHANGES reviews can be dismissed", reviewID)), nil
+				}
+				return nil, fmt.Errorf("failed to dismiss pull request review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to dismiss pull request review: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(review)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+const reviewRequestsQuery = `query($query: String!, $first: Int!, $after: String) {
+  search(query: $query, type: ISSUE, first: $first, after: $after) {
+    issueCount
+    nodes {
+      ... on PullRequest {
+        number
+        title
+        url
+        isDraft
+        createdAt
+        baseRefName
+        headRefName
+        author { login }
+        repository { nameWithOwner }
+      }
+    }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+// reviewRequest is a pull request waiting for the review of the reviewer of list_review_requests.
+type reviewRequest struct {
+	Repository string    `json:"repository"`
+	Number     int       `json:"number"`
+	Title      string    `json:"title"`
+	Author     string    `json:"author"`
+	BaseBranch string    `json:"base_branch"`
+	HeadBranch string    `json:"head_branch"`
+	Draft      bool      `json:"draft"`
+	CreatedAt  time.Time `json:"created_at"`
+	AgeDays    int       `json:"age_days"`
+	URL        string    `json:"url"`
+}
+
+// reviewRequests is the result of list_review_requests, with the cursor of the next page if there is one.
+type reviewRequests struct {
+	Query        string          `json:"query"`
+	TotalCount   int             `json:"total_count"`
+	PullRequests []reviewRequest `json:"pull_requests"`
+	EndCursor    string          `json:"end_cursor,omitempty"`
+}
+
+// reviewRequestsSearchQuery returns the search query for the open pull requests reviewer is requested
+// to review, a team when reviewer is given as org/team.
+func reviewRequestsSearchQuery(reviewer, repo string) (string, error) {
+	qualifier := "review-requested"
+	if strings.Contains(reviewer, "/") {
+		qualifier = "team-review-requested"
+	}
+	requested, err := searchQualifier(qualifier, reviewer)
+	if err != nil {
+		return "", err
+	}
+	query := "is:open is:pr " + requested
+	if repo != "" {
+		repoQualifier, err := searchQualifier("repo", repo)
+		if err != nil {
+			return "", err
+		}
+		query += " " + repoQualifier
+	}
+	return query, nil
+}
+
+// ListReviewRequests creates a tool to list the open pull requests waiting for the review of a user or team.
+func ListReviewRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_review_requests",
+			mcp.WithDescription(t("TOOL_LIST_REVIEW_REQUESTS_DESCRIPTION", "List the open pull requests a user or team is requested to review and has not reviewed yet, the authenticated user by default, with the age and base branch of each. A request is removed once the reviewer submits a review")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REVIEW_REQUESTS_USER_TITLE", "List review requests"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("reviewer",
+				mcp.Description("Login of the reviewer, or org/team for a team, defaults to the authenticated user"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only list pull requests of this repository, as owner/repo"),
+			),
+			WithGraphQLPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			reviewer, err := OptionalParam[string](request, "reviewer")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if reviewer == "" {
+				reviewer = "@me"
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalGraphQLPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query, err := reviewRequestsSearchQuery(reviewer, repo)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			variables := pagination.variables()
+			variables["query"] = query
+			var data struct {
+				Search struct {
+					IssueCount int `json:"issueCount"`
+					Nodes      []struct {
+						Number      int       `json:"number"`
+						Title       string    `json:"title"`
+						URL         string    `json:"url"`
+						IsDraft     bool      `json:"isDraft"`
+						CreatedAt   time.Time `json:"createdAt"`
+						BaseRefName string    `json:"baseRefName"`
+						HeadRefName string    `json:"headRefName"`
+						Author      struct {
+							Login string `json:"login"`
+						} `json:"author"`
+						Repository struct {
+							NameWithOwner string `json:"nameWithOwner"`
+						} `json:"repository"`
+					} `json:"nodes"`
+					PageInfo graphQLPageInfo `json:"pageInfo"`
+				} `json:"search"`
+			}
+			if err := doGraphQL(ctx, client, reviewRequestsQuery, variables, &data); err != nil {
+				return nil, fmt.Errorf("failed to search review requests: %w", err)
+			}
+
+			now := time.Now()
+			result := reviewRequests{
+				Query:        query,
+				TotalCount:   data.Search.IssueCount,
+				PullRequests: make([]reviewRequest, 0, len(data.Search.Nodes)),
+			}
+			for _, node := range data.Search.Nodes {
+				result.PullRequests = append(result.PullRequests, reviewRequest{
+					Repository: node.Repository.NameWithOwner,
+					Number:     node.Number,
+					Title:      node.Title,
+					Author:     node.Author.Login,
+					BaseBranch: node.BaseRefName,
+					HeadBranch: node.HeadRefName,
+					Draft:      node.IsDraft,
+					CreatedAt:  node.CreatedAt,
+					AgeDays:    int(now.Sub(node.CreatedAt).Hours() / 24),
+					URL:        node.URL,
+				})
+			}
+			if data.Search.PageInfo.HasNextPage {
+				result.EndCursor = data.Search.PageInfo.EndCursor
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -115,13 +115,270 @@ func Test_GetPullRequest(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedPR github.PullRequest
+			var returnedPR pullRequestCompact
 			err = json.Unmarshal([]byte(textContent.Text), &returnedPR)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedPR.Number, *returnedPR.Number)
-			assert.Equal(t, *tc.expectedPR.Title, *returnedPR.Title)
-			assert.Equal(t, *tc.expectedPR.State, *returnedPR.State)
-			assert.Equal(t, *tc.expectedPR.HTMLURL, *returnedPR.HTMLURL)
+			assert.Equal(t, *tc.expectedPR.Number, returnedPR.Number)
+			assert.Equal(t, *tc.expectedPR.Title, returnedPR.Title)
+			assert.Equal(t, *tc.expectedPR.State, returnedPR.State)
+			assert.Equal(t, *tc.expectedPR.HTMLURL, returnedPR.HTMLURL)
+		})
+	}
+}
+
+func Test_GetPullRequest_Reactions(t *testing.T) {
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Title:  github.Ptr("Test PR"),
+	}
+	mockIssue := &github.Issue{
+		Number: github.Ptr(42),
+		Reactions: &github.Reactions{
+			TotalCount: github.Ptr(3),
+			PlusOne:    github.Ptr(1),
+			Rocket:     github.Ptr(2),
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectedReactions *reactionCounts
+	}{
+		{
+			name: "reactions omitted by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+		},
+		{
+			name: "reactions included",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					mockIssue,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"include_reactions": true,
+			},
+			expectedReactions: &reactionCounts{
+				TotalCount: 3,
+				PlusOne:    1,
+				Rocket:     2,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			var returned pullRequestCompact
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, 42, returned.Number)
+			assert.Equal(t, tc.expectedReactions, returned.Reactions)
+		})
+	}
+}
+
+func Test_GetPullRequestCompact(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	assert.Contains(t, tool.InputSchema.Properties, "full")
+	assert.Contains(t, tool.InputSchema.Properties, "wait_for_mergeable")
+
+	originalInterval := mergeablePollInterval
+	mergeablePollInterval = time.Millisecond
+	t.Cleanup(func() { mergeablePollInterval = originalInterval })
+
+	mockPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		Title:          github.Ptr("Fix crash on empty input"),
+		State:          github.Ptr("open"),
+		Draft:          github.Ptr(false),
+		Body:           github.Ptr("Fixes #41"),
+		HTMLURL:        github.Ptr("https://github.com/owner/repo/pull/42"),
+		User:           &github.User{Login: github.Ptr("octocat")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+		Head:           &github.PullRequestBranch{Ref: github.Ptr("fix-crash"), Label: github.Ptr("octocat:fix-crash"), SHA: github.Ptr("abc123")},
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Additions:      github.Ptr(12),
+		Deletions:      github.Ptr(3),
+		ChangedFiles:   github.Ptr(2),
+		Labels:         []*github.Label{{Name: github.Ptr("bug")}},
+		RequestedReviewers: []*github.User{
+			{Login: github.Ptr("monalisa")},
+		},
+		RequestedTeams: []*github.Team{
+			{Slug: github.Ptr("maintainers")},
+		},
+	}
+	unknownPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		MergeableState: github.Ptr("unknown"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectedCompact *pullRequestCompact
+		expectedBody    string
+	}{
+		{
+			name: "compact by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedCompact: &pullRequestCompact{
+				Number:             42,
+				Title:              "Fix crash on empty input",
+				State:              "open",
+				Author:             "octocat",
+				Base:               "main",
+				Head:               "octocat:fix-crash",
+				HeadSHA:            "abc123",
+				Mergeable:          github.Ptr(true),
+				MergeableState:     "clean",
+				Additions:          12,
+				Deletions:          3,
+				ChangedFiles:       2,
+				Labels:             []string{"bug"},
+				RequestedReviewers: []string{"monalisa"},
+				RequestedTeams:     []string{"maintainers"},
+				HTMLURL:            "https://github.com/owner/repo/pull/42",
+			},
+		},
+		{
+			name: "full pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"full":       true,
+			},
+			expectedBody: "Fixes #41",
+		},
+		{
+			name: "mergeability not polled by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					unknownPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedCompact: &pullRequestCompact{
+				Number:             42,
+				State:              "open",
+				MergeableState:     "unknown",
+				Labels:             []string{},
+				RequestedReviewers: []string{},
+				RequestedTeams:     []string{},
+			},
+		},
+		{
+			name: "wait for mergeable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					unknownPR,
+					unknownPR,
+					mockPR,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":              "owner",
+				"repo":               "repo",
+				"pullNumber":         float64(42),
+				"wait_for_mergeable": true,
+			},
+			expectedCompact: &pullRequestCompact{
+				Number:             42,
+				Title:              "Fix crash on empty input",
+				State:              "open",
+				Author:             "octocat",
+				Base:               "main",
+				Head:               "octocat:fix-crash",
+				HeadSHA:            "abc123",
+				Mergeable:          github.Ptr(true),
+				MergeableState:     "clean",
+				Additions:          12,
+				Deletions:          3,
+				ChangedFiles:       2,
+				Labels:             []string{"bug"},
+				RequestedReviewers: []string{"monalisa"},
+				RequestedTeams:     []string{"maintainers"},
+				HTMLURL:            "https://github.com/owner/repo/pull/42",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedCompact != nil {
+				var returned pullRequestCompact
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, *tc.expectedCompact, returned)
+				assert.NotContains(t, textContent.Text, `"body"`)
+				return
+			}
+			var returned github.PullRequest
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedBody, returned.GetBody())
 		})
 	}
 }
@@ -219,6 +476,62 @@ func Test_UpdatePullRequest(t *testing.T) {
 			expectError: false,
 			expectedPR:  mockClosedPR,
 		},
+		{
+			name: "successful PR base retarget",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					// Only the base is sent, the title and body are left as they are
+					expectRequestBody(t, map[string]interface{}{
+						"base": "develop",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUpdatedPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"base":       "develop",
+			},
+			expectError: false,
+			expectedPR:  mockUpdatedPR,
+		},
+		{
+			name: "PR base retarget to a missing branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors": []map[string]any{
+							{"resource": "PullRequest", "field": "base", "code": "invalid", "message": "Proposed base branch 'missing' was not found"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"base":       "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "cannot change the base of pull request #42 in owner/repo to missing, check that the branch exists: Validation Failed: Proposed base branch 'missing' was not found",
+		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(), // No API call expected
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"state":      "merged",
+			},
+			expectError:    false,
+			expectedErrMsg: "state must be open or closed, got merged",
+		},
 		{
 			name:         "no update parameters provided",
 			mockedClient: mock.NewMockedHTTPClient(), // No API call expected
@@ -333,6 +646,17 @@ func Test_ListPullRequests(t *testing.T) {
 			Title:   github.Ptr("First PR"),
 			State:   github.Ptr("open"),
 			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+			User:    &github.User{Login: github.Ptr("octocat")},
+			Draft:   github.Ptr(true),
+			Base:    &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head: &github.PullRequestBranch{
+				Ref:   github.Ptr("feature"),
+				Label: github.Ptr("octocat:feature"),
+			},
+			Labels: []*github.Label{
+				{Name: github.Ptr("bug")},
+				{Name: github.Ptr("needs-review")},
+			},
 		},
 		{
 			Number:  github.Ptr(43),
@@ -378,6 +702,34 @@ func Test_ListPullRequests(t *testing.T) {
 			expectError: false,
 			expectedPRs: mockPRs,
 		},
+		{
+			name: "PRs listing with head and base filters and long-running sort",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"head":      "octocat:feature",
+						"base":      "main",
+						"sort":      "long-running",
+						"direction": "asc",
+						"per_page":  "30",
+						"page":      "1",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPRs),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"head":      "octocat:feature",
+				"base":      "main",
+				"sort":      "long-running",
+				"direction": "asc",
+			},
+			expectError: false,
+			expectedPRs: mockPRs,
+		},
 		{
 			name: "PRs listing fails",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -424,16 +776,165 @@ func Test_ListPullRequests(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedPRs []*github.PullRequest
+			var returnedPRs []pullRequestSummary
 			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
 			require.NoError(t, err)
 			assert.Len(t, returnedPRs, 2)
-			assert.Equal(t, *tc.expectedPRs[0].Number, *returnedPRs[0].Number)
-			assert.Equal(t, *tc.expectedPRs[0].Title, *returnedPRs[0].Title)
-			assert.Equal(t, *tc.expectedPRs[0].State, *returnedPRs[0].State)
-			assert.Equal(t, *tc.expectedPRs[1].Number, *returnedPRs[1].Number)
-			assert.Equal(t, *tc.expectedPRs[1].Title, *returnedPRs[1].Title)
-			assert.Equal(t, *tc.expectedPRs[1].State, *returnedPRs[1].State)
+			assert.Equal(t, *tc.expectedPRs[0].Number, returnedPRs[0].Number)
+			assert.Equal(t, *tc.expectedPRs[0].Title, returnedPRs[0].Title)
+			assert.Equal(t, *tc.expectedPRs[0].State, returnedPRs[0].State)
+			assert.Equal(t, "octocat", returnedPRs[0].Author)
+			assert.True(t, returnedPRs[0].Draft)
+			assert.Equal(t, "main", returnedPRs[0].Base)
+			assert.Equal(t, "octocat:feature", returnedPRs[0].Head)
+			assert.Equal(t, []string{"bug", "needs-review"}, returnedPRs[0].Labels)
+			assert.Equal(t, *tc.expectedPRs[1].Number, returnedPRs[1].Number)
+			assert.Equal(t, *tc.expectedPRs[1].Title, returnedPRs[1].Title)
+			assert.Equal(t, *tc.expectedPRs[1].State, returnedPRs[1].State)
+			assert.False(t, returnedPRs[1].Draft)
+			assert.Empty(t, returnedPRs[1].Labels)
+		})
+	}
+}
+
+func Test_ListPullRequestsForCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestsForCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_commit_pull_requests", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	mockPRs := []*github.PullRequest{
+		{
+			Number: github.Ptr(42),
+			Title:  github.Ptr("Add feature"),
+			State:  github.Ptr("closed"),
+			User:   &github.User{Login: github.Ptr("octocat")},
+			Base:   &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head:   &github.PullRequestBranch{Ref: github.Ptr("feature"), Label: github.Ptr("octocat:feature")},
+		},
+		{
+			Number: github.Ptr(43),
+			Title:  github.Ptr("Backport feature"),
+			State:  github.Ptr("open"),
+			User:   &github.User{Login: github.Ptr("hubot")},
+			Base:   &github.PullRequestBranch{Ref: github.Ptr("release-1.0")},
+			Head:   &github.PullRequestBranch{Ref: github.Ptr("backport"), Label: github.Ptr("hubot:backport")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedPRs    []*github.PullRequest
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "commit in several pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/commits/abc123/pulls", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(mockPRs)
+					}),
+				),
+			),
+			expectedPRs: mockPRs,
+		},
+		{
+			name: "commit in no pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					[]*github.PullRequest{},
+				),
+			),
+			// Commits pushed straight to a branch belong to no pull request
+			expectedText: "[]",
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "No commit found for SHA: abc123"}`),
+				),
+			),
+			expectedErrMsg: "commit abc123 not found in owner/repo",
+		},
+		{
+			name: "listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to list pull requests for commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestsForCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			require.False(t, result.IsError)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedPRs []pullRequestSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
+			require.NoError(t, err)
+			require.Len(t, returnedPRs, len(tc.expectedPRs))
+			for i, pr := range tc.expectedPRs {
+				assert.Equal(t, pr.GetNumber(), returnedPRs[i].Number)
+				assert.Equal(t, pr.GetBase().GetRef(), returnedPRs[i].Base)
+				assert.Equal(t, pr.GetHead().GetLabel(), returnedPRs[i].Head)
+			}
 		})
 	}
 }
@@ -714,13 +1215,68 @@ func Test_GetPullRequestStatus(t *testing.T) {
 		},
 	}
 
+	// Setup mock check runs, one of which is failing
+	mockCheckRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
+			{
+				Name:       github.Ptr("build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+			},
+			{
+				Name:       github.Ptr("e2e"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("failure"),
+			},
+		},
+	}
+
+	mockReviews := []*github.PullRequestReview{
+		{
+			User:  &github.User{Login: github.Ptr("reviewer")},
+			State: github.Ptr("CHANGES_REQUESTED"),
+		},
+		{
+			User:  &github.User{Login: github.Ptr("reviewer")},
+			State: github.Ptr("APPROVED"),
+		},
+	}
+
+	// The first fetch of an open PR has not computed mergeability yet, the second one has
+	mockOpenPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		State:  github.Ptr("open"),
+		Head: &github.PullRequestBranch{
+			SHA: github.Ptr("abcd1234"),
+		},
+	}
+	mockResolvedPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Rebaseable:     github.Ptr(true),
+		Head: &github.PullRequestBranch{
+			SHA: github.Ptr("abcd1234"),
+		},
+	}
+
+	originalInterval := mergeablePollInterval
+	mergeablePollInterval = time.Millisecond
+	t.Cleanup(func() { mergeablePollInterval = originalInterval })
+
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedStatus *github.CombinedStatus
-		expectedErrMsg string
+		name                    string
+		mockedClient            *http.Client
+		requestArgs             map[string]interface{}
+		expectError             bool
+		expectedStatus          *github.CombinedStatus
+		expectedMergeable       *bool
+		expectedMergeableState  string
+		expectedReviewDecision  string
+		expectedFailingContexts []string
+		expectedErrMsg          string
 	}{
 		{
 			name: "successful status fetch",
@@ -730,8 +1286,135 @@ func Test_GetPullRequestStatus(t *testing.T) {
 					mockPR,
 				),
 				mock.WithRequestMatch(
-					mock.GetReposCommitsStatusByOwnerByRepoByRef,
-					mockStatus,
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:             false,
+			expectedStatus:          mockStatus,
+			expectedReviewDecision:  "APPROVED",
+			expectedFailingContexts: []string{"e2e"},
+		},
+		{
+			name: "mergeable is polled until resolved",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockOpenPR,
+					mockResolvedPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					&github.ListCheckRunsResults{Total: github.Ptr(0)},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					[]*github.PullRequestReview{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:             false,
+			expectedStatus:          mockStatus,
+			expectedMergeable:       github.Ptr(true),
+			expectedMergeableState:  "clean",
+			expectedReviewDecision:  "REVIEW_REQUIRED",
+			expectedFailingContexts: []string{},
+		},
+		{
+			name: "latest review on a later page decides",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					&github.ListCheckRunsResults{Total: github.Ptr(0)},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, []*github.PullRequestReview{
+								{User: &github.User{Login: github.Ptr("reviewer")}, State: github.Ptr("CHANGES_REQUESTED")},
+							})(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls/42/reviews?page=2&per_page=100>; rel="next"`)
+						mockResponse(t, http.StatusOK, []*github.PullRequestReview{
+							{User: &github.User{Login: github.Ptr("reviewer")}, State: github.Ptr("APPROVED")},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:             false,
+			expectedStatus:          mockStatus,
+			expectedReviewDecision:  "CHANGES_REQUESTED",
+			expectedFailingContexts: []string{},
+		},
+		{
+			name: "failing check run on a later page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+								Total:     github.Ptr(2),
+								CheckRuns: []*github.CheckRun{{Name: github.Ptr("lint"), Conclusion: github.Ptr("failure")}},
+							})(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/commits/abcd1234/check-runs?page=2&per_page=100>; rel="next"`)
+						mockResponse(t, http.StatusOK, &github.ListCheckRunsResults{
+							Total:     github.Ptr(2),
+							CheckRuns: []*github.CheckRun{{Name: github.Ptr("build"), Conclusion: github.Ptr("success")}},
+						})(w, r)
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -739,8 +1422,10 @@ func Test_GetPullRequestStatus(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
+			expectError:             false,
+			expectedStatus:          mockStatus,
+			expectedReviewDecision:  "APPROVED",
+			expectedFailingContexts: []string{"lint"},
 		},
 		{
 			name: "PR fetch fails",
@@ -822,6 +1507,14 @@ func Test_GetPullRequestStatus(t *testing.T) {
 				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
 				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
 			}
+
+			var returnedPRStatus pullRequestStatus
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPRStatus)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMergeable, returnedPRStatus.Mergeable)
+			assert.Equal(t, tc.expectedMergeableState, returnedPRStatus.MergeableState)
+			assert.Equal(t, tc.expectedReviewDecision, returnedPRStatus.ReviewDecision)
+			assert.Equal(t, tc.expectedFailingContexts, returnedPRStatus.FailingContexts)
 		})
 	}
 }
@@ -1720,6 +2413,139 @@ func Test_CreatePullRequest(t *testing.T) {
 	}
 }
 
+func Test_CreatePullRequestFromIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreatePullRequestFromIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_pull_request_from_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.NotContains(t, tool.InputSchema.Properties, "title")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "head", "base"})
+
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Support custom cache paths"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+		Head:    &github.PullRequestBranch{Ref: github.Ptr("feature/cache-paths")},
+		Base:    &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedPR     *github.PullRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "sends the issue number instead of a title and body",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"issue":                 float64(42),
+						"head":                  "feature/cache-paths",
+						"base":                  "main",
+						"draft":                 false,
+						"maintainer_can_modify": true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                 "owner",
+				"repo":                  "repo",
+				"issue_number":          float64(42),
+				"head":                  "feature/cache-paths",
+				"base":                  "main",
+				"maintainer_can_modify": true,
+			},
+			expectedPR: mockPR,
+		},
+		{
+			name: "issue already has a pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors": []map[string]any{
+							{"resource": "PullRequest", "code": "custom", "message": "A pull request already exists for owner:feature/cache-paths."},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"head":         "feature/cache-paths",
+				"base":         "main",
+			},
+			expectedErrMsg: "cannot create a pull request from issue #42, it may already be a pull request or have one open: Validation Failed: A pull request already exists for owner:feature/cache-paths.",
+		},
+		{
+			name: "create fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"head":         "feature/cache-paths",
+				"base":         "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreatePullRequestFromIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedPR github.PullRequest
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPR)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedPR.Number, *returnedPR.Number)
+			assert.Equal(t, *tc.expectedPR.Title, *returnedPR.Title)
+			assert.Equal(t, *tc.expectedPR.HTMLURL, *returnedPR.HTMLURL)
+		})
+	}
+}
+
 func Test_AddPullRequestReviewComment(t *testing.T) {
 	mockClient := github.NewClient(nil)
 	tool, _ := AddPullRequestReviewComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -1916,3 +2742,270 @@ func Test_AddPullRequestReviewComment(t *testing.T) {
 		})
 	}
 }
+
+func Test_DismissPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DismissPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "dismiss_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewId")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "reviewId", "message"})
+
+	mockReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("DISMISSED"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedReview *github.PullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "successful dismissal",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Concerns addressed in abc123",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewId":   float64(301),
+				"message":    "Concerns addressed in abc123",
+			},
+			expectError:    false,
+			expectedReview: mockReview,
+		},
+		{
+			name: "comment review cannot be dismissed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Can not dismiss a commented pull request review"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewId":   float64(302),
+				"message":    "Stale",
+			},
+			expectError:    false,
+			expectedErrMsg: "review 302 cannot be dismissed: only APPROVE and REQUEST_CHANGES reviews can be dismissed",
+		},
+		{
+			name: "review not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewId":   float64(999),
+				"message":    "Stale",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to dismiss pull request review",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DismissPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedReview github.PullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedReview.ID, *returnedReview.ID)
+			assert.Equal(t, "DISMISSED", *returnedReview.State)
+		})
+	}
+}
+
+func Test_ListReviewRequests(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReviewRequests(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_review_requests", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "reviewer")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "first")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	createdAt := time.Now().Add(-75 * time.Hour).UTC().Truncate(time.Second)
+	searchResponse := map[string]any{
+		"data": map[string]any{
+			"search": map[string]any{
+				"issueCount": 3,
+				"nodes": []any{
+					map[string]any{
+						"number":      12,
+						"title":       "Add retries to the client",
+						"url":         "https://github.com/owner/repo/pull/12",
+						"isDraft":     false,
+						"createdAt":   createdAt.Format(time.RFC3339),
+						"baseRefName": "main",
+						"headRefName": "retries",
+						"author":      map[string]any{"login": "octocat"},
+						"repository":  map[string]any{"nameWithOwner": "owner/repo"},
+					},
+				},
+				"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "Y3Vyc29yOjE="},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		expectedQuery   string
+		expectedVars    map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedResults reviewRequests
+	}{
+		{
+			name:          "authenticated user by default",
+			requestArgs:   map[string]interface{}{},
+			expectedQuery: "is:open is:pr review-requested:@me",
+			expectedVars: map[string]any{
+				"query": "is:open is:pr review-requested:@me",
+				"first": float64(30),
+			},
+		},
+		{
+			name: "login in a repository",
+			requestArgs: map[string]interface{}{
+				"reviewer": "hubot",
+				"repo":     "owner/repo",
+				"first":    float64(10),
+				"after":    "Y3Vyc29yOjA=",
+			},
+			expectedQuery: "is:open is:pr review-requested:hubot repo:owner/repo",
+			expectedVars: map[string]any{
+				"query": "is:open is:pr review-requested:hubot repo:owner/repo",
+				"first": float64(10),
+				"after": "Y3Vyc29yOjA=",
+			},
+		},
+		{
+			name: "team",
+			requestArgs: map[string]interface{}{
+				"reviewer": "github/reviewers",
+			},
+			expectedQuery: "is:open is:pr team-review-requested:github/reviewers",
+			expectedVars: map[string]any{
+				"query": "is:open is:pr team-review-requested:github/reviewers",
+				"first": float64(30),
+			},
+		},
+		{
+			name: "invalid reviewer",
+			requestArgs: map[string]interface{}{
+				"reviewer": `octo"cat`,
+			},
+			expectError:    true,
+			expectedErrMsg: `review-requested cannot contain double quotes: octo"cat`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mockGraphQLEndpoint,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						var body graphQLRequest
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						assert.Contains(t, body.Query, "search(query: $query, type: ISSUE")
+						assert.Equal(t, tc.expectedVars, body.Variables)
+						mockResponse(t, http.StatusOK, searchResponse)(w, r)
+					}),
+				),
+			))
+			_, handler := ListReviewRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned reviewRequests
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, reviewRequests{
+				Query:      tc.expectedQuery,
+				TotalCount: 3,
+				PullRequests: []reviewRequest{
+					{
+						Repository: "owner/repo",
+						Number:     12,
+						Title:      "Add retries to the client",
+						Author:     "octocat",
+						BaseBranch: "main",
+						HeadBranch: "retries",
+						CreatedAt:  createdAt,
+						AgeDays:    3,
+						URL:        "https://github.com/owner/repo/pull/12",
+					},
+				},
+				EndCursor: "Y3Vyc29yOjE=",
+			}, returned)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultRateLimitThreshold is the number of remaining requests below which NewRateLimitTransport
+// starts delaying requests by default.
+const DefaultRateLimitThreshold = 50
+
+// defaultSecondaryRetryAfter is how long to wait after a secondary rate limit error without a
+// Retry-After header, GitHub asking to wait at least a minute.
+const defaultSecondaryRetryAfter = time.Minute
+
+// rateLimit is the last known state of the rate limit of a GitHub API resource.
+type rateLimit struct {
+	remaining int
+	reset     time.Time
+}
+
+// rateLimitTransport delays the requests to a resource whose rate limit is nearly used up until
+// the limit resets, instead of sending them until the API rejects them.
+type rateLimitTransport struct {
+	base      http.RoundTripper
+	threshold int
+
+	// now and sleep are replaced in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu     sync.Mutex
+	limits map[string]rateLimit
+}
+
+// NewRateLimitTransport wraps base, http.DefaultTransport if nil, so that once fewer than
+// threshold requests remain in the rate limit of a GitHub API resource, according to the
+// X-RateLimit headers of its responses, the next requests to that resource wait until the limit
+// resets. The core, search and GraphQL limits are tracked separately.
+func NewRateLimitTransport(base http.RoundTripper, threshold int) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		base:      base,
+		threshold: threshold,
+		now:       time.Now,
+		sleep:     sleepContext,
+		limits:    make(map[string]rateLimit),
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resource := rateLimitResource(req)
+	if wait := t.delay(resource); wait > 0 {
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		t.record(resource, resp.Header)
+	}
+	return resp, err
+}
+
+// delay returns how long a request to resource has to wait for its rate limit to reset.
+func (t *rateLimitTransport) delay(resource string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	limit, ok := t.limits[resource]
+	if !ok || limit.remaining >= t.threshold {
+		return 0
+	}
+	wait := limit.reset.Sub(t.now())
+	if wait <= 0 {
+		delete(t.limits, resource)
+		return 0
+	}
+	return wait
+}
+
+// record keeps the rate limit reported by the headers of a response, which name the resource
+// they apply to when they have the header for it.
+func (t *rateLimitTransport) record(resource string, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	if r := header.Get("X-RateLimit-Resource"); r != "" {
+		resource = r
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limits[resource] = rateLimit{remaining: remaining, reset: time.Unix(reset, 0)}
+}
+
+// rateLimitResource returns the rate limit resource a request counts against, as named by the
+// X-RateLimit-Resource header of the API.
+func rateLimitResource(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, "/api/v3")
+	switch {
+	case path == "/graphql" || path == "/api/graphql":
+		return "graphql"
+	case strings.HasPrefix(path, "/search/code"):
+		return "code_search"
+	case strings.HasPrefix(path, "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitedError is the result of a tool call that failed because of a rate limit, telling the
+// caller how long to wait before calling again. Kind is primary for the hourly limit of a
+// resource, X-RateLimit-Remaining reaching 0, and secondary for the limits on bursts of requests,
+// which come with a Retry-After header.
+type rateLimitedError struct {
+	RateLimited       bool      `json:"rate_limited"`
+	Kind              string    `json:"kind"`
+	Resource          string    `json:"resource,omitempty"`
+	ResetAt           time.Time `json:"reset_at"`
+	RetryAfterSeconds int       `json:"retry_after_seconds"`
+	Message           string    `json:"message"`
+}
+
+// classifyRateLimitError reports whether err is caused by a primary or secondary rate limit of the
+// GitHub API and, if so, when the call can be retried relative to now.
+func classifyRateLimitError(err error, now time.Time) (rateLimitedError, bool) {
+	var primary *github.RateLimitError
+	if errors.As(err, &primary) {
+		reset := primary.Rate.Reset.Time
+		if reset.IsZero() {
+			reset = now.Add(defaultSecondaryRetryAfter)
+		}
+		return newRateLimitedError("primary", primary.Rate.Resource, reset, now, err), true
+	}
+	var secondary *github.AbuseRateLimitError
+	if errors.As(err, &secondary) {
+		retryAfter := defaultSecondaryRetryAfter
+		if secondary.RetryAfter != nil {
+			retryAfter = *secondary.RetryAfter
+		}
+		return newRateLimitedError("secondary", "", now.Add(retryAfter), now, err), true
+	}
+	return rateLimitedError{}, false
+}
+
+func newRateLimitedError(kind, resource string, reset, now time.Time, err error) rateLimitedError {
+	retryAfter := int(math.Ceil(reset.Sub(now).Seconds()))
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+	return rateLimitedError{
+		RateLimited:       true,
+		Kind:              kind,
+		Resource:          resource,
+		ResetAt:           reset.UTC(),
+		RetryAfterSeconds: retryAfter,
+		Message:           err.Error(),
+	}
+}
+
+// rateLimitErrorMiddleware turns the errors of tool calls caused by a rate limit into tool errors
+// holding a rateLimitedError, so the caller can tell them from other failures and wait. Only Go
+// errors returned by a handler are classified: a handler that already turned the API error into a
+// tool error result, for instance one that handles 403s itself, leaves nothing to classify, and its
+// result is passed through unchanged.
+func rateLimitErrorMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err == nil {
+			return result, nil
+		}
+		limited, ok := classifyRateLimitError(err, time.Now())
+		if !ok {
+			return result, err
+		}
+		r, marshalErr := json.Marshal(limited)
+		if marshalErr != nil {
+			return result, err
+		}
+		return mcp.NewToolResultError(string(r)), nil
+	}
+}
@@ -0,0 +1,312 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_RateLimitTransport(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := now.Add(30 * time.Second)
+
+	rateLimitHeaders := func(resource string, remaining int) http.Header {
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		header.Set("X-RateLimit-Resource", resource)
+		return header
+	}
+
+	tests := []struct {
+		name          string
+		firstURL      string
+		firstHeader   http.Header
+		nextURL       string
+		now           time.Time
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "remaining below the threshold delays the next request until reset",
+			firstURL:      "https://api.github.com/repos/owner/repo",
+			firstHeader:   rateLimitHeaders("core", 2),
+			nextURL:       "https://api.github.com/repos/owner/repo/issues",
+			now:           now,
+			expectedDelay: 30 * time.Second,
+		},
+		{
+			name:        "remaining at the threshold does not delay",
+			firstURL:    "https://api.github.com/repos/owner/repo",
+			firstHeader: rateLimitHeaders("core", 5),
+			nextURL:     "https://api.github.com/repos/owner/repo/issues",
+			now:         now,
+		},
+		{
+			name:        "limit already reset does not delay",
+			firstURL:    "https://api.github.com/repos/owner/repo",
+			firstHeader: rateLimitHeaders("core", 0),
+			nextURL:     "https://api.github.com/repos/owner/repo/issues",
+			now:         reset.Add(time.Second),
+		},
+		{
+			name:        "other resources are not delayed",
+			firstURL:    "https://api.github.com/search/issues?q=bug",
+			firstHeader: rateLimitHeaders("search", 0),
+			nextURL:     "https://api.github.com/repos/owner/repo/issues",
+			now:         now,
+		},
+		{
+			name:          "search requests are delayed by the search limit",
+			firstURL:      "https://api.github.com/search/issues?q=bug",
+			firstHeader:   rateLimitHeaders("search", 0),
+			nextURL:       "https://api.github.com/search/repositories?q=mcp",
+			now:           now,
+			expectedDelay: 30 * time.Second,
+		},
+		{
+			name:        "responses without rate limit headers are ignored",
+			firstURL:    "https://api.github.com/repos/owner/repo",
+			firstHeader: http.Header{},
+			nextURL:     "https://api.github.com/repos/owner/repo/issues",
+			now:         now,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			header := tc.firstHeader
+			base := roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+				resp := &http.Response{StatusCode: http.StatusOK, Header: header, Body: http.NoBody}
+				header = http.Header{}
+				return resp, nil
+			})
+			transport := NewRateLimitTransport(base, 5).(*rateLimitTransport)
+			transport.now = func() time.Time { return tc.now }
+			var delays []time.Duration
+			transport.sleep = func(_ context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return nil
+			}
+
+			for _, url := range []string{tc.firstURL, tc.nextURL} {
+				req, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(t, err)
+				resp, err := transport.RoundTrip(req)
+				require.NoError(t, err)
+				_ = resp.Body.Close()
+			}
+
+			if tc.expectedDelay == 0 {
+				assert.Empty(t, delays)
+				return
+			}
+			assert.Equal(t, []time.Duration{tc.expectedDelay}, delays)
+		})
+	}
+}
+
+func Test_RateLimitTransport_Cancelled(t *testing.T) {
+	var requests int
+	base := roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		requests++
+		header := http.Header{}
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		return &http.Response{StatusCode: http.StatusOK, Header: header, Body: http.NoBody}, nil
+	})
+	transport := NewRateLimitTransport(base, DefaultRateLimitThreshold)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/user", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, requests, "the delayed request is not sent")
+}
+
+func Test_ClassifyRateLimitError(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	response := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Request:    httptest.NewRequest(http.MethodGet, "https://api.github.com/repos/owner/repo", nil),
+	}
+
+	tests := []struct {
+		name          string
+		err           error
+		expectLimited bool
+		expected      rateLimitedError
+	}{
+		{
+			name: "primary rate limit",
+			err: fmt.Errorf("failed to get repository: %w", &github.RateLimitError{
+				Rate:     github.Rate{Remaining: 0, Reset: github.Timestamp{Time: now.Add(90 * time.Second)}, Resource: "core"},
+				Response: response,
+				Message:  "API rate limit exceeded",
+			}),
+			expectLimited: true,
+			expected: rateLimitedError{
+				RateLimited:       true,
+				Kind:              "primary",
+				Resource:          "core",
+				ResetAt:           now.Add(90 * time.Second).UTC(),
+				RetryAfterSeconds: 90,
+			},
+		},
+		{
+			name: "primary rate limit already reset",
+			err: &github.RateLimitError{
+				Rate:     github.Rate{Reset: github.Timestamp{Time: now.Add(-time.Second)}, Resource: "search"},
+				Response: response,
+			},
+			expectLimited: true,
+			expected: rateLimitedError{
+				RateLimited: true,
+				Kind:        "primary",
+				Resource:    "search",
+				ResetAt:     now.Add(-time.Second).UTC(),
+			},
+		},
+		{
+			name: "secondary rate limit with retry after",
+			err: fmt.Errorf("failed to search code: %w", &github.AbuseRateLimitError{
+				Response:   response,
+				Message:    "You have exceeded a secondary rate limit",
+				RetryAfter: github.Ptr(30 * time.Second),
+			}),
+			expectLimited: true,
+			expected: rateLimitedError{
+				RateLimited:       true,
+				Kind:              "secondary",
+				ResetAt:           now.Add(30 * time.Second).UTC(),
+				RetryAfterSeconds: 30,
+			},
+		},
+		{
+			name: "secondary rate limit without retry after",
+			err: &github.AbuseRateLimitError{
+				Response: response,
+				Message:  "You have exceeded a secondary rate limit",
+			},
+			expectLimited: true,
+			expected: rateLimitedError{
+				RateLimited:       true,
+				Kind:              "secondary",
+				ResetAt:           now.Add(time.Minute).UTC(),
+				RetryAfterSeconds: 60,
+			},
+		},
+		{
+			name: "other forbidden error",
+			err: fmt.Errorf("failed to get repository: %w", &github.ErrorResponse{
+				Response: response,
+				Message:  "Resource not accessible by integration",
+			}),
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limited, ok := classifyRateLimitError(tc.err, now)
+			require.Equal(t, tc.expectLimited, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, tc.err.Error(), limited.Message)
+			limited.Message = ""
+			assert.Equal(t, tc.expected, limited)
+		})
+	}
+}
+
+func Test_RateLimitErrorMiddleware(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name         string
+		header       http.Header
+		expectedKind string
+	}{
+		{
+			name: "primary rate limit",
+			header: http.Header{
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+				"X-Ratelimit-Resource":  {"core"},
+			},
+			expectedKind: "primary",
+		},
+		{
+			name: "secondary rate limit",
+			header: http.Header{
+				"Retry-After": {"120"},
+			},
+			expectedKind: "secondary",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						for name, values := range tc.header {
+							w.Header()[name] = values
+						}
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit", "documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+					}),
+				),
+			))
+			_, handler := GetIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			})
+			result, err := rateLimitErrorMiddleware(handler)(context.Background(), request)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+
+			var limited rateLimitedError
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &limited))
+			assert.True(t, limited.RateLimited)
+			assert.Equal(t, tc.expectedKind, limited.Kind)
+			assert.Positive(t, limited.RetryAfterSeconds)
+			if tc.expectedKind == "primary" {
+				assert.Equal(t, reset.UTC(), limited.ResetAt)
+				assert.Equal(t, "core", limited.Resource)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxTagDepth caps the number of annotated tags followed when a tag points to another tag.
+const maxTagDepth = 5
+
+// resolvedRef is the result of resolve_ref.
+type resolvedRef struct {
+	Ref    string `json:"ref"`
+	Type   string `json:"type"`
+	SHA    string `json:"sha"`
+	TagSHA string `json:"tag_sha,omitempty"`
+}
+
+// peelTag follows the annotated tag object sha to the commit it points to.
+func peelTag(ctx context.Context, client *github.Client, owner, repo, sha string) (string, error) {
+	for i := 0; i < maxTagDepth; i++ {
+		tag, resp, err := client.Git.GetTag(ctx, owner, repo, sha)
+		if err != nil {
+			return "", fmt.Errorf("failed to get tag %s: %w", sha, err)
+		}
+		_ = resp.Body.Close()
+
+		if tag.GetObject().GetType() != "tag" {
+			return tag.GetObject().GetSHA(), nil
+		}
+		sha = tag.GetObject().GetSHA()
+	}
+	return "", fmt.Errorf("tag %s is nested more than %d levels deep", sha, maxTagDepth)
+}
+
+// ResolveRef creates a tool to resolve a branch, tag or partial SHA to the full SHA of a commit.
+func ResolveRef(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("resolve_ref",
+			mcp.WithDescription(t("TOOL_RESOLVE_REF_DESCRIPTION", "Resolve a branch, tag or partial commit SHA in a GitHub repository to the full SHA of the commit it points to. Annotated tags are dereferenced to their commit. Branches take precedence over tags of the same name")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RESOLVE_REF_USER_TITLE", "Resolve ref to commit SHA"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Required(),
+				mcp.Description("Branch name, tag name, or full or partial commit SHA"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := requiredParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result, err := resolveRef(ctx, client, owner, repo, ref)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("ref %s not found in %s/%s", ref, owner, repo)), nil
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// resolveRef looks ref up as a branch, then as a tag, then as a commit SHA. It returns nil when
+// ref matches none of them.
+func resolveRef(ctx context.Context, client *github.Client, owner, repo, ref string) (*resolvedRef, error) {
+	branch, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+ref)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("failed to get branch %s: %w", ref, err)
+	}
+	if err == nil {
+		_ = resp.Body.Close()
+		return &resolvedRef{Ref: ref, Type: "branch", SHA: branch.GetObject().GetSHA()}, nil
+	}
+
+	tag, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+ref)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("failed to get tag %s: %w", ref, err)
+	}
+	if err == nil {
+		_ = resp.Body.Close()
+		if tag.GetObject().GetType() != "tag" {
+			return &resolvedRef{Ref: ref, Type: "tag", SHA: tag.GetObject().GetSHA()}, nil
+		}
+		sha, err := peelTag(ctx, client, owner, repo, tag.GetObject().GetSHA())
+		if err != nil {
+			return nil, err
+		}
+		return &resolvedRef{Ref: ref, Type: "annotated_tag", SHA: sha, TagSHA: tag.GetObject().GetSHA()}, nil
+	}
+
+	// The commits API returns 422 rather than 404 for a SHA that matches no commit
+	sha, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get commit %s: %w", ref, err)
+	}
+	_ = resp.Body.Close()
+
+	return &resolvedRef{Ref: ref, Type: "commit", SHA: sha}, nil
+}
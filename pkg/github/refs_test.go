@@ -0,0 +1,160 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResolveRef(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ResolveRef(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "resolve_ref", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ref"})
+
+	const commitSHA = "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+
+	// refs stubs the refs of the repository by path
+	refs := map[string]*github.Reference{
+		"/repos/owner/repo/git/ref/heads/main": {
+			Ref:    github.Ptr("refs/heads/main"),
+			Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr(commitSHA)},
+		},
+		"/repos/owner/repo/git/ref/tags/v1.0.0": {
+			Ref:    github.Ptr("refs/tags/v1.0.0"),
+			Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr(commitSHA)},
+		},
+		"/repos/owner/repo/git/ref/tags/v2.0.0": {
+			Ref:    github.Ptr("refs/tags/v2.0.0"),
+			Object: &github.GitObject{Type: github.Ptr("tag"), SHA: github.Ptr("940bd336248efae0f9ee5bc7b2d5c985887b16ac")},
+		},
+	}
+	refHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ref, ok := refs[r.URL.Path]
+		if !ok {
+			mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+			return
+		}
+		mockResponse(t, http.StatusOK, ref)(w, r)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		ref            string
+		expectError    bool
+		expectedRef    resolvedRef
+		expectedErrMsg string
+	}{
+		{
+			name: "branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposGitRefByOwnerByRepoByRef, refHandler),
+			),
+			ref:         "main",
+			expectedRef: resolvedRef{Ref: "main", Type: "branch", SHA: commitSHA},
+		},
+		{
+			name: "lightweight tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposGitRefByOwnerByRepoByRef, refHandler),
+			),
+			ref:         "v1.0.0",
+			expectedRef: resolvedRef{Ref: "v1.0.0", Type: "tag", SHA: commitSHA},
+		},
+		{
+			name: "annotated tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposGitRefByOwnerByRepoByRef, refHandler),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTagsByOwnerByRepoByTagSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Tag{
+							Tag:    github.Ptr("v2.0.0"),
+							SHA:    github.Ptr("940bd336248efae0f9ee5bc7b2d5c985887b16ac"),
+							Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr(commitSHA)},
+						})(w, r)
+					}),
+				),
+			),
+			ref: "v2.0.0",
+			expectedRef: resolvedRef{
+				Ref:    "v2.0.0",
+				Type:   "annotated_tag",
+				SHA:    commitSHA,
+				TagSHA: "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			},
+		},
+		{
+			name: "short SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposGitRefByOwnerByRepoByRef, refHandler),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/commits/6dcb09b", r.URL.Path)
+						assert.Equal(t, "application/vnd.github.v3.sha", r.Header.Get("Accept"))
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write([]byte(commitSHA))
+					}),
+				),
+			),
+			ref:         "6dcb09b",
+			expectedRef: resolvedRef{Ref: "6dcb09b", Type: "commit", SHA: commitSHA},
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposGitRefByOwnerByRepoByRef, refHandler),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "No commit found for SHA: nope"}`),
+				),
+			),
+			ref:            "nope",
+			expectError:    true,
+			expectedErrMsg: "ref nope not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ResolveRef(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   tc.ref,
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned resolvedRef
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedRef, returned)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxReleaseAssetBytes caps the size of a release asset returned inline. Larger assets are
+// only returned as a download URL.
+const maxReleaseAssetBytes = 1024 * 1024
+
+// releaseAssetDownloadClient follows the redirect to the storage location of a release asset.
+// The location is a signed URL, so it must not receive the token, overridden in tests.
+var releaseAssetDownloadClient = http.DefaultClient
+
+// releaseAssetContent is the result of download_release_asset.
+type releaseAssetContent struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+	DownloadURL string `json:"download_url"`
+	Encoding    string `json:"encoding,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Truncated   bool   `json:"truncated,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// DownloadReleaseAsset creates a tool to download the contents of a release asset.
+func DownloadReleaseAsset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("download_release_asset",
+			mcp.WithDescription(t("TOOL_DOWNLOAD_RELEASE_ASSET_DESCRIPTION", fmt.Sprintf("Download a release asset of a GitHub repository. Returns the asset base64 encoded, or as text when as_text is set. Assets larger than %d bytes are only returned as a download URL", maxReleaseAssetBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DOWNLOAD_RELEASE_ASSET_USER_TITLE", "Download release asset"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("asset_id",
+				mcp.Required(),
+				mcp.Description("The ID of the release asset"),
+			),
+			mcp.WithBoolean("as_text",
+				mcp.Description("Return the asset as text instead of base64, for text assets such as checksums or changelogs"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assetID, err := RequiredInt(request, "asset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			asText, err := OptionalParam[bool](request, "as_text")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			asset, resp, err := client.Repositories.GetReleaseAsset(ctx, owner, repo, int64(assetID))
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("release asset %d not found in %s/%s", assetID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get release asset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := releaseAssetContent{
+				Name:        asset.GetName(),
+				ContentType: asset.GetContentType(),
+				Size:        asset.GetSize(),
+				DownloadURL: asset.GetBrowserDownloadURL(),
+			}
+			if asset.GetSize() > maxReleaseAssetBytes {
+				result.Note = fmt.Sprintf("asset is larger than %d bytes, download it from download_url instead", maxReleaseAssetBytes)
+				return marshalReleaseAssetContent(result)
+			}
+
+			rc, _, err := client.Repositories.DownloadReleaseAsset(ctx, owner, repo, int64(assetID), releaseAssetDownloadClient)
+			if err != nil {
+				return nil, fmt.Errorf("failed to download release asset: %w", err)
+			}
+			defer func() { _ = rc.Close() }()
+
+			// The size reported by the API is checked above, only read one byte past the cap in case it was wrong
+			data, err := io.ReadAll(io.LimitReader(rc, maxReleaseAssetBytes+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read release asset: %w", err)
+			}
+			if len(data) > maxReleaseAssetBytes {
+				result.Note = fmt.Sprintf("asset is larger than %d bytes, download it from download_url instead", maxReleaseAssetBytes)
+				return marshalReleaseAssetContent(result)
+			}
+
+			switch {
+			case asText && isBinary(data):
+				return mcp.NewToolResultError(fmt.Sprintf("release asset %s is not a text file, download it without as_text", asset.GetName())), nil
+			case asText:
+				result.Encoding = "utf-8"
+				result.Content, result.Truncated = truncateText(string(data), maxTextResultBytes)
+			default:
+				result.Encoding = "base64"
+				result.Content = base64.StdEncoding.EncodeToString(data)
+			}
+
+			return marshalReleaseAssetContent(result)
+		}
+}
+
+// marshalReleaseAssetContent returns the result of download_release_asset as a tool result.
+func marshalReleaseAssetContent(result releaseAssetContent) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return mcp.NewToolResultText(string(r)), nil
+}
@@ -0,0 +1,236 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DownloadReleaseAsset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DownloadReleaseAsset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "download_release_asset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "asset_id")
+	assert.Contains(t, tool.InputSchema.Properties, "as_text")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "asset_id"})
+
+	assetBytes := []byte{0x1f, 0x8b, 0x08, 0x00, 0xde, 0xad}
+	checksums := "abc123  tool_linux_amd64.tar.gz\n"
+
+	// assetHandler serves the asset metadata as JSON, and redirects octet-stream requests
+	// to the storage location the way the API does
+	assetHandler := func(asset *github.ReleaseAsset) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept") == "application/octet-stream" {
+				http.Redirect(w, r, "https://objects.githubusercontent.com/download/asset", http.StatusFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(asset)
+		}
+	}
+	storageHandler := func(data []byte) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(data)
+		}
+	}
+	storageEndpoint := mock.EndpointPattern{Pattern: "/download/asset", Method: "GET"}
+
+	binaryAsset := &github.ReleaseAsset{
+		ID:                 github.Ptr(int64(1)),
+		Name:               github.Ptr("tool_linux_amd64.tar.gz"),
+		ContentType:        github.Ptr("application/gzip"),
+		Size:               github.Ptr(len(assetBytes)),
+		BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/tool_linux_amd64.tar.gz"),
+	}
+	textAsset := &github.ReleaseAsset{
+		ID:                 github.Ptr(int64(2)),
+		Name:               github.Ptr("checksums.txt"),
+		ContentType:        github.Ptr("text/plain"),
+		Size:               github.Ptr(len(checksums)),
+		BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/checksums.txt"),
+	}
+	largeAsset := &github.ReleaseAsset{
+		ID:                 github.Ptr(int64(3)),
+		Name:               github.Ptr("image.iso"),
+		ContentType:        github.Ptr("application/octet-stream"),
+		Size:               github.Ptr(maxReleaseAssetBytes + 1),
+		BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/image.iso"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult releaseAssetContent
+		expectedErrMsg string
+	}{
+		{
+			name: "follows the redirect and returns base64 bytes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByAssetId,
+					assetHandler(binaryAsset),
+				),
+				mock.WithRequestMatchHandler(
+					storageEndpoint,
+					storageHandler(assetBytes),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(1),
+			},
+			expectError: false,
+			expectedResult: releaseAssetContent{
+				Name:        "tool_linux_amd64.tar.gz",
+				ContentType: "application/gzip",
+				Size:        len(assetBytes),
+				DownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/tool_linux_amd64.tar.gz",
+				Encoding:    "base64",
+				Content:     base64.StdEncoding.EncodeToString(assetBytes),
+			},
+		},
+		{
+			name: "decodes text assets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByAssetId,
+					assetHandler(textAsset),
+				),
+				mock.WithRequestMatchHandler(
+					storageEndpoint,
+					storageHandler([]byte(checksums)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(2),
+				"as_text":  true,
+			},
+			expectError: false,
+			expectedResult: releaseAssetContent{
+				Name:        "checksums.txt",
+				ContentType: "text/plain",
+				Size:        len(checksums),
+				DownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/checksums.txt",
+				Encoding:    "utf-8",
+				Content:     checksums,
+			},
+		},
+		{
+			name: "refuses to decode binary assets as text",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByAssetId,
+					assetHandler(binaryAsset),
+				),
+				mock.WithRequestMatchHandler(
+					storageEndpoint,
+					storageHandler(assetBytes),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(1),
+				"as_text":  true,
+			},
+			expectError:    false,
+			expectedErrMsg: "release asset tool_linux_amd64.tar.gz is not a text file, download it without as_text",
+		},
+		{
+			name: "large asset returns only the download URL",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByAssetId,
+					assetHandler(largeAsset),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(3),
+			},
+			expectError: false,
+			expectedResult: releaseAssetContent{
+				Name:        "image.iso",
+				ContentType: "application/octet-stream",
+				Size:        maxReleaseAssetBytes + 1,
+				DownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/image.iso",
+				Note:        "asset is larger than 1048576 bytes, download it from download_url instead",
+			},
+		},
+		{
+			name: "asset not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReleasesAssetsByOwnerByRepoByAssetId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"asset_id": float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "release asset 999 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock, following redirects through the same mock
+			client := github.NewClient(tc.mockedClient)
+			originalDownloadClient := releaseAssetDownloadClient
+			releaseAssetDownloadClient = tc.mockedClient
+			t.Cleanup(func() { releaseAssetDownloadClient = originalDownloadClient })
+			_, handler := DownloadReleaseAsset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned releaseAssetContent
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -3,9 +3,13 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -13,12 +17,53 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// commitVerification is the signature verification of a commit.
+type commitVerification struct {
+	Verified      bool   `json:"verified"`
+	Reason        string `json:"reason"`
+	SignatureType string `json:"signature_type,omitempty"`
+}
+
+// commitDetails is a commit, optionally with a summary of its signature verification.
+type commitDetails struct {
+	*github.RepositoryCommit
+	Verification *commitVerification `json:"verification,omitempty"`
+}
+
+// signatureType returns the kind of signature from its armor header: gpg, ssh or x509.
+func signatureType(signature string) string {
+	switch {
+	case strings.HasPrefix(signature, "-----BEGIN PGP SIGNATURE-----"):
+		return "gpg"
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		return "ssh"
+	case strings.HasPrefix(signature, "-----BEGIN SIGNED MESSAGE-----"):
+		return "x509"
+	default:
+		return ""
+	}
+}
+
+// withVerification returns commit with the summary of its signature verification when include is set.
+func withVerification(commit *github.RepositoryCommit, include bool) commitDetails {
+	details := commitDetails{RepositoryCommit: commit}
+	if include {
+		verification := commit.GetCommit().GetVerification()
+		details.Verification = &commitVerification{
+			Verified:      verification.GetVerified(),
+			Reason:        verification.GetReason(),
+			SignatureType: signatureType(verification.GetSignature()),
+		}
+	}
+	return details
+}
+
 func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_commit",
 			mcp.WithDescription(t("TOOL_GET_COMMITS_DESCRIPTION", "Get details for a commit from a GitHub repository")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_GET_COMMITS_USER_TITLE", "Get commit details"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -32,6 +77,9 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				mcp.Required(),
 				mcp.Description("Commit SHA, branch name, or tag name"),
 			),
+			mcp.WithBoolean("include_verification",
+				mcp.Description("Include whether the commit signature is verified, the reason and the signature type"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -47,6 +95,10 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeVerification, err := OptionalParam[bool](request, "include_verification")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -75,7 +127,7 @@ func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commit)
+			r, err := json.Marshal(withVerification(commit, includeVerification))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -90,7 +142,7 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithDescription(t("TOOL_LIST_COMMITS_DESCRIPTION", "Get list of commits of a branch in a GitHub repository")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_COMMITS_USER_TITLE", "List commits"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -103,6 +155,9 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			mcp.WithString("sha",
 				mcp.Description("SHA or Branch name"),
 			),
+			mcp.WithBoolean("include_verification",
+				mcp.Description("Include whether each commit signature is verified, the reason and the signature type"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -118,6 +173,10 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			includeVerification, err := OptionalParam[bool](request, "include_verification")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -149,7 +208,12 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commits)
+			details := make([]commitDetails, 0, len(commits))
+			for _, commit := range commits {
+				details = append(details, withVerification(commit, includeVerification))
+			}
+
+			r, err := json.Marshal(details)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -158,13 +222,22 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
-// ListBranches creates a tool to list branches in a GitHub repository.
-func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("list_branches",
-			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository")),
+// pathCommit is the result of get_latest_commit_for_path.
+type pathCommit struct {
+	SHA     string     `json:"sha"`
+	Author  string     `json:"author"`
+	Date    *time.Time `json:"date,omitempty"`
+	Message string     `json:"message"`
+	HTMLURL string     `json:"html_url"`
+}
+
+// GetLatestCommitForPath creates a tool to get the most recent commit that touched a path.
+func GetLatestCommitForPath(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_latest_commit_for_path",
+			mcp.WithDescription(t("TOOL_GET_LATEST_COMMIT_FOR_PATH_DESCRIPTION", "Get the most recent commit that touched a file or directory in a GitHub repository, without listing its full history")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_LIST_BRANCHES_USER_TITLE", "List branches"),
-				ReadOnlyHint: true,
+				Title:        t("TOOL_GET_LATEST_COMMIT_FOR_PATH_USER_TITLE", "Get latest commit for path"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -174,7 +247,13 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			WithPagination(),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file or directory"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA or Branch name to start from, defaults to the default branch"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -185,26 +264,26 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			pagination, err := OptionalPaginationParams(request)
+			path, err := requiredParam[string](request, "path")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.BranchListOptions{
-				ListOptions: github.ListOptions{
-					Page:    pagination.page,
-					PerPage: pagination.perPage,
-				},
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-
-			branches, resp, err := client.Repositories.ListBranches(ctx, owner, repo, opts)
+			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
+				SHA:         sha,
+				Path:        path,
+				ListOptions: github.ListOptions{PerPage: 1},
+			})
 			if err != nil {
-				return nil, fmt.Errorf("failed to list branches: %w", err)
+				return nil, fmt.Errorf("failed to list commits: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -213,10 +292,32 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
+			}
+
+			if len(commits) == 0 {
+				ref := sha
+				if ref == "" {
+					ref = "the default branch"
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("no commit touched %s on %s in %s/%s, the path may never have existed on that ref", path, ref, owner, repo)), nil
+			}
+
+			commit := commits[0]
+			result := pathCommit{
+				SHA:     commit.GetSHA(),
+				Author:  commit.GetAuthor().GetLogin(),
+				Message: commit.GetCommit().GetMessage(),
+				HTMLURL: commit.GetHTMLURL(),
+			}
+			if result.Author == "" {
+				result.Author = commit.GetCommit().GetAuthor().GetName()
+			}
+			if date := commit.GetCommit().GetAuthor().Date; date != nil {
+				result.Date = &date.Time
 			}
 
-			r, err := json.Marshal(branches)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -225,40 +326,87 @@ func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
-// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
-func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_or_update_file",
-			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository. If updating, you must provide the SHA of the file you want to update.")),
+// diffFilePaths returns the old and new paths of the file a section of a unified diff changes.
+func diffFilePaths(section string) []string {
+	var paths []string
+	header, _, _ := strings.Cut(section, "\n")
+	// Without a rename both sides of "diff --git a/<path> b/<path>" are the same path, which
+	// keeps paths containing spaces intact
+	rest := strings.TrimPrefix(header, "diff --git ")
+	if n := (len(rest) - 5) / 2; n > 0 && rest == "a/"+rest[2:2+n]+" b/"+rest[2:2+n] {
+		paths = append(paths, rest[2:2+n])
+	}
+	for _, line := range strings.Split(section, "\n") {
+		switch {
+		case strings.HasPrefix(line, "--- a/"):
+			paths = append(paths, strings.TrimPrefix(line, "--- a/"))
+		case strings.HasPrefix(line, "+++ b/"):
+			paths = append(paths, strings.TrimPrefix(line, "+++ b/"))
+		case strings.HasPrefix(line, "rename from "):
+			paths = append(paths, strings.TrimPrefix(line, "rename from "))
+		case strings.HasPrefix(line, "rename to "):
+			paths = append(paths, strings.TrimPrefix(line, "rename to "))
+		case strings.HasPrefix(line, "@@"):
+			// The hunks start, the remaining lines are file content
+			return paths
+		}
+	}
+	return paths
+}
+
+// filterDiffByPath keeps the files of a unified diff that are at or below the path prefix. Filtering
+// is per file: every hunk of a matching file is kept, since all of them touch its path.
+func filterDiffByPath(diff, prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+
+	// Each file of the diff starts with its "diff --git" header
+	var sections []string
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(line, "diff --git ") || len(sections) == 0 {
+			sections = append(sections, line)
+			continue
+		}
+		sections[len(sections)-1] += line
+	}
+
+	var filtered strings.Builder
+	for _, section := range sections {
+		for _, path := range diffFilePaths(section) {
+			if path == prefix || strings.HasPrefix(path, prefix+"/") {
+				filtered.WriteString(section)
+				break
+			}
+		}
+	}
+	return filtered.String()
+}
+
+// GetDiffBetweenRefs creates a tool to get the unified diff between two refs of a repository.
+func GetDiffBetweenRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_diff_between_refs",
+			mcp.WithDescription(t("TOOL_GET_DIFF_BETWEEN_REFS_DESCRIPTION", fmt.Sprintf("Get the raw unified diff between two branches, tags or commits of a GitHub repository, as of their merge base. The diff is truncated to %d bytes", maxTextResultBytes))),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_CREATE_OR_UPDATE_FILE_USER_TITLE", "Create or update file"),
-				ReadOnlyHint: false,
+				Title:        t("TOOL_GET_DIFF_BETWEEN_REFS_USER_TITLE", "Get diff between refs"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
+				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
-				mcp.Required(),
-				mcp.Description("Path where to create/update the file"),
-			),
-			mcp.WithString("content",
-				mcp.Required(),
-				mcp.Description("Content of the file"),
-			),
-			mcp.WithString("message",
+			mcp.WithString("base",
 				mcp.Required(),
-				mcp.Description("Commit message"),
+				mcp.Description("Branch, tag or commit SHA to compare from"),
 			),
-			mcp.WithString("branch",
+			mcp.WithString("head",
 				mcp.Required(),
-				mcp.Description("Branch to create/update the file in"),
+				mcp.Description("Branch, tag or commit SHA to compare to"),
 			),
-			mcp.WithString("sha",
-				mcp.Description("SHA of file being replaced (for updates)"),
+			mcp.WithString("path",
+				mcp.Description("Only include the files at or below this path. The filter is per file: the diff of each matching file is kept whole, the others are dropped"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -270,167 +418,170 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := requiredParam[string](request, "path")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			content, err := requiredParam[string](request, "content")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			message, err := requiredParam[string](request, "message")
+			base, err := requiredParam[string](request, "base")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := requiredParam[string](request, "branch")
+			head, err := requiredParam[string](request, "head")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Convert content to base64
-			contentBytes := []byte(content)
-
-			// Create the file options
-			opts := &github.RepositoryContentFileOptions{
-				Message: github.Ptr(message),
-				Content: contentBytes,
-				Branch:  github.Ptr(branch),
-			}
-
-			// If SHA is provided, set it (for updates)
-			sha, err := OptionalParam[string](request, "sha")
+			path, err := OptionalParam[string](request, "path")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if sha != "" {
-				opts.SHA = github.Ptr(sha)
-			}
 
-			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+
+			diff, resp, err := client.Repositories.CompareCommitsRaw(ctx, owner, repo, base, head, github.RawOptions{Type: github.Diff})
 			if err != nil {
-				return nil, fmt.Errorf("failed to create/update file: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot compare %s...%s in %s/%s, check that both refs exist", base, head, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to compare refs: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 && resp.StatusCode != 201 {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare refs: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(fileContent)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			if path != "" {
+				diff = filterDiffByPath(diff, path)
+			}
+			if diff == "" {
+				if path != "" {
+					return mcp.NewToolResultText(fmt.Sprintf("No changes to %s between %s and %s", path, base, head)), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("No changes between %s and %s", base, head)), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			diff, truncated := truncateText(diff, maxTextResultBytes)
+			if truncated {
+				diff += fmt.Sprintf("\n[diff truncated to %d bytes, pass a path to narrow it down]\n", maxTextResultBytes)
+			}
+
+			return mcp.NewToolResultText(diff), nil
 		}
 }
 
-// CreateRepository creates a tool to create a new GitHub repository.
-func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_repository",
-			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account")),
+// GetCommitDiff creates a tool to get the unified diff of a single commit.
+func GetCommitDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_commit_diff",
+			mcp.WithDescription(t("TOOL_GET_COMMIT_DIFF_DESCRIPTION", fmt.Sprintf("Get the raw unified diff of a single commit of a GitHub repository, against its first parent. The diff is truncated to %d bytes", maxTextResultBytes))),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_CREATE_REPOSITORY_USER_TITLE", "Create repository"),
-				ReadOnlyHint: false,
+				Title:        t("TOOL_GET_COMMIT_DIFF_USER_TITLE", "Get commit diff"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
-			mcp.WithString("name",
+			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository name"),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("description",
-				mcp.Description("Repository description"),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
 			),
-			mcp.WithBoolean("private",
-				mcp.Description("Whether repo should be private"),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("Commit SHA, branch name, or tag name"),
 			),
-			mcp.WithBoolean("autoInit",
-				mcp.Description("Initialize with README"),
+			mcp.WithString("path",
+				mcp.Description("Only include the files at or below this path. The filter is per file: the diff of each matching file is kept whole, the others are dropped"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			name, err := requiredParam[string](request, "name")
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			description, err := OptionalParam[string](request, "description")
+			repo, err := requiredParam[string](request, "repo")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			private, err := OptionalParam[bool](request, "private")
+			sha, err := requiredParam[string](request, "sha")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			autoInit, err := OptionalParam[bool](request, "autoInit")
+			path, err := OptionalParam[string](request, "path")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			repo := &github.Repository{
-				Name:        github.Ptr(name),
-				Description: github.Ptr(description),
-				Private:     github.Ptr(private),
-				AutoInit:    github.Ptr(autoInit),
-			}
-
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			createdRepo, resp, err := client.Repositories.Create(ctx, "", repo)
+
+			diff, resp, err := client.Repositories.GetCommitRaw(ctx, owner, repo, sha, github.RawOptions{Type: github.Diff})
 			if err != nil {
-				return nil, fmt.Errorf("failed to create repository: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("commit %s not found in %s/%s", sha, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get commit diff: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusCreated {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get commit diff: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(createdRepo)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			if path != "" {
+				diff = filterDiffByPath(diff, path)
+			}
+			if diff == "" {
+				if path != "" {
+					return mcp.NewToolResultText(fmt.Sprintf("Commit %s does not change %s", sha, path)), nil
+				}
+				return mcp.NewToolResultText(fmt.Sprintf("Commit %s has no changes", sha)), nil
 			}
 
-			return mcp.NewToolResultText(string(r)), nil
+			diff, truncated := truncateText(diff, maxTextResultBytes)
+			if truncated {
+				diff += fmt.Sprintf("\n[diff truncated to %d bytes, pass a path to narrow it down]\n", maxTextResultBytes)
+			}
+
+			return mcp.NewToolResultText(diff), nil
 		}
 }
 
-// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
-func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+// branchSummary is the compact form of a branch returned by list_branches.
+type branchSummary struct {
+	Name      string `json:"name"`
+	SHA       string `json:"sha"`
+	Protected bool   `json:"protected"`
+}
+
+// ListBranches creates a tool to list branches in a GitHub repository.
+func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_branches",
+			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List branches in a GitHub repository, optionally only the protected ones, with each branch's head commit SHA and protection flag")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_GET_FILE_CONTENTS_USER_TITLE", "Get file or directory contents"),
-				ReadOnlyHint: true,
+				Title:        t("TOOL_LIST_BRANCHES_USER_TITLE", "List branches"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
+				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
-				mcp.Required(),
-				mcp.Description("Path to file/directory"),
-			),
-			mcp.WithString("branch",
-				mcp.Description("Branch to get contents from"),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only list protected branches when true, only unprotected ones when false"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -441,42 +592,54 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := requiredParam[string](request, "path")
+			protected, hasProtected, err := OptionalParamOK[bool](request, "protected")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := OptionalParam[string](request, "branch")
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			opts := &github.BranchListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if hasProtected {
+				opts.Protected = github.Ptr(protected)
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.RepositoryContentGetOptions{Ref: branch}
-			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+
+			branches, resp, err := client.Repositories.ListBranches(ctx, owner, repo, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get file contents: %w", err)
+				return nil, fmt.Errorf("failed to list branches: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 {
+			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get file contents: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list branches: %s", string(body))), nil
 			}
 
-			var result interface{}
-			if fileContent != nil {
-				result = fileContent
-			} else {
-				result = dirContent
+			summaries := make([]branchSummary, 0, len(branches))
+			for _, branch := range branches {
+				summaries = append(summaries, branchSummary{
+					Name:      branch.GetName(),
+					SHA:       branch.GetCommit().GetSHA(),
+					Protected: branch.GetProtected(),
+				})
 			}
 
-			r, err := json.Marshal(result)
+			r, err := json.Marshal(summaries)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -485,13 +648,71 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
-// ForkRepository creates a tool to fork a repository.
-func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("fork_repository",
-			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+// branchProtectionSummary is the subset of a branch's protection rules that matters when pushing to
+// or merging into it.
+type branchProtectionSummary struct {
+	RequiredStatusChecks     []string `json:"required_status_checks"`
+	StrictStatusChecks       bool     `json:"strict_status_checks"`
+	RequiredApprovingReviews int      `json:"required_approving_reviews"`
+	RequireCodeOwnerReviews  bool     `json:"require_code_owner_reviews"`
+	EnforceAdmins            bool     `json:"enforce_admins"`
+	RequireLinearHistory     bool     `json:"require_linear_history"`
+	AllowForcePushes         bool     `json:"allow_force_pushes"`
+	AllowDeletions           bool     `json:"allow_deletions"`
+}
+
+// branchDetails is the result of get_branch.
+type branchDetails struct {
+	Name       string                   `json:"name"`
+	SHA        string                   `json:"sha"`
+	Message    string                   `json:"message"`
+	Author     string                   `json:"author"`
+	Date       *time.Time               `json:"date,omitempty"`
+	IsDefault  bool                     `json:"is_default"`
+	Protected  bool                     `json:"protected"`
+	Protection *branchProtectionSummary `json:"protection,omitempty"`
+	// ProtectionNote tells why the protection rules of a protected branch are missing.
+	ProtectionNote string `json:"protection_note,omitempty"`
+}
+
+// summarizeBranchProtection extracts the protection summary of a branch, nil when it is not protected.
+func summarizeBranchProtection(protection *github.Protection) *branchProtectionSummary {
+	if protection == nil {
+		return nil
+	}
+	summary := &branchProtectionSummary{
+		RequiredStatusChecks: []string{},
+	}
+	if enforceAdmins := protection.GetEnforceAdmins(); enforceAdmins != nil {
+		summary.EnforceAdmins = enforceAdmins.Enabled
+	}
+	if linearHistory := protection.GetRequireLinearHistory(); linearHistory != nil {
+		summary.RequireLinearHistory = linearHistory.Enabled
+	}
+	if forcePushes := protection.GetAllowForcePushes(); forcePushes != nil {
+		summary.AllowForcePushes = forcePushes.Enabled
+	}
+	if deletions := protection.GetAllowDeletions(); deletions != nil {
+		summary.AllowDeletions = deletions.Enabled
+	}
+	if checks := protection.GetRequiredStatusChecks(); checks != nil {
+		summary.StrictStatusChecks = checks.Strict
+		summary.RequiredStatusChecks = requiredCheckContexts(checks)
+	}
+	if reviews := protection.GetRequiredPullRequestReviews(); reviews != nil {
+		summary.RequiredApprovingReviews = reviews.RequiredApprovingReviewCount
+		summary.RequireCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+	}
+	return summary
+}
+
+// GetBranch creates a tool to get a branch of a GitHub repository.
+func GetBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_DESCRIPTION", "Get a branch of a GitHub repository: its head commit SHA, a summary of its protection rules, which needs admin access, and whether it is the default branch")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
-				ReadOnlyHint: false,
+				Title:        t("TOOL_GET_BRANCH_USER_TITLE", "Get branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -501,8 +722,9 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("organization",
-				mcp.Description("Organization to fork to"),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -514,40 +736,1483 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			org, err := OptionalParam[string](request, "organization")
+			branchName, err := requiredParam[string](request, "branch")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.RepositoryCreateForkOptions{}
-			if org != "" {
-				opts.Organization = org
-			}
-
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+
+			// Follow a redirect in case the branch was renamed
+			branch, resp, err := client.Repositories.GetBranch(ctx, owner, repo, branchName, 1)
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Fork is in progress"), nil
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch %s not found in %s/%s, use list_branches to see the available branches", branchName, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			commit := branch.GetCommit()
+			details := branchDetails{
+				Name:      branch.GetName(),
+				SHA:       commit.GetSHA(),
+				Message:   commit.GetCommit().GetMessage(),
+				Author:    commit.GetCommit().GetAuthor().GetName(),
+				IsDefault: branch.GetName() == repository.GetDefaultBranch(),
+				Protected: branch.GetProtected(),
+			}
+			if login := commit.GetAuthor().GetLogin(); login != "" {
+				details.Author = login
+			}
+			if date := commit.GetCommit().GetAuthor().Date; date != nil {
+				details.Date = &date.Time
+			}
+			if branch.GetProtected() {
+				// The branch only carries the required status checks of its protection, the rules
+				// themselves come from the protection endpoint, which needs admin access.
+				protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch.GetName())
+				switch {
+				case err == nil:
+					defer func() { _ = resp.Body.Close() }()
+					details.Protection = summarizeBranchProtection(protection)
+				case errors.Is(err, github.ErrBranchNotProtected):
+				case resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden):
+					details.ProtectionNote = "reading the protection rules requires admin access to the repository"
+				default:
+					return nil, fmt.Errorf("failed to get branch protection: %w", err)
+				}
+			}
+
+			r, err := json.Marshal(details)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
+func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_or_update_file",
+			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository. If updating, you must provide the SHA of the file you want to update.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_OR_UPDATE_FILE_USER_TITLE", "Create or update file"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path where to create/update the file"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the file"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to create/update the file in"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA of file being replaced (for updates)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Convert content to base64
+			contentBytes := []byte(content)
+
+			// Create the file options
+			opts := &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				Content: contentBytes,
+				Branch:  github.Ptr(branch),
+			}
+
+			// If SHA is provided, set it (for updates)
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sha != "" {
+				opts.SHA = github.Ptr(sha)
+			}
+
+			// Create or update the file
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("commit %s to branch %s of %s/%s", path, branch, owner, repo), nil
+			}
+			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create/update file: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 && resp.StatusCode != 201 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(fileContent)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRepository creates a tool to create a new GitHub repository.
+func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_USER_TITLE", "Create repository"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Repository description"),
+			),
+			mcp.WithBoolean("private",
+				mcp.Description("Whether repo should be private"),
+			),
+			mcp.WithBoolean("autoInit",
+				mcp.Description("Initialize with README"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			private, err := OptionalParam[bool](request, "private")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			autoInit, err := OptionalParam[bool](request, "autoInit")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo := &github.Repository{
+				Name:        github.Ptr(name),
+				Description: github.Ptr(description),
+				Private:     github.Ptr(private),
+				AutoInit:    github.Ptr(autoInit),
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("create repository %s", name), nil
+			}
+			createdRepo, resp, err := client.Repositories.Create(ctx, "", repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(createdRepo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
+func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_contents",
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_FILE_CONTENTS_USER_TITLE", "Get file or directory contents"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to file/directory"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch to get contents from"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.RepositoryContentGetOptions{Ref: branch}
+			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get file contents: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get file contents: %s", string(body))), nil
+			}
+
+			var result interface{}
+			if fileContent != nil {
+				result = fileContent
+			} else {
+				result = dirContent
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// rawFileContent is the result of get_raw_file.
+type rawFileContent struct {
+	Path      string `json:"path"`
+	SHA       string `json:"sha"`
+	Size      int    `json:"size"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated"`
+}
+
+// GetRawFile creates a tool to get the text of a file of any size from a GitHub repository.
+func GetRawFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_raw_file",
+			mcp.WithDescription(t("TOOL_GET_RAW_FILE_DESCRIPTION", fmt.Sprintf("Get the text of a file from a GitHub repository through its raw download URL, for files larger than the 1MB get_file_contents supports. The text is truncated to %d bytes", maxTextResultBytes))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_RAW_FILE_USER_TITLE", "Get raw file"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to get the file from, defaults to the default branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The file is looked up in the listing of its directory, which has no size limit, then
+			// downloaded from its raw URL
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			rc, meta, resp, err := client.Repositories.DownloadContentsWithMeta(ctx, owner, repo, path, opts)
+			if err != nil {
+				// A failed download has no response, and a 200 means the directory was listed but the
+				// file is not in it
+				if resp != nil && resp.Response != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusOK) {
+					return mcp.NewToolResultError(fmt.Sprintf("file %s not found in %s/%s", path, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to download file: %w", err)
+			}
+			defer func() { _ = rc.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(rc)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to download file: %s", string(body))), nil
+			}
+
+			// Only read one byte past the limit, enough to know the text is truncated
+			data, err := io.ReadAll(io.LimitReader(rc, maxTextResultBytes+1))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file: %w", err)
+			}
+			// Truncate before the binary check, so a character cut by the limit is not mistaken
+			// for invalid UTF-8
+			content, truncated := truncateText(string(data), maxTextResultBytes)
+			if isBinary([]byte(content)) {
+				return mcp.NewToolResultError(fmt.Sprintf("file %s is not a text file", path)), nil
+			}
+
+			r, err := json.Marshal(rawFileContent{
+				Path:      path,
+				SHA:       meta.GetSHA(),
+				Size:      meta.GetSize(),
+				Content:   content,
+				Truncated: truncated,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ForkRepository creates a tool to fork a repository.
+func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("fork_repository",
+			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("organization",
+				mcp.Description("Organization to fork to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "organization")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryCreateForkOptions{}
+			if org != "" {
+				opts.Organization = org
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("fork %s/%s", owner, repo), nil
+			}
+			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+			if err != nil {
+				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
+				// and it's not a real error.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText("Fork is in progress"), nil
 				}
 				return nil, fmt.Errorf("failed to fork repository: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusAccepted {
+			if resp.StatusCode != http.StatusAccepted {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to fork repository: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(forkedRepo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateBranch creates a tool to create a new branch.
+func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_branch",
+			mcp.WithDescription(t("TOOL_CREATE_BRANCH_DESCRIPTION", "Create a new branch in a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_BRANCH_USER_TITLE", "Create branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name for new branch"),
+			),
+			mcp.WithString("from_branch",
+				mcp.Description("Source branch (defaults to repo default)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fromBranch, err := OptionalParam[string](request, "from_branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Get the source branch SHA
+			var ref *github.Reference
+
+			if fromBranch == "" {
+				// Get default branch if from_branch not specified
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get repository: %w", err)
+				}
+				defer resp.Body.Close()
+
+				fromBranch = *repository.DefaultBranch
+			}
+
+			// Get SHA of source branch
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+fromBranch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get reference: %w", err)
+			}
+			defer resp.Body.Close()
+
+			// Create new branch
+			newRef := &github.Reference{
+				Ref:    github.Ptr("refs/heads/" + branch),
+				Object: &github.GitObject{SHA: ref.Object.SHA},
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("create branch %s from %s at %s in %s/%s", branch, fromBranch, ref.GetObject().GetSHA(), owner, repo), nil
+			}
+			createdRef, resp, err := client.Git.CreateRef(ctx, owner, repo, newRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create branch: %w", err)
+			}
+			defer resp.Body.Close()
+
+			r, err := json.Marshal(createdRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
+func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("push_files",
+			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple files to a GitHub repository in a single commit")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PUSH_FILES_USER_TITLE", "Push files to repository"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to push to"),
+			),
+			mcp.WithArray("files",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"path", "content"},
+						"properties": map[string]interface{}{
+							"path": map[string]interface{}{
+								"type":        "string",
+								"description": "path to the file",
+							},
+							"content": map[string]interface{}{
+								"type":        "string",
+								"description": "file content",
+							},
+						},
+					}),
+				mcp.Description("Array of file objects to push, each object with path (string) and content (string)"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Parse files parameter - this should be an array of objects with path and content
+			filesObj, ok := request.GetArguments()["files"].([]interface{})
+			if !ok {
+				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Get the reference for the branch
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get branch reference: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Get the commit object that the branch points to
+			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get base commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Create tree entries for all files
+			var entries []*github.TreeEntry
+
+			for _, file := range filesObj {
+				fileMap, ok := file.(map[string]interface{})
+				if !ok {
+					return mcp.NewToolResultError("each file must be an object with path and content"), nil
+				}
+
+				path, ok := fileMap["path"].(string)
+				if !ok || path == "" {
+					return mcp.NewToolResultError("each file must have a path"), nil
+				}
+
+				content, ok := fileMap["content"].(string)
+				if !ok {
+					return mcp.NewToolResultError("each file must have content"), nil
+				}
+
+				// Create a tree entry for the file
+				entries = append(entries, &github.TreeEntry{
+					Path:    github.Ptr(path),
+					Mode:    github.Ptr("100644"), // Regular file mode
+					Type:    github.Ptr("blob"),
+					Content: github.Ptr(content),
+				})
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("push %d files to branch %s of %s/%s", len(entries), branch, owner, repo), nil
+			}
+
+			// Create a new tree with the file entries
+			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create tree: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Create a new commit
+			commit := &github.Commit{
+				Message: github.Ptr(message),
+				Tree:    newTree,
+				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
+			}
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Update the reference to point to the new commit
+			ref.Object.SHA = newCommit.SHA
+			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update reference: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(updatedRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepoLanguages creates a tool to get the language breakdown of a repository.
+func GetRepoLanguages(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_languages",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_LANGUAGES_DESCRIPTION", "Get the languages used in a GitHub repository, with the number of bytes of code written in each, sorted from most to least used")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_LANGUAGES_USER_TITLE", "Get repository languages"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list languages: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list languages: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(sortLanguagesByBytes(languages))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// repoLanguage is the number of bytes of code written in a single language.
+type repoLanguage struct {
+	Language string `json:"language"`
+	Bytes    int    `json:"bytes"`
+}
+
+// sortLanguagesByBytes converts the language map returned by the API into a slice
+// sorted by byte count in descending order, breaking ties by language name.
+func sortLanguagesByBytes(languages map[string]int) []repoLanguage {
+	sorted := make([]repoLanguage, 0, len(languages))
+	for language, bytes := range languages {
+		sorted = append(sorted, repoLanguage{Language: language, Bytes: bytes})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Bytes != sorted[j].Bytes {
+			return sorted[i].Bytes > sorted[j].Bytes
+		}
+		return sorted[i].Language < sorted[j].Language
+	})
+	return sorted
+}
+
+// GetCommunityProfile creates a tool to get the community health metrics of a repository.
+func GetCommunityProfile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_community_profile",
+			mcp.WithDescription(t("TOOL_GET_COMMUNITY_PROFILE_DESCRIPTION", "Get the community profile of a GitHub repository, including its health percentage and whether it has a README, LICENSE, CONTRIBUTING guide, code of conduct and issue/pull request templates")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COMMUNITY_PROFILE_USER_TITLE", "Get repository community profile"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			metrics, resp, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get community profile: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get community profile: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(metrics)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetReadme creates a tool to get the decoded README of a repository.
+func GetReadme(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_readme",
+			mcp.WithDescription(t("TOOL_GET_README_DESCRIPTION", "Get the decoded README of a GitHub repository. Use this as a first step to understand what a repository is about")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_README_USER_TITLE", "Get repository README"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit SHA to read the README from (defaults to the default branch)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			readme, resp, err := client.Repositories.GetReadme(ctx, owner, repo, opts)
+			if err != nil {
+				// Not having a README is a normal state for a repository, so report it rather than failing.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					r, err := json.Marshal(map[string]string{
+						"content": "",
+						"note":    fmt.Sprintf("Repository %s/%s does not have a README", owner, repo),
+					})
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal response: %w", err)
+					}
+					return mcp.NewToolResultText(string(r)), nil
+				}
+				return nil, fmt.Errorf("failed to get README: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get README: %s", string(body))), nil
+			}
+
+			content, err := readme.GetContent()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode README: %w", err)
+			}
+
+			r, err := json.Marshal(map[string]string{
+				"name":     readme.GetName(),
+				"path":     readme.GetPath(),
+				"sha":      readme.GetSHA(),
+				"html_url": readme.GetHTMLURL(),
+				"content":  content,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetLicense creates a tool to get the license of a repository.
+func GetLicense(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_license",
+			mcp.WithDescription(t("TOOL_GET_LICENSE_DESCRIPTION", "Get the license of a GitHub repository, including its SPDX identifier and decoded text")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LICENSE_USER_TITLE", "Get repository license"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			license, resp, err := client.Repositories.License(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get license: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get license: %s", string(body))), nil
+			}
+
+			// The license is returned like a file, decode it the same way
+			content, err := (&github.RepositoryContent{
+				Content:  license.Content,
+				Encoding: license.Encoding,
+			}).GetContent()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode license: %w", err)
+			}
+
+			r, err := json.Marshal(map[string]string{
+				"name":     license.GetLicense().GetName(),
+				"spdx_id":  license.GetLicense().GetSPDXID(),
+				"path":     license.GetPath(),
+				"html_url": license.GetHTMLURL(),
+				"content":  content,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// minimalRepository is the compact projection of a repository returned by get_repository by default.
+type minimalRepository struct {
+	FullName      string   `json:"full_name"`
+	Description   string   `json:"description"`
+	DefaultBranch string   `json:"default_branch"`
+	Visibility    string   `json:"visibility"`
+	Stars         int      `json:"stars"`
+	OpenIssues    int      `json:"open_issues"`
+	Language      string   `json:"language"`
+	Topics        []string `json:"topics"`
+}
+
+// minimizeRepository projects a repository onto its compact form.
+func minimizeRepository(repository *github.Repository) minimalRepository {
+	return minimalRepository{
+		FullName:      repository.GetFullName(),
+		Description:   repository.GetDescription(),
+		DefaultBranch: repository.GetDefaultBranch(),
+		Visibility:    repository.GetVisibility(),
+		Stars:         repository.GetStargazersCount(),
+		OpenIssues:    repository.GetOpenIssuesCount(),
+		Language:      repository.GetLanguage(),
+		Topics:        repository.Topics,
+	}
+}
+
+// GetRepository creates a tool to get the metadata of a repository.
+func GetRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_DESCRIPTION", "Get metadata of a GitHub repository. Returns a compact summary by default, set 'full' to get the complete repository object")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_USER_TITLE", "Get repository"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("full",
+				mcp.Description("Return the complete repository object instead of the compact summary"),
+			),
+			mcp.WithBoolean("structured",
+				mcp.Description("Also return the compact summary as structured content, described by the output schema of the tool. Cannot be combined with 'full'"),
+			),
+			mcp.WithOutputSchema[minimalRepository](),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			full, err := OptionalParam[bool](request, "full")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			structured, err := OptionalParam[bool](request, "structured")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if full && structured {
+				return mcp.NewToolResultError("full and structured cannot both be set"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository: %s", string(body))), nil
+			}
+
+			if full {
+				r, err := json.Marshal(repository)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			result := minimizeRepository(repository)
+			if structured && result.Topics == nil {
+				// The output schema declares topics as an array
+				result.Topics = []string{}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			if structured {
+				return mcp.NewToolResultStructured(result, string(r)), nil
+			}
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CherryPick creates a tool to apply a single commit onto a branch.
+//
+// The Git Data API has no cherry-pick endpoint, so the pick is emulated server-side: a temporary
+// branch is pointed at a sibling of the target tip that shares the picked commit's parent, the
+// picked commit is merged into it, and the resulting tree is committed on top of the target branch.
+// That way GitHub applies the commit's patch and reports conflicts instead of us producing a broken tree.
+func CherryPick(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cherry_pick",
+			mcp.WithDescription(t("TOOL_CHERRY_PICK_DESCRIPTION", "Apply a single commit onto a branch of a GitHub repository, like 'git cherry-pick'. Fails without changing the branch if the commit does not apply cleanly")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHERRY_PICK_USER_TITLE", "Cherry-pick commit"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit to cherry-pick"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to apply the commit onto"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Get the commit to cherry-pick
+			picked, resp, err := client.Git.GetCommit(ctx, owner, repo, sha)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if len(picked.Parents) != 1 {
+				return mcp.NewToolResultError(fmt.Sprintf("commit %s has %d parents, only commits with a single parent can be cherry-picked", sha, len(picked.Parents))), nil
+			}
+
+			// Get the tip of the target branch
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get branch reference: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			tip, resp, err := client.Git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get branch commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if isDryRun(ctx) {
+				return dryRunResult("cherry-pick commit %s onto branch %s of %s/%s", sha, branch, owner, repo), nil
+			}
+
+			// Create a sibling of the tip whose parent is the picked commit's parent, so merging
+			// the picked commit into it applies exactly that commit's changes to the tip's tree.
+			sibling, resp, err := client.Git.CreateCommit(ctx, owner, repo, &github.Commit{
+				Message: github.Ptr("temporary cherry-pick commit"),
+				Tree:    &github.Tree{SHA: tip.GetTree().SHA},
+				Parents: []*github.Commit{{SHA: picked.Parents[0].SHA}},
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create temporary commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			tempBranch := fmt.Sprintf("cherry-pick-%s-%s", sha, branch)
+			_, resp, err = client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr("refs/heads/" + tempBranch),
+				Object: &github.GitObject{SHA: sibling.SHA},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create temporary branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			defer func() {
+				resp, err := client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+tempBranch)
+				if err == nil {
+					_ = resp.Body.Close()
+				}
+			}()
+
+			merge, resp, err := client.Repositories.Merge(ctx, owner, repo, &github.RepositoryMergeRequest{
+				Base:          github.Ptr(tempBranch),
+				Head:          github.Ptr(sha),
+				CommitMessage: github.Ptr("temporary cherry-pick merge"),
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					return mcp.NewToolResultError(fmt.Sprintf("commit %s does not apply cleanly onto %s: merge conflict, branch was not changed", sha, branch)), nil
+				}
+				return nil, fmt.Errorf("failed to apply commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to apply commit: %s", string(body))), nil
+			}
+
+			// Commit the merged tree on top of the target branch, keeping the original message and author
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, &github.Commit{
+				Message: picked.Message,
+				Author:  picked.Author,
+				Tree:    &github.Tree{SHA: merge.GetCommit().GetTree().SHA},
+				Parents: []*github.Commit{{SHA: tip.SHA}},
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			ref.Object.SHA = newCommit.SHA
+			_, resp, err = client.Git.UpdateRef(ctx, owner, repo, ref, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newCommit)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RenameBranch creates a tool to rename a branch in a repository.
+func RenameBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("rename_branch",
+			mcp.WithDescription(t("TOOL_RENAME_BRANCH_DESCRIPTION", "Rename a branch in a GitHub repository. Open pull requests and branch protection rules are updated to the new name")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RENAME_BRANCH_USER_TITLE", "Rename branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Current name of the branch"),
+			),
+			mcp.WithString("new_name",
+				mcp.Required(),
+				mcp.Description("New name for the branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			newName, err := requiredParam[string](request, "new_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("rename branch %s of %s/%s to %s", branch, owner, repo, newName), nil
+			}
+			renamed, resp, err := client.Repositories.RenameBranch(ctx, owner, repo, branch, newName)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot rename branch %s to %s: a branch named %s already exists or the name is invalid", branch, newName, newName)), nil
+				}
+				return nil, fmt.Errorf("failed to rename branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to rename branch: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(renamed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// MergeBranch creates a tool to merge one branch into another.
+func MergeBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("merge_branch",
+			mcp.WithDescription(t("TOOL_MERGE_BRANCH_DESCRIPTION", "Merge a branch or commit into a branch of a GitHub repository, e.g. to bring a feature branch up to date with main")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_MERGE_BRANCH_USER_TITLE", "Merge branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch to merge into"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch or commit SHA to merge from"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Message for the merge commit (defaults to GitHub's generated message)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitMessage, err := OptionalParam[string](request, "commit_message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			mergeRequest := &github.RepositoryMergeRequest{
+				Base: github.Ptr(base),
+				Head: github.Ptr(head),
+			}
+			if commitMessage != "" {
+				mergeRequest.CommitMessage = github.Ptr(commitMessage)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("merge %s into %s in %s/%s", head, base, owner, repo), nil
+			}
+			commit, resp, err := client.Repositories.Merge(ctx, owner, repo, mergeRequest)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					return mcp.NewToolResultError(fmt.Sprintf("merge conflict: %s cannot be merged into %s automatically", head, base)), nil
+				}
+				return nil, fmt.Errorf("failed to merge branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			switch resp.StatusCode {
+			case http.StatusNoContent:
+				return mcp.NewToolResultText(fmt.Sprintf("Nothing to merge: %s is already up to date with %s", base, head)), nil
+			case http.StatusCreated:
+			default:
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to fork repository: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to merge branch: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(forkedRepo)
+			r, err := json.Marshal(commit)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -556,28 +2221,25 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
-// CreateBranch creates a tool to create a new branch.
-func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_branch",
-			mcp.WithDescription(t("TOOL_CREATE_BRANCH_DESCRIPTION", "Create a new branch in a GitHub repository")),
+// SyncFork creates a tool to bring a branch of a fork up to date with its upstream repository.
+func SyncFork(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_fork",
+			mcp.WithDescription(t("TOOL_SYNC_FORK_DESCRIPTION", "Bring a branch of a forked GitHub repository up to date with the upstream repository, by fast-forward or merge commit")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_CREATE_BRANCH_USER_TITLE", "Create branch"),
-				ReadOnlyHint: false,
+				Title:        t("TOOL_SYNC_FORK_USER_TITLE", "Sync fork"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner"),
+				mcp.Description("Owner of the fork"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
-				mcp.Description("Repository name"),
+				mcp.Description("Name of the fork"),
 			),
 			mcp.WithString("branch",
 				mcp.Required(),
-				mcp.Description("Name for new branch"),
-			),
-			mcp.WithString("from_branch",
-				mcp.Description("Source branch (defaults to repo default)"),
+				mcp.Description("Branch of the fork to sync"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -593,50 +2255,166 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			fromBranch, err := OptionalParam[string](request, "from_branch")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+			if isDryRun(ctx) {
+				return dryRunResult("sync %s of %s/%s with its upstream repository", branch, owner, repo), nil
+			}
+			result, resp, err := client.Repositories.MergeUpstream(ctx, owner, repo, &github.RepoMergeUpstreamRequest{
+				Branch: github.Ptr(branch),
+			})
+			if err != nil {
+				var errResp *github.ErrorResponse
+				switch {
+				case resp != nil && resp.StatusCode == http.StatusConflict:
+					return mcp.NewToolResultError(fmt.Sprintf("cannot sync %s of %s/%s: it has conflicts with upstream, resolve them in a pull request from the upstream repository", branch, owner, repo)), nil
+				case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp):
+					return mcp.NewToolResultError(fmt.Sprintf("cannot sync %s of %s/%s: %s", branch, owner, repo, errResp.Message)), nil
+				}
+				return nil, fmt.Errorf("failed to sync fork: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
 
-			// Get the source branch SHA
-			var ref *github.Reference
-
-			if fromBranch == "" {
-				// Get default branch if from_branch not specified
-				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
 				if err != nil {
-					return nil, fmt.Errorf("failed to get repository: %w", err)
+					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				defer resp.Body.Close()
+				return mcp.NewToolResultError(fmt.Sprintf("failed to sync fork: %s", string(body))), nil
+			}
 
-				fromBranch = *repository.DefaultBranch
+			upstream := result.GetBaseBranch()
+			switch result.GetMergeType() {
+			case "fast-forward":
+				return mcp.NewToolResultText(fmt.Sprintf("Fast-forwarded %s of %s/%s to %s", branch, owner, repo, upstream)), nil
+			case "merge":
+				return mcp.NewToolResultText(fmt.Sprintf("Merged %s into %s of %s/%s with a merge commit", upstream, branch, owner, repo)), nil
+			default:
+				return mcp.NewToolResultText(fmt.Sprintf("%s of %s/%s is already up to date with %s", branch, owner, repo, upstream)), nil
 			}
+		}
+}
 
-			// Get SHA of source branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+fromBranch)
+// repositoryEvent is the normalized shape of a repository activity event.
+type repositoryEvent struct {
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor"`
+	CreatedAt time.Time `json:"created_at"`
+	Summary   string    `json:"summary"`
+}
+
+// summarizeEvent describes the payload of an event in one line. Events whose payload is not
+// understood fall back to their type, so new event types never break the activity feed.
+func summarizeEvent(event *github.Event) string {
+	payload, err := event.ParsePayload()
+	if err != nil {
+		return event.GetType()
+	}
+
+	switch p := payload.(type) {
+	case *github.PushEvent:
+		size := p.GetSize()
+		if size == 0 {
+			size = len(p.Commits)
+		}
+		return fmt.Sprintf("pushed %d commit(s) to %s", size, strings.TrimPrefix(p.GetRef(), "refs/heads/"))
+	case *github.PullRequestEvent:
+		return fmt.Sprintf("%s pull request #%d: %s", p.GetAction(), p.GetNumber(), p.GetPullRequest().GetTitle())
+	case *github.PullRequestReviewEvent:
+		return fmt.Sprintf("%s review on pull request #%d: %s", p.GetAction(), p.GetPullRequest().GetNumber(), p.GetReview().GetState())
+	case *github.PullRequestReviewCommentEvent:
+		return fmt.Sprintf("commented on pull request #%d: %s", p.GetPullRequest().GetNumber(), p.GetPullRequest().GetTitle())
+	case *github.IssuesEvent:
+		return fmt.Sprintf("%s issue #%d: %s", p.GetAction(), p.GetIssue().GetNumber(), p.GetIssue().GetTitle())
+	case *github.IssueCommentEvent:
+		return fmt.Sprintf("commented on #%d: %s", p.GetIssue().GetNumber(), p.GetIssue().GetTitle())
+	case *github.ForkEvent:
+		return fmt.Sprintf("forked the repository to %s", p.GetForkee().GetFullName())
+	case *github.CreateEvent:
+		return fmt.Sprintf("created %s %s", p.GetRefType(), p.GetRef())
+	case *github.DeleteEvent:
+		return fmt.Sprintf("deleted %s %s", p.GetRefType(), p.GetRef())
+	case *github.ReleaseEvent:
+		return fmt.Sprintf("%s release %s", p.GetAction(), p.GetRelease().GetTagName())
+	case *github.WatchEvent:
+		return "starred the repository"
+	case *github.MemberEvent:
+		return fmt.Sprintf("%s collaborator %s", p.GetAction(), p.GetMember().GetLogin())
+	case *github.PublicEvent:
+		return "made the repository public"
+	default:
+		return event.GetType()
+	}
+}
+
+// ListRepositoryEvents creates a tool to list the recent activity of a repository.
+func ListRepositoryEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_events",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_EVENTS_DESCRIPTION", "List recent activity in a GitHub repository, such as pushes, pull requests, issues and forks, each summarized in one line")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_EVENTS_USER_TITLE", "List repository events"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
-				return nil, fmt.Errorf("failed to get reference: %w", err)
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-			defer resp.Body.Close()
-
-			// Create new branch
-			newRef := &github.Reference{
-				Ref:    github.Ptr("refs/heads/" + branch),
-				Object: &github.GitObject{SHA: ref.Object.SHA},
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			createdRef, resp, err := client.Git.CreateRef(ctx, owner, repo, newRef)
+			client, err := getClient(ctx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create branch: %w", err)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			defer resp.Body.Close()
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+			events, resp, err := client.Activity.ListRepositoryEvents(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list repository events: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(createdRef)
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository events: %s", string(body))), nil
+			}
+
+			normalized := make([]repositoryEvent, 0, len(events))
+			for _, event := range events {
+				normalized = append(normalized, repositoryEvent{
+					Type:      event.GetType(),
+					Actor:     event.GetActor().GetLogin(),
+					CreatedAt: event.GetCreatedAt().Time,
+					Summary:   summarizeEvent(event),
+				})
+			}
+
+			r, err := json.Marshal(normalized)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -645,13 +2423,13 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
-// PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
-func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("push_files",
-			mcp.WithDescription(t("TOOL_PUSH_FILES_DESCRIPTION", "Push multiple files to a GitHub repository in a single commit")),
+// DeleteBranch creates a tool to delete a branch of a GitHub repository.
+func DeleteBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_branch",
+			mcp.WithDescription(t("TOOL_DELETE_BRANCH_DESCRIPTION", "Delete a branch of a GitHub repository, e.g. a feature branch that has been merged. The default branch can never be deleted, and deleting a protected branch requires confirm")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_PUSH_FILES_USER_TITLE", "Push files to repository"),
-				ReadOnlyHint: false,
+				Title:        t("TOOL_DELETE_BRANCH_USER_TITLE", "Delete branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -663,31 +2441,10 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			),
 			mcp.WithString("branch",
 				mcp.Required(),
-				mcp.Description("Branch to push to"),
-			),
-			mcp.WithArray("files",
-				mcp.Required(),
-				mcp.Items(
-					map[string]interface{}{
-						"type":                 "object",
-						"additionalProperties": false,
-						"required":             []string{"path", "content"},
-						"properties": map[string]interface{}{
-							"path": map[string]interface{}{
-								"type":        "string",
-								"description": "path to the file",
-							},
-							"content": map[string]interface{}{
-								"type":        "string",
-								"description": "file content",
-							},
-						},
-					}),
-				mcp.Description("Array of file objects to push, each object with path (string) and content (string)"),
+				mcp.Description("Name of the branch to delete"),
 			),
-			mcp.WithString("message",
-				mcp.Required(),
-				mcp.Description("Commit message"),
+			mcp.WithBoolean("confirm",
+				mcp.Description("Must be true to delete a protected branch"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -699,96 +2456,161 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := requiredParam[string](request, "branch")
+			branchName, err := requiredParam[string](request, "branch")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			message, err := requiredParam[string](request, "message")
+			confirm, err := OptionalParam[bool](request, "confirm")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Parse files parameter - this should be an array of objects with path and content
-			filesObj, ok := request.Params.Arguments["files"].([]interface{})
-			if !ok {
-				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
-			}
-
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			// Get the reference for the branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get branch reference: %w", err)
+				return nil, fmt.Errorf("failed to get repository: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Get the commit object that the branch points to
-			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
+			if branchName == repository.GetDefaultBranch() {
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to delete %s: it is the default branch of %s/%s", branchName, owner, repo)), nil
+			}
+
+			branch, resp, err := client.Repositories.GetBranch(ctx, owner, repo, branchName, 0)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get base commit: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch %s not found in %s/%s, use list_branches to see the available branches", branchName, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get branch: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Create tree entries for all files
-			var entries []*github.TreeEntry
+			if branch.GetProtected() && !confirm {
+				return mcp.NewToolResultError(fmt.Sprintf("branch %s is protected, set confirm to true to delete it anyway", branchName)), nil
+			}
 
-			for _, file := range filesObj {
-				fileMap, ok := file.(map[string]interface{})
-				if !ok {
-					return mcp.NewToolResultError("each file must be an object with path and content"), nil
-				}
+			if isDryRun(ctx) {
+				return dryRunResult("delete branch %s from %s/%s", branchName, owner, repo), nil
+			}
+			resp, err = client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branchName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to delete branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
 
-				path, ok := fileMap["path"].(string)
-				if !ok || path == "" {
-					return mcp.NewToolResultError("each file must have a path"), nil
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete branch: %s", string(body))), nil
+			}
 
-				content, ok := fileMap["content"].(string)
-				if !ok {
-					return mcp.NewToolResultError("each file must have content"), nil
-				}
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted branch %s at %s", branchName, branch.GetCommit().GetSHA())), nil
+		}
+}
 
-				// Create a tree entry for the file
-				entries = append(entries, &github.TreeEntry{
-					Path:    github.Ptr(path),
-					Mode:    github.Ptr("100644"), // Regular file mode
-					Type:    github.Ptr("blob"),
-					Content: github.Ptr(content),
-				})
+// defaultBranchChange is the result of set_default_branch.
+type defaultBranchChange struct {
+	Repository            string `json:"repository"`
+	PreviousDefaultBranch string `json:"previous_default_branch"`
+	DefaultBranch         string `json:"default_branch"`
+}
+
+// SetDefaultBranch creates a tool to change the default branch of a repository to an existing branch.
+func SetDefaultBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_default_branch",
+			mcp.WithDescription(t("TOOL_SET_DEFAULT_BRANCH_DESCRIPTION", "Change the default branch of a GitHub repository to an existing branch, e.g. from master to main. Returns the previous and the new default branch")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_DEFAULT_BRANCH_USER_TITLE", "Set default branch"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to make the default branch, which must exist"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branchName, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Create a new tree with the file entries
-			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
+			client, err := getClient(ctx)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create tree: %w", err)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Create a new commit
-			commit := &github.Commit{
-				Message: github.Ptr(message),
-				Tree:    newTree,
-				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
+			previous := repository.GetDefaultBranch()
+			if branchName == previous {
+				return mcp.NewToolResultText(fmt.Sprintf("%s is already the default branch of %s/%s", branchName, owner, repo)), nil
 			}
-			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+
+			// The API answers a bare 422 for a branch that does not exist, so check it first
+			_, resp, err = client.Repositories.GetBranch(ctx, owner, repo, branchName, 0)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create commit: %w", err)
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("branch %s not found in %s/%s, create it before making it the default branch", branchName, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get branch: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Update the reference to point to the new commit
-			ref.Object.SHA = newCommit.SHA
-			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
+			if isDryRun(ctx) {
+				return dryRunResult("change the default branch of %s/%s from %s to %s", owner, repo, previous, branchName), nil
+			}
+			edited, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{DefaultBranch: github.Ptr(branchName)})
 			if err != nil {
-				return nil, fmt.Errorf("failed to update reference: %w", err)
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot make %s the default branch of %s/%s: %s", branchName, owner, repo, describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to set default branch: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(updatedRef)
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set default branch: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(defaultBranchChange{
+				Repository:            edited.GetFullName(),
+				PreviousDefaultBranch: previous,
+				DefaultBranch:         edited.GetDefaultBranch(),
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
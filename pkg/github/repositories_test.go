@@ -3,7 +3,9 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -136,13 +138,7 @@ func Test_GetFileContents(t *testing.T) {
 
 			// Create call request
 			request := mcp.CallToolRequest{
-				Params: struct {
-					Name      string                 `json:"name"`
-					Arguments map[string]interface{} `json:"arguments,omitempty"`
-					Meta      *struct {
-						ProgressToken mcp.ProgressToken `json:"progressToken,omitempty"`
-					} `json:"_meta,omitempty"`
-				}{
+				Params: mcp.CallToolParams{
 					Arguments: tc.requestArgs,
 				},
 			}
@@ -186,6 +182,171 @@ func Test_GetFileContents(t *testing.T) {
 	}
 }
 
+func Test_GetRawFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRawFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_raw_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
+
+	// A 1.2MB file, too large for the contents API
+	largeFile := strings.Repeat("id,name,value\n", 90000)
+	smallFile := "# Generated\nkey: value\n"
+	rawEndpoint := mock.EndpointPattern{
+		Pattern: "/owner/repo/main/data/{file}",
+		Method:  "GET",
+	}
+	directory := func(name string, size int) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			mock.GetReposContentsByOwnerByRepoByPath,
+			expectQueryParams(t, map[string]string{
+				"ref": "main",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+					{
+						Name: github.Ptr("other.txt"),
+						Path: github.Ptr("data/other.txt"),
+					},
+					{
+						Name:        github.Ptr(name),
+						Path:        github.Ptr("data/" + name),
+						SHA:         github.Ptr("abc123"),
+						Size:        github.Ptr(size),
+						DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/data/" + name),
+					},
+				}),
+			),
+		)
+	}
+	raw := func(content string) mock.MockBackendOption {
+		return mock.WithRequestMatchHandler(
+			rawEndpoint,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(content))
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expected       rawFileContent
+		expectedErrMsg string
+	}{
+		{
+			name: "large file is truncated",
+			mockedClient: mock.NewMockedHTTPClient(
+				directory("big.csv", len(largeFile)),
+				raw(largeFile),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "data/big.csv",
+				"ref":   "main",
+			},
+			expected: rawFileContent{
+				Path:      "data/big.csv",
+				SHA:       "abc123",
+				Size:      len(largeFile),
+				Content:   largeFile[:maxTextResultBytes],
+				Truncated: true,
+			},
+		},
+		{
+			name: "small file",
+			mockedClient: mock.NewMockedHTTPClient(
+				directory("config.yml", len(smallFile)),
+				raw(smallFile),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "data/config.yml",
+				"ref":   "main",
+			},
+			expected: rawFileContent{
+				Path:    "data/config.yml",
+				SHA:     "abc123",
+				Size:    len(smallFile),
+				Content: smallFile,
+			},
+		},
+		{
+			name: "binary file",
+			mockedClient: mock.NewMockedHTTPClient(
+				directory("model.bin", 4),
+				raw("\x00\x01\x02\x03"),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "data/model.bin",
+				"ref":   "main",
+			},
+			expectedErrMsg: "file data/model.bin is not a text file",
+		},
+		{
+			name:         "file not in directory",
+			mockedClient: mock.NewMockedHTTPClient(directory("big.csv", len(largeFile))),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "data/missing.csv",
+				"ref":   "main",
+			},
+			expectedErrMsg: "file data/missing.csv not found in owner/repo",
+		},
+		{
+			name: "directory not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "missing/big.csv",
+			},
+			expectedErrMsg: "file missing/big.csv not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRawFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned rawFileContent
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, returned)
+		})
+	}
+}
+
 func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -600,6 +761,101 @@ func Test_GetCommit(t *testing.T) {
 	}
 }
 
+func Test_CommitVerification(t *testing.T) {
+	signedCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("abc123def456"),
+		Commit: &github.Commit{
+			Message: github.Ptr("Signed commit"),
+			Verification: &github.SignatureVerification{
+				Verified:  github.Ptr(true),
+				Reason:    github.Ptr("valid"),
+				Signature: github.Ptr("-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----"),
+			},
+		},
+	}
+	unsignedCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("def456abc123"),
+		Commit: &github.Commit{
+			Message: github.Ptr("Unsigned commit"),
+			Verification: &github.SignatureVerification{
+				Verified: github.Ptr(false),
+				Reason:   github.Ptr("unsigned"),
+			},
+		},
+	}
+
+	t.Run("get_commit", func(t *testing.T) {
+		tests := []struct {
+			name                 string
+			includeVerification  bool
+			expectedVerification *commitVerification
+		}{
+			{
+				name: "verification omitted by default",
+			},
+			{
+				name:                "verification included",
+				includeVerification: true,
+				expectedVerification: &commitVerification{
+					Verified:      true,
+					Reason:        "valid",
+					SignatureType: "ssh",
+				},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				client := github.NewClient(mock.NewMockedHTTPClient(
+					mock.WithRequestMatch(
+						mock.GetReposCommitsByOwnerByRepoByRef,
+						signedCommit,
+					),
+				))
+				_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+				result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+					"owner":                "owner",
+					"repo":                 "repo",
+					"sha":                  "abc123def456",
+					"include_verification": tc.includeVerification,
+				}))
+				require.NoError(t, err)
+
+				var returned commitDetails
+				err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+				require.NoError(t, err)
+				assert.Equal(t, "abc123def456", returned.GetSHA())
+				assert.Equal(t, tc.expectedVerification, returned.Verification)
+			})
+		}
+	})
+
+	t.Run("list_commits", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposCommitsByOwnerByRepo,
+				[]*github.RepositoryCommit{signedCommit, unsignedCommit},
+			),
+		))
+		_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":                "owner",
+			"repo":                 "repo",
+			"include_verification": true,
+		}))
+		require.NoError(t, err)
+
+		var returned []commitDetails
+		err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returned)
+		require.NoError(t, err)
+		require.Len(t, returned, 2)
+		assert.Equal(t, &commitVerification{Verified: true, Reason: "valid", SignatureType: "ssh"}, returned[0].Verification)
+		assert.Equal(t, &commitVerification{Verified: false, Reason: "unsigned"}, returned[1].Verification)
+	})
+}
+
 func Test_ListCommits(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -774,125 +1030,100 @@ func Test_ListCommits(t *testing.T) {
 	}
 }
 
-func Test_CreateOrUpdateFile(t *testing.T) {
+func Test_GetLatestCommitForPath(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateOrUpdateFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetLatestCommitForPath(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_or_update_file", tool.Name)
+	assert.Equal(t, "get_latest_commit_for_path", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "path")
-	assert.Contains(t, tool.InputSchema.Properties, "content")
-	assert.Contains(t, tool.InputSchema.Properties, "message")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
 	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "content", "message", "branch"})
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
 
-	// Setup mock file content response
-	mockFileResponse := &github.RepositoryContentResponse{
-		Content: &github.RepositoryContent{
-			Name:        github.Ptr("example.md"),
-			Path:        github.Ptr("docs/example.md"),
-			SHA:         github.Ptr("abc123def456"),
-			Size:        github.Ptr(42),
-			HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/docs/example.md"),
-			DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/docs/example.md"),
-		},
-		Commit: github.Commit{
-			SHA:     github.Ptr("def456abc789"),
-			Message: github.Ptr("Add example file"),
-			Author: &github.CommitAuthor{
-				Name:  github.Ptr("Test User"),
-				Email: github.Ptr("test@example.com"),
-				Date:  &github.Timestamp{Time: time.Now()},
+	commitDate := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("abc123def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Update the config loader"),
+				Author: &github.CommitAuthor{
+					Name: github.Ptr("Test User"),
+					Date: &github.Timestamp{Time: commitDate},
+				},
 			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
 		},
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedContent *github.RepositoryContentResponse
-		expectedErrMsg  string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCommit pathCommit
+		expectedErrMsg string
 	}{
 		{
-			name: "successful file creation",
+			name: "latest commit for path",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Add example file",
-						"content": "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4=", // Base64 encoded content
-						"branch":  "main",
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"path":     "pkg/config/loader.go",
+						"sha":      "release",
+						"per_page": "1",
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockFileResponse),
+						mockResponse(t, http.StatusOK, mockCommits),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "# Example\n\nThis is an example file.",
-				"message": "Add example file",
-				"branch":  "main",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "pkg/config/loader.go",
+				"sha":   "release",
+			},
+			expectedCommit: pathCommit{
+				SHA:     "abc123def456",
+				Author:  "Test User",
+				Date:    &commitDate,
+				Message: "Update the config loader",
+				HTMLURL: "https://github.com/owner/repo/commit/abc123def456",
 			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
 		},
 		{
-			name: "successful file update with SHA",
+			name: "path never existed",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Update example file",
-						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
-						"branch":  "main",
-						"sha":     "abc123def456",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockFileResponse),
-					),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					[]*github.RepositoryCommit{},
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "# Updated Example\n\nThis file has been updated.",
-				"message": "Update example file",
-				"branch":  "main",
-				"sha":     "abc123def456",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "missing.go",
 			},
-			expectError:     false,
-			expectedContent: mockFileResponse,
+			expectedErrMsg: "no commit touched missing.go on the default branch in owner/repo, the path may never have existed on that ref",
 		},
 		{
-			name: "file creation fails",
+			name: "list fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PutReposContentsByOwnerByRepoByPath,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid request"}`))
-					}),
+					mock.GetReposCommitsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"path":    "docs/example.md",
-				"content": "#Invalid Content",
-				"message": "Invalid request",
-				"branch":  "nonexistent-branch",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "main.go",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create/update file",
+			expectedErrMsg: "failed to list commits",
 		},
 	}
 
@@ -900,15 +1131,11 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateOrUpdateFile(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := GetLatestCommitForPath(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
 
-			// Verify results
 			if tc.expectError {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tc.expectedErrMsg)
@@ -916,45 +1143,378 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedContent github.RepositoryContentResponse
-			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
-			require.NoError(t, err)
-
-			// Verify content
-			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
-			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
-			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
 
-			// Verify commit
-			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
-			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+			var returned pathCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCommit, returned)
 		})
 	}
 }
 
-func Test_CreateRepository(t *testing.T) {
+func Test_GetDiffBetweenRefs(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetDiffBetweenRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_repository", tool.Name)
+	assert.Equal(t, "get_diff_between_refs", tool.Name)
 	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "name")
-	assert.Contains(t, tool.InputSchema.Properties, "description")
-	assert.Contains(t, tool.InputSchema.Properties, "private")
-	assert.Contains(t, tool.InputSchema.Properties, "autoInit")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"name"})
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
 
-	// Setup mock repository response
-	mockRepo := &github.Repository{
-		Name:        github.Ptr("test-repo"),
-		Description: github.Ptr("Test repository"),
-		Private:     github.Ptr(true),
+	mockDiff := "diff --git a/docs/README.md b/docs/README.md\n" +
+		"index 1111111..2222222 100644\n" +
+		"--- a/docs/README.md\n" +
+		"+++ b/docs/README.md\n" +
+		"@@ -1 +1 @@\n" +
+		"-old\n" +
+		"+new\n" +
+		"diff --git a/src/main.go b/src/main.go\n" +
+		"index 3333333..4444444 100644\n" +
+		"--- a/src/main.go\n" +
+		"+++ b/src/main.go\n" +
+		"@@ -1,2 +1,2 @@\n" +
+		" package main\n" +
+		"-// diff --git a/docs/other b/docs/other\n" +
+		"+// updated\n" +
+		"diff --git a/docs/old.md b/src/moved.md\n" +
+		"similarity index 100%\n" +
+		"rename from docs/old.md\n" +
+		"rename to src/moved.md\n" +
+		"diff --git a/srcfoo/x.go b/srcfoo/x.go\n" +
+		"new file mode 100644\n" +
+		"--- /dev/null\n" +
+		"+++ b/srcfoo/x.go\n" +
+		"@@ -0,0 +1 @@\n" +
+		"+package srcfoo\n"
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "returns the raw diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+						assert.Equal(t, "/repos/owner/repo/compare/main...feature", r.URL.Path)
+						_, _ = w.Write([]byte(mockDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedText: mockDiff,
+		},
+		{
+			name: "filters files by path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+						_, _ = w.Write([]byte(mockDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+				"path":  "src/",
+			},
+			expectedText: "diff --git a/src/main.go b/src/main.go\n" +
+				"index 3333333..4444444 100644\n" +
+				"--- a/src/main.go\n" +
+				"+++ b/src/main.go\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				" package main\n" +
+				"-// diff --git a/docs/other b/docs/other\n" +
+				"+// updated\n" +
+				"diff --git a/docs/old.md b/src/moved.md\n" +
+				"similarity index 100%\n" +
+				"rename from docs/old.md\n" +
+				"rename to src/moved.md\n",
+		},
+		{
+			name: "no changes under the path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(mockDiff))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+				"path":  "cmd",
+			},
+			expectedText: "No changes to cmd between main and feature",
+		},
+		{
+			name: "truncates large diffs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(mockDiff + strings.Repeat("+x\n", maxTextResultBytes)))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "feature",
+			},
+			expectedText: (mockDiff + strings.Repeat("+x\n", maxTextResultBytes))[:maxTextResultBytes] +
+				fmt.Sprintf("\n[diff truncated to %d bytes, pass a path to narrow it down]\n", maxTextResultBytes),
+		},
+		{
+			name: "unknown ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot compare main...missing in owner/repo, check that both refs exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDiffBetweenRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_CreateOrUpdateFile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateOrUpdateFile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_or_update_file", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path", "content", "message", "branch"})
+
+	// Setup mock file content response
+	mockFileResponse := &github.RepositoryContentResponse{
+		Content: &github.RepositoryContent{
+			Name:        github.Ptr("example.md"),
+			Path:        github.Ptr("docs/example.md"),
+			SHA:         github.Ptr("abc123def456"),
+			Size:        github.Ptr(42),
+			HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/docs/example.md"),
+			DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/docs/example.md"),
+		},
+		Commit: github.Commit{
+			SHA:     github.Ptr("def456abc789"),
+			Message: github.Ptr("Add example file"),
+			Author: &github.CommitAuthor{
+				Name:  github.Ptr("Test User"),
+				Email: github.Ptr("test@example.com"),
+				Date:  &github.Timestamp{Time: time.Now()},
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedContent *github.RepositoryContentResponse
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful file creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Add example file",
+						"content": "IyBFeGFtcGxlCgpUaGlzIGlzIGFuIGV4YW1wbGUgZmlsZS4=", // Base64 encoded content
+						"branch":  "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Example\n\nThis is an example file.",
+				"message": "Add example file",
+				"branch":  "main",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "successful file update with SHA",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update example file",
+						"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==", // Base64 encoded content
+						"branch":  "main",
+						"sha":     "abc123def456",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example\n\nThis file has been updated.",
+				"message": "Update example file",
+				"branch":  "main",
+				"sha":     "abc123def456",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "file creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Invalid request"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "#Invalid Content",
+				"message": "Invalid request",
+				"branch":  "nonexistent-branch",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create/update file",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateOrUpdateFile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedContent github.RepositoryContentResponse
+			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+			require.NoError(t, err)
+
+			// Verify content
+			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
+			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
+			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
+
+			// Verify commit
+			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
+			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+		})
+	}
+}
+
+func Test_CreateRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "private")
+	assert.Contains(t, tool.InputSchema.Properties, "autoInit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"name"})
+
+	// Setup mock repository response
+	mockRepo := &github.Repository{
+		Name:        github.Ptr("test-repo"),
+		Description: github.Ptr("Test repository"),
+		Private:     github.Ptr(true),
 		HTMLURL:     github.Ptr("https://github.com/testuser/test-repo"),
 		CloneURL:    github.Ptr("https://github.com/testuser/test-repo.git"),
 		CreatedAt:   &github.Timestamp{Time: time.Now()},
@@ -968,79 +1528,2253 @@ func Test_CreateRepository(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedRepo   *github.Repository
+		expectedRepo   *github.Repository
+		expectedErrMsg string
+	}{
+		{
+			name: "successful repository creation with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "test-repo",
+						"description": "Test repository",
+						"private":     true,
+						"auto_init":   true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name":        "test-repo",
+				"description": "Test repository",
+				"private":     true,
+				"autoInit":    true,
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "successful repository creation with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "test-repo",
+						"auto_init":   false,
+						"description": "",
+						"private":     false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "test-repo",
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "repository creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "invalid-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedRepo github.Repository
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
+			assert.NoError(t, err)
+
+			// Verify repository details
+			assert.Equal(t, *tc.expectedRepo.Name, *returnedRepo.Name)
+			assert.Equal(t, *tc.expectedRepo.Description, *returnedRepo.Description)
+			assert.Equal(t, *tc.expectedRepo.Private, *returnedRepo.Private)
+			assert.Equal(t, *tc.expectedRepo.HTMLURL, *returnedRepo.HTMLURL)
+			assert.Equal(t, *tc.expectedRepo.Owner.Login, *returnedRepo.Owner.Login)
+		})
+	}
+}
+
+func Test_PushFiles(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PushFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "push_files", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "files")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "files", "message"})
+
+	// Setup mock objects
+	mockRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123"),
+			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/abc123"),
+		},
+	}
+
+	mockCommit := &github.Commit{
+		SHA: github.Ptr("abc123"),
+		Tree: &github.Tree{
+			SHA: github.Ptr("def456"),
+		},
+	}
+
+	mockTree := &github.Tree{
+		SHA: github.Ptr("ghi789"),
+	}
+
+	mockNewCommit := &github.Commit{
+		SHA:     github.Ptr("jkl012"),
+		Message: github.Ptr("Update multiple files"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
+	}
+
+	mockUpdatedRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("jkl012"),
+			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/jkl012"),
+		},
+	}
+
+	// Define test cases
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRef    *github.Reference
+		expectedErrMsg string
+	}{
+		{
+			name: "successful push of multiple files",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				// Create tree
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"base_tree": "def456",
+						"tree": []interface{}{
+							map[string]interface{}{
+								"path":    "README.md",
+								"mode":    "100644",
+								"type":    "blob",
+								"content": "# Updated README\n\nThis is an updated README file.",
+							},
+							map[string]interface{}{
+								"path":    "docs/example.md",
+								"mode":    "100644",
+								"type":    "blob",
+								"content": "# Example\n\nThis is an example file.",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTree),
+					),
+				),
+				// Create commit
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Update multiple files",
+						"tree":    "ghi789",
+						"parents": []interface{}{"abc123"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockNewCommit),
+					),
+				),
+				// Update reference
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					expectRequestBody(t, map[string]interface{}{
+						"sha":   "jkl012",
+						"force": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUpdatedRef),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# Updated README\n\nThis is an updated README file.",
+					},
+					map[string]interface{}{
+						"path":    "docs/example.md",
+						"content": "# Example\n\nThis is an example file.",
+					},
+				},
+				"message": "Update multiple files",
+			},
+			expectError: false,
+			expectedRef: mockUpdatedRef,
+		},
+		{
+			name:         "fails when files parameter is invalid",
+			mockedClient: mock.NewMockedHTTPClient(
+			// No requests expected
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"branch":  "main",
+				"files":   "invalid-files-parameter", // Not an array
+				"message": "Update multiple files",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "files parameter must be an array",
+		},
+		{
+			name: "fails when files contains object without path",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"content": "# Missing path",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "each file must have a path",
+		},
+		{
+			name: "fails when files contains object without content",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path": "README.md",
+						// Missing content
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false, // This returns a tool error, not a Go error
+			expectedErrMsg: "each file must have content",
+		},
+		{
+			name: "fails to get branch reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "non-existent-branch",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get branch reference",
+		},
+		{
+			name: "fails to get base commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Fail to get commit
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusNotFound, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get base commit",
+		},
+		{
+			name: "fails to create tree",
+			mockedClient: mock.NewMockedHTTPClient(
+				// Get branch reference
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				// Get commit
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				// Fail to create tree
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create tree",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := PushFiles(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, result)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedRef github.Reference
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
+			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+		})
+	}
+}
+
+func Test_ListBranches(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_branches", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "protected")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock branches for success case
+	mockBranches := []*github.Branch{
+		{
+			Name:      github.Ptr("main"),
+			Commit:    &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+			Protected: github.Ptr(true),
+		},
+		{
+			Name:      github.Ptr("develop"),
+			Commit:    &github.RepositoryCommit{SHA: github.Ptr("def456")},
+			Protected: github.Ptr(false),
+		},
+	}
+
+	// Test cases
+	tests := []struct {
+		name             string
+		args             map[string]interface{}
+		mockResponses    []mock.MockBackendOption
+		wantErr          bool
+		errContains      string
+		expectedBranches []branchSummary
+	}{
+		{
+			name: "success",
+			args: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+				),
+			},
+			wantErr: false,
+			expectedBranches: []branchSummary{
+				{Name: "main", SHA: "abc123", Protected: true},
+				{Name: "develop", SHA: "def456", Protected: false},
+			},
+		},
+		{
+			name: "protected only with pagination",
+			args: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"protected": true,
+				"page":      float64(2),
+				"perPage":   float64(10),
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"protected": "true",
+						"page":      "2",
+						"per_page":  "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches[:1]),
+					),
+				),
+			},
+			wantErr: false,
+			expectedBranches: []branchSummary{
+				{Name: "main", SHA: "abc123", Protected: true},
+			},
+		},
+		{
+			name: "unprotected only",
+			args: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"protected": false,
+			},
+			mockResponses: []mock.MockBackendOption{
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"protected": "false",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches[1:]),
+					),
+				),
+			},
+			wantErr: false,
+			expectedBranches: []branchSummary{
+				{Name: "develop", SHA: "def456", Protected: false},
+			},
+		},
+		{
+			name: "missing owner",
+			args: map[string]interface{}{
+				"repo": "repo",
+			},
+			mockResponses: []mock.MockBackendOption{},
+			wantErr:       false,
+			errContains:   "missing required parameter: owner",
+		},
+		{
+			name: "missing repo",
+			args: map[string]interface{}{
+				"owner": "owner",
+			},
+			mockResponses: []mock.MockBackendOption{},
+			wantErr:       false,
+			errContains:   "missing required parameter: repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			mockClient := github.NewClient(mock.NewMockedHTTPClient(tt.mockResponses...))
+			_, handler := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+			// Create request
+			request := createMCPRequest(tt.args)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			if tt.errContains != "" {
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tt.errContains)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			require.NotEmpty(t, textContent.Text)
+
+			// Verify response
+			var branches []branchSummary
+			err = json.Unmarshal([]byte(textContent.Text), &branches)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBranches, branches)
+		})
+	}
+}
+
+func Test_SummarizeBranchProtection(t *testing.T) {
+	tests := []struct {
+		name       string
+		protection *github.Protection
+		expected   *branchProtectionSummary
+	}{
+		{
+			name:       "no protection",
+			protection: nil,
+			expected:   nil,
+		},
+		{
+			name: "status checks only, as returned by get branch",
+			protection: &github.Protection{
+				RequiredStatusChecks: &github.RequiredStatusChecks{
+					Contexts: &[]string{"ci/build", "ci/test"},
+				},
+			},
+			expected: &branchProtectionSummary{
+				RequiredStatusChecks: []string{"ci/build", "ci/test"},
+			},
+		},
+		{
+			name: "full protection rules",
+			protection: &github.Protection{
+				RequiredStatusChecks: &github.RequiredStatusChecks{
+					Strict:   true,
+					Contexts: &[]string{"ci/build"},
+					Checks: &[]*github.RequiredStatusCheck{
+						{Context: "ci/build"},
+						{Context: "lint"},
+					},
+				},
+				RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+					RequiredApprovingReviewCount: 2,
+					RequireCodeOwnerReviews:      true,
+				},
+				EnforceAdmins:        &github.AdminEnforcement{Enabled: true},
+				RequireLinearHistory: &github.RequireLinearHistory{Enabled: true},
+				AllowForcePushes:     &github.AllowForcePushes{Enabled: false},
+				AllowDeletions:       &github.AllowDeletions{Enabled: true},
+			},
+			expected: &branchProtectionSummary{
+				RequiredStatusChecks:     []string{"ci/build", "lint"},
+				StrictStatusChecks:       true,
+				RequiredApprovingReviews: 2,
+				RequireCodeOwnerReviews:  true,
+				EnforceAdmins:            true,
+				RequireLinearHistory:     true,
+				AllowForcePushes:         false,
+				AllowDeletions:           true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summarizeBranchProtection(tc.protection))
+		})
+	}
+}
+
+func Test_GetBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockBranch := &github.Branch{
+		Name: github.Ptr("main"),
+		Commit: &github.RepositoryCommit{
+			SHA:    github.Ptr("abc123"),
+			Author: &github.User{Login: github.Ptr("octocat")},
+			Commit: &github.Commit{
+				Message: github.Ptr("Fix the build"),
+				Author: &github.CommitAuthor{
+					Name: github.Ptr("The Octocat"),
+					Date: &github.Timestamp{Time: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+		},
+		Protected: github.Ptr(true),
+		Protection: &github.Protection{
+			RequiredStatusChecks: &github.RequiredStatusChecks{
+				Contexts: &[]string{"ci/build"},
+			},
+		},
+	}
+	mockFeatureBranch := &github.Branch{
+		Name: github.Ptr("feature"),
+		Commit: &github.RepositoryCommit{
+			SHA: github.Ptr("def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Add feature"),
+				Author:  &github.CommitAuthor{Name: github.Ptr("Someone")},
+			},
+		},
+		Protected: github.Ptr(false),
+	}
+	mockProtection := &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict:   true,
+			Contexts: &[]string{"ci/build"},
+		},
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 2,
+		},
+		EnforceAdmins:    &github.AdminEnforcement{Enabled: true},
+		AllowForcePushes: &github.AllowForcePushes{Enabled: false},
+	}
+	mockRepo := &github.Repository{DefaultBranch: github.Ptr("main")}
+	commitDate := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedDetails branchDetails
+		expectedErrMsg  string
+	}{
+		{
+			name: "protected default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockBranch,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockProtection,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedDetails: branchDetails{
+				Name:      "main",
+				SHA:       "abc123",
+				Message:   "Fix the build",
+				Author:    "octocat",
+				Date:      &commitDate,
+				IsDefault: true,
+				Protected: true,
+				Protection: &branchProtectionSummary{
+					RequiredStatusChecks:     []string{"ci/build"},
+					StrictStatusChecks:       true,
+					RequiredApprovingReviews: 2,
+					EnforceAdmins:            true,
+				},
+			},
+		},
+		{
+			name: "protection rules need admin access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockBranch,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectError: false,
+			expectedDetails: branchDetails{
+				Name:           "main",
+				SHA:            "abc123",
+				Message:        "Fix the build",
+				Author:         "octocat",
+				Date:           &commitDate,
+				IsDefault:      true,
+				Protected:      true,
+				ProtectionNote: "reading the protection rules requires admin access to the repository",
+			},
+		},
+		{
+			name: "unprotected feature branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockFeatureBranch,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectError: false,
+			expectedDetails: branchDetails{
+				Name:      "feature",
+				SHA:       "def456",
+				Message:   "Add feature",
+				Author:    "Someone",
+				IsDefault: false,
+				Protected: false,
+			},
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "branch missing not found in owner/repo, use list_branches to see the available branches",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var details branchDetails
+			err = json.Unmarshal([]byte(textContent.Text), &details)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDetails, details)
+		})
+	}
+}
+
+func Test_GetRepoLanguages(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepoLanguages(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_languages", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedLanguages []repoLanguage
+		expectedErrMsg    string
+	}{
+		{
+			name: "languages sorted by bytes descending",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLanguagesByOwnerByRepo,
+					map[string]int{
+						"Shell":      120,
+						"Go":         98213,
+						"Dockerfile": 120,
+						"Makefile":   740,
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+			expectedLanguages: []repoLanguage{
+				{Language: "Go", Bytes: 98213},
+				{Language: "Makefile", Bytes: 740},
+				{Language: "Dockerfile", Bytes: 120},
+				{Language: "Shell", Bytes: 120},
+			},
+		},
+		{
+			name: "languages fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLanguagesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list languages",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepoLanguages(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedLanguages []repoLanguage
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLanguages)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLanguages, returnedLanguages)
+		})
+	}
+}
+
+func Test_GetCommunityProfile(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommunityProfile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_community_profile", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockMetrics := &github.CommunityHealthMetrics{
+		HealthPercentage: github.Ptr(71),
+		Files: &github.CommunityHealthFiles{
+			Readme: &github.Metric{
+				HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/README.md"),
+			},
+			License: &github.Metric{
+				Name:   github.Ptr("MIT License"),
+				SPDXID: github.Ptr("MIT"),
+			},
+			Contributing: &github.Metric{
+				HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/CONTRIBUTING.md"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedMetrics *github.CommunityHealthMetrics
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful community profile fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommunityProfileByOwnerByRepo,
+					mockMetrics,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedMetrics: mockMetrics,
+		},
+		{
+			name: "community profile fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommunityProfileByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get community profile",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommunityProfile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedMetrics github.CommunityHealthMetrics
+			err = json.Unmarshal([]byte(textContent.Text), &returnedMetrics)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedMetrics.HealthPercentage, *returnedMetrics.HealthPercentage)
+			require.NotNil(t, returnedMetrics.Files)
+			assert.NotNil(t, returnedMetrics.Files.Readme)
+			assert.Equal(t, "MIT", *returnedMetrics.Files.License.SPDXID)
+			assert.NotNil(t, returnedMetrics.Files.Contributing)
+			assert.Nil(t, returnedMetrics.Files.CodeOfConduct)
+		})
+	}
+}
+
+func Test_GetReadme(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetReadme(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_readme", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockReadme := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Name:     github.Ptr("README.md"),
+		Path:     github.Ptr("README.md"),
+		SHA:      github.Ptr("abc123"),
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/README.md"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr("IyBSZXBv\nCg=="),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedContent string
+		expectedNote    string
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful README fetch with ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReadmeByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"ref": "dev",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReadme),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "dev",
+			},
+			expectError:     false,
+			expectedContent: "# Repo\n",
+		},
+		{
+			name: "repository without README",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReadmeByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedContent: "",
+			expectedNote:    "Repository owner/repo does not have a README",
+		},
+		{
+			name: "README fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReadmeByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get README",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetReadme(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned map[string]string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContent, returned["content"])
+			if tc.expectedNote != "" {
+				assert.Equal(t, tc.expectedNote, returned["note"])
+				return
+			}
+			assert.Equal(t, "README.md", returned["path"])
+			assert.Equal(t, "abc123", returned["sha"])
+		})
+	}
+}
+
+func Test_GetLicense(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetLicense(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_license", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockLicense := &github.RepositoryLicense{
+		Name:     github.Ptr("LICENSE"),
+		Path:     github.Ptr("LICENSE"),
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/LICENSE"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr("TUlUIExp\nY2Vuc2U="),
+		License: &github.License{
+			Key:    github.Ptr("mit"),
+			Name:   github.Ptr("MIT License"),
+			SPDXID: github.Ptr("MIT"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful license fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLicenseByOwnerByRepo,
+					mockLicense,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+		},
+		{
+			name: "license fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get license",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetLicense(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned map[string]string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, "MIT", returned["spdx_id"])
+			assert.Equal(t, "MIT License", returned["name"])
+			assert.Equal(t, "MIT License", returned["content"])
+		})
+	}
+}
+
+func Test_GetRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "full")
+	assert.Contains(t, tool.InputSchema.Properties, "structured")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.Contains(t, string(tool.RawOutputSchema), `"required":["full_name","description","default_branch","visibility","stars","open_issues","language","topics"]`)
+
+	mockRepo := &github.Repository{
+		ID:              github.Ptr(int64(42)),
+		Name:            github.Ptr("repo"),
+		FullName:        github.Ptr("owner/repo"),
+		Description:     github.Ptr("A test repository"),
+		DefaultBranch:   github.Ptr("main"),
+		Visibility:      github.Ptr("public"),
+		StargazersCount: github.Ptr(100),
+		OpenIssuesCount: github.Ptr(7),
+		Language:        github.Ptr("Go"),
+		Topics:          []string{"mcp", "github"},
+		HTMLURL:         github.Ptr("https://github.com/owner/repo"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "minimal repository by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError: false,
+		},
+		{
+			name: "full repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"full":  true,
+			},
+			expectError: false,
+		},
+		{
+			name: "structured minimal repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"structured": true,
+			},
+			expectError: false,
+		},
+		{
+			name: "repository fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if structured, _ := tc.requestArgs["structured"].(bool); structured {
+				assertMatchesOutputSchema(t, tool, result)
+				var fromText minimalRepository
+				err = json.Unmarshal([]byte(textContent.Text), &fromText)
+				require.NoError(t, err)
+				assert.Equal(t, fromText, result.StructuredContent)
+			} else {
+				assert.Nil(t, result.StructuredContent)
+			}
+
+			if full, _ := tc.requestArgs["full"].(bool); full {
+				var returnedRepo github.Repository
+				err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
+				require.NoError(t, err)
+				assert.Equal(t, *mockRepo.ID, *returnedRepo.ID)
+				assert.Equal(t, *mockRepo.HTMLURL, *returnedRepo.HTMLURL)
+				return
+			}
+
+			var returned map[string]interface{}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Len(t, returned, 8)
+			assert.Equal(t, "owner/repo", returned["full_name"])
+			assert.Equal(t, "main", returned["default_branch"])
+			assert.Equal(t, "public", returned["visibility"])
+			assert.Equal(t, float64(100), returned["stars"])
+			assert.Equal(t, float64(7), returned["open_issues"])
+			assert.Equal(t, "Go", returned["language"])
+			assert.Equal(t, []interface{}{"mcp", "github"}, returned["topics"])
+			assert.NotContains(t, returned, "html_url")
+		})
+	}
+
+	t.Run("structured repository without topics", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatch(
+				mock.GetReposByOwnerByRepo,
+				&github.Repository{FullName: github.Ptr("owner/repo")},
+			),
+		))
+		_, handler := GetRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":      "owner",
+			"repo":       "repo",
+			"structured": true,
+		}))
+		require.NoError(t, err)
+
+		assertMatchesOutputSchema(t, tool, result)
+		assert.Equal(t, []string{}, result.StructuredContent.(minimalRepository).Topics)
+	})
+
+	t.Run("full and structured", func(t *testing.T) {
+		_, handler := GetRepository(stubGetClientFn(github.NewClient(mock.NewMockedHTTPClient())), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner":      "owner",
+			"repo":       "repo",
+			"full":       true,
+			"structured": true,
+		}))
+		require.NoError(t, err)
+
+		require.True(t, result.IsError)
+		assert.Equal(t, "full and structured cannot both be set", getTextResult(t, result).Text)
+	})
+}
+
+func Test_CherryPick(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CherryPick(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "cherry_pick", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha", "branch"})
+
+	// Setup mock objects
+	mockPicked := &github.Commit{
+		SHA:     github.Ptr("pick123"),
+		Message: github.Ptr("Fix crash on empty input"),
+		Author: &github.CommitAuthor{
+			Name:  github.Ptr("Test User"),
+			Email: github.Ptr("test@example.com"),
+		},
+		Tree:    &github.Tree{SHA: github.Ptr("picktree")},
+		Parents: []*github.Commit{{SHA: github.Ptr("parent123")}},
+	}
+
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/release"),
+		Object: &github.GitObject{SHA: github.Ptr("tip123")},
+	}
+
+	mockTip := &github.Commit{
+		SHA:  github.Ptr("tip123"),
+		Tree: &github.Tree{SHA: github.Ptr("tiptree")},
+	}
+
+	mockSibling := &github.Commit{
+		SHA: github.Ptr("sibling123"),
+	}
+
+	mockMerge := &github.RepositoryCommit{
+		SHA: github.Ptr("merge123"),
+		Commit: &github.Commit{
+			Tree: &github.Tree{SHA: github.Ptr("mergedtree")},
+		},
+	}
+
+	mockNewCommit := &github.Commit{
+		SHA:     github.Ptr("new123"),
+		Message: github.Ptr("Fix crash on empty input"),
+	}
+
+	deleteTempBranch := mock.WithRequestMatchHandler(
+		mock.DeleteReposGitRefsByOwnerByRepoByRef,
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCommit *github.Commit
+		expectedErrMsg string
+	}{
+		{
+			name: "clean cherry-pick",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockPicked,
+					mockTip,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockSibling,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref": "refs/heads/cherry-pick-pick123-release",
+						"sha": "sibling123",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{
+							Ref: github.Ptr("refs/heads/cherry-pick-pick123-release"),
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"base":           "cherry-pick-pick123-release",
+						"head":           "pick123",
+						"commit_message": "temporary cherry-pick merge",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockMerge),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					expectRequestBody(t, map[string]any{
+						"sha":   "new123",
+						"force": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRef),
+					),
+				),
+				deleteTempBranch,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"sha":    "pick123",
+				"branch": "release",
+			},
+			expectError:    false,
+			expectedCommit: mockNewCommit,
+		},
+		{
+			name: "conflicting cherry-pick",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockPicked,
+					mockTip,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockSibling,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockResponse(t, http.StatusCreated, &github.Reference{
+						Ref: github.Ptr("refs/heads/cherry-pick-pick123-release"),
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergesByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, `{"message": "Merge conflict"}`),
+				),
+				deleteTempBranch,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"sha":    "pick123",
+				"branch": "release",
+			},
+			expectError:    false,
+			expectedErrMsg: "commit pick123 does not apply cleanly onto release: merge conflict, branch was not changed",
+		},
+		{
+			name: "merge commit is rejected",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					&github.Commit{
+						SHA:     github.Ptr("pick123"),
+						Parents: []*github.Commit{{SHA: github.Ptr("a")}, {SHA: github.Ptr("b")}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"sha":    "pick123",
+				"branch": "release",
+			},
+			expectError:    false,
+			expectedErrMsg: "commit pick123 has 2 parents, only commits with a single parent can be cherry-picked",
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"sha":    "missing",
+				"branch": "release",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CherryPick(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCommit github.Commit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
+			assert.Equal(t, *tc.expectedCommit.Message, *returnedCommit.Message)
+		})
+	}
+}
+
+func Test_RenameBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RenameBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "rename_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "new_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "new_name"})
+
+	mockBranch := &github.Branch{
+		Name: github.Ptr("trunk"),
+		Commit: &github.RepositoryCommit{
+			SHA: github.Ptr("abc123"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedBranch *github.Branch
+		expectedErrMsg string
+	}{
+		{
+			name: "successful rename",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposBranchesRenameByOwnerByRepoByBranch,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/branches/master/rename", r.URL.Path)
+						var body map[string]interface{}
+						require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+						assert.Equal(t, map[string]interface{}{"new_name": "trunk"}, body)
+						w.WriteHeader(http.StatusCreated)
+						b, _ := json.Marshal(mockBranch)
+						_, _ = w.Write(b)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "master",
+				"new_name": "trunk",
+			},
+			expectError:    false,
+			expectedBranch: mockBranch,
+		},
+		{
+			name: "new name already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposBranchesRenameByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "master",
+				"new_name": "main",
+			},
+			expectError:    false,
+			expectedErrMsg: "cannot rename branch master to main: a branch named main already exists or the name is invalid",
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposBranchesRenameByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "missing",
+				"new_name": "trunk",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to rename branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RenameBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedBranch github.Branch
+			err = json.Unmarshal([]byte(textContent.Text), &returnedBranch)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedBranch.Name, *returnedBranch.Name)
+			assert.Equal(t, *tc.expectedBranch.Commit.SHA, *returnedBranch.Commit.SHA)
+		})
+	}
+}
+
+func Test_MergeBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := MergeBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "merge_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	mockMergeCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("merge123"),
+		Commit: &github.Commit{
+			Message: github.Ptr("Sync feature with main"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedCommit *github.RepositoryCommit
+		expectedText   string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful repository creation with all parameters",
+			name: "successful merge",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					expectRequestBody(t, map[string]interface{}{
-						"name":        "test-repo",
-						"description": "Test repository",
-						"private":     true,
-						"auto_init":   true,
+					mock.PostReposMergesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"base":           "feature",
+						"head":           "main",
+						"commit_message": "Sync feature with main",
 					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
+						mockResponse(t, http.StatusCreated, mockMergeCommit),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name":        "test-repo",
-				"description": "Test repository",
-				"private":     true,
-				"autoInit":    true,
+				"owner":          "owner",
+				"repo":           "repo",
+				"base":           "feature",
+				"head":           "main",
+				"commit_message": "Sync feature with main",
+			},
+			expectError:    false,
+			expectedCommit: mockMergeCommit,
+		},
+		{
+			name: "already up to date",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "feature",
+				"head":  "main",
 			},
 			expectError:  false,
-			expectedRepo: mockRepo,
+			expectedText: "Nothing to merge: feature is already up to date with main",
+		},
+		{
+			name: "merge conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergesByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, `{"message": "Merge Conflict"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "feature",
+				"head":  "main",
+			},
+			expectError:    false,
+			expectedErrMsg: "merge conflict: main cannot be merged into feature automatically",
+		},
+		{
+			name: "base branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Base does not exist"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "missing",
+				"head":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to merge branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := MergeBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			if tc.expectedText != "" {
+				require.False(t, result.IsError)
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCommit github.RepositoryCommit
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedCommit.SHA, *returnedCommit.SHA)
+		})
+	}
+}
+
+func Test_SyncFork(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SyncFork(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "sync_fork", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mergeResult := func(mergeType string) *github.RepoMergeUpstreamResult {
+		return &github.RepoMergeUpstreamResult{
+			Message:    github.Ptr("Successfully fetched and fast-forwarded from upstream upstream:main."),
+			MergeType:  github.Ptr(mergeType),
+			BaseBranch: github.Ptr("upstream:main"),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "fast-forward",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"branch": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mergeResult("fast-forward")),
+					),
+				),
+			),
+			expectedText: "Fast-forwarded main of owner/repo to upstream:main",
+		},
+		{
+			name: "merge commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mergeResult("merge"),
+				),
+			),
+			expectedText: "Merged upstream:main into main of owner/repo with a merge commit",
+		},
+		{
+			name: "already up to date",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mergeResult("none"),
+				),
+			),
+			expectedText: "main of owner/repo is already up to date with upstream:main",
+		},
+		{
+			name: "conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, `{"message": "There are merge conflicts"}`),
+				),
+			),
+			expectedErrMsg: "cannot sync main of owner/repo: it has conflicts with upstream, resolve them in a pull request from the upstream repository",
+		},
+		{
+			name: "not a fork",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, json.RawMessage(`{"message": "This repository is not a fork"}`)),
+				),
+			),
+			expectedErrMsg: "cannot sync main of owner/repo: This repository is not a fork",
+		},
+		{
+			name: "server error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposMergeUpstreamByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to sync fork",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SyncFork(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_ListRepositoryEvents(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryEvents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_events", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	createdAt := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	rawPayload := func(payload string) *json.RawMessage {
+		raw := json.RawMessage(payload)
+		return &raw
+	}
+
+	mockEvents := []*github.Event{
+		{
+			Type:       github.Ptr("PushEvent"),
+			Actor:      &github.User{Login: github.Ptr("pusher")},
+			CreatedAt:  &github.Timestamp{Time: createdAt},
+			RawPayload: rawPayload(`{"ref": "refs/heads/main", "size": 3, "commits": [{}, {}, {}]}`),
 		},
 		{
-			name: "successful repository creation with minimal parameters",
+			Type:       github.Ptr("PullRequestEvent"),
+			Actor:      &github.User{Login: github.Ptr("author")},
+			CreatedAt:  &github.Timestamp{Time: createdAt},
+			RawPayload: rawPayload(`{"action": "opened", "number": 7, "pull_request": {"title": "Add feature"}}`),
+		},
+		{
+			Type:       github.Ptr("SomeFutureEvent"),
+			Actor:      &github.User{Login: github.Ptr("someone")},
+			CreatedAt:  &github.Timestamp{Time: createdAt},
+			RawPayload: rawPayload(`{}`),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedEvents []repositoryEvent
+		expectedErrMsg string
+	}{
+		{
+			name: "events are normalized",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					expectRequestBody(t, map[string]interface{}{
-						"name":        "test-repo",
-						"auto_init":   false,
-						"description": "",
-						"private":     false,
+					mock.GetReposEventsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
 					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
+						mockResponse(t, http.StatusOK, mockEvents),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name": "test-repo",
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError: false,
+			expectedEvents: []repositoryEvent{
+				{
+					Type:      "PushEvent",
+					Actor:     "pusher",
+					CreatedAt: createdAt,
+					Summary:   "pushed 3 commit(s) to main",
+				},
+				{
+					Type:      "PullRequestEvent",
+					Actor:     "author",
+					CreatedAt: createdAt,
+					Summary:   "opened pull request #7: Add feature",
+				},
+				{
+					Type:      "SomeFutureEvent",
+					Actor:     "someone",
+					CreatedAt: createdAt,
+					Summary:   "SomeFutureEvent",
+				},
 			},
-			expectError:  false,
-			expectedRepo: mockRepo,
 		},
 		{
-			name: "repository creation fails",
+			name: "events listing fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.EndpointPattern{
-						Pattern: "/user/repos",
-						Method:  "POST",
-					},
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
-					}),
+					mock.GetReposEventsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"name": "invalid-repo",
+				"owner": "owner",
+				"repo":  "repo",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create repository",
+			expectedErrMsg: "failed to list repository events",
 		},
 	}
 
@@ -1048,7 +3782,7 @@ func Test_CreateRepository(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListRepositoryEvents(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1064,316 +3798,182 @@ func Test_CreateRepository(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedRepo github.Repository
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
-			assert.NoError(t, err)
-
-			// Verify repository details
-			assert.Equal(t, *tc.expectedRepo.Name, *returnedRepo.Name)
-			assert.Equal(t, *tc.expectedRepo.Description, *returnedRepo.Description)
-			assert.Equal(t, *tc.expectedRepo.Private, *returnedRepo.Private)
-			assert.Equal(t, *tc.expectedRepo.HTMLURL, *returnedRepo.HTMLURL)
-			assert.Equal(t, *tc.expectedRepo.Owner.Login, *returnedRepo.Owner.Login)
+			var returnedEvents []repositoryEvent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEvents)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEvents, returnedEvents)
 		})
 	}
 }
 
-func Test_PushFiles(t *testing.T) {
+func Test_DeleteBranch(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := PushFiles(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := DeleteBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "push_files", tool.Name)
+	assert.Equal(t, "delete_branch", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "branch")
-	assert.Contains(t, tool.InputSchema.Properties, "files")
-	assert.Contains(t, tool.InputSchema.Properties, "message")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "files", "message"})
-
-	// Setup mock objects
-	mockRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123"),
-			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/abc123"),
-		},
-	}
-
-	mockCommit := &github.Commit{
-		SHA: github.Ptr("abc123"),
-		Tree: &github.Tree{
-			SHA: github.Ptr("def456"),
-		},
-	}
-
-	mockTree := &github.Tree{
-		SHA: github.Ptr("ghi789"),
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
 
-	mockNewCommit := &github.Commit{
-		SHA:     github.Ptr("jkl012"),
-		Message: github.Ptr("Update multiple files"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/jkl012"),
+	mockRepo := &github.Repository{DefaultBranch: github.Ptr("main")}
+	mockFeatureBranch := &github.Branch{
+		Name:      github.Ptr("feature"),
+		Commit:    &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+		Protected: github.Ptr(false),
 	}
-
-	mockUpdatedRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("jkl012"),
-			URL: github.Ptr("https://api.github.com/repos/owner/repo/git/trees/jkl012"),
-		},
+	mockProtectedBranch := &github.Branch{
+		Name:      github.Ptr("release"),
+		Commit:    &github.RepositoryCommit{SHA: github.Ptr("def456")},
+		Protected: github.Ptr(true),
 	}
 
-	// Define test cases
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedRef    *github.Reference
+		expectedText   string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful push of multiple files",
+			name: "successful deletion",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Get commit
 				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
-				),
-				// Create tree
-				mock.WithRequestMatchHandler(
-					mock.PostReposGitTreesByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"base_tree": "def456",
-						"tree": []interface{}{
-							map[string]interface{}{
-								"path":    "README.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Updated README\n\nThis is an updated README file.",
-							},
-							map[string]interface{}{
-								"path":    "docs/example.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Example\n\nThis is an example file.",
-							},
-						},
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockTree),
-					),
-				),
-				// Create commit
-				mock.WithRequestMatchHandler(
-					mock.PostReposGitCommitsByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"message": "Update multiple files",
-						"tree":    "ghi789",
-						"parents": []interface{}{"abc123"},
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockNewCommit),
-					),
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockFeatureBranch,
 				),
-				// Update reference
 				mock.WithRequestMatchHandler(
-					mock.PatchReposGitRefsByOwnerByRepoByRef,
-					expectRequestBody(t, map[string]interface{}{
-						"sha":   "jkl012",
-						"force": false,
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockUpdatedRef),
-					),
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/refs/heads/feature", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# Updated README\n\nThis is an updated README file.",
-					},
-					map[string]interface{}{
-						"path":    "docs/example.md",
-						"content": "# Example\n\nThis is an example file.",
-					},
-				},
-				"message": "Update multiple files",
+				"branch": "feature",
 			},
-			expectError: false,
-			expectedRef: mockUpdatedRef,
+			expectError:  false,
+			expectedText: "Deleted branch feature at abc123",
 		},
 		{
-			name:         "fails when files parameter is invalid",
+			name: "refuses to delete the default branch",
 			mockedClient: mock.NewMockedHTTPClient(
-			// No requests expected
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":   "owner",
 				"repo":    "repo",
 				"branch":  "main",
-				"files":   "invalid-files-parameter", // Not an array
-				"message": "Update multiple files",
+				"confirm": true,
 			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "files parameter must be an array",
+			expectError:    false,
+			expectedErrMsg: "refusing to delete main: it is the default branch of owner/repo",
 		},
 		{
-			name: "fails when files contains object without path",
+			name: "protected branch without confirm",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Get commit
 				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockProtectedBranch,
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"content": "# Missing path",
-					},
-				},
-				"message": "Update file",
+				"branch": "release",
 			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "each file must have a path",
+			expectError:    false,
+			expectedErrMsg: "branch release is protected, set confirm to true to delete it anyway",
 		},
 		{
-			name: "fails when files contains object without content",
+			name: "protected branch with confirm",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Get commit
 				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path": "README.md",
-						// Missing content
-					},
-				},
-				"message": "Update file",
-			},
-			expectError:    false, // This returns a tool error, not a Go error
-			expectedErrMsg: "each file must have content",
-		},
-		{
-			name: "fails to get branch reference",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockResponse(t, http.StatusNotFound, nil),
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockProtectedBranch,
 				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "repo",
-				"branch": "non-existent-branch",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"branch":  "release",
+				"confirm": true,
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get branch reference",
+			expectError:  false,
+			expectedText: "Deleted branch release at def456",
 		},
 		{
-			name: "fails to get base commit",
+			name: "branch not found",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Fail to get commit
 				mock.WithRequestMatchHandler(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockResponse(t, http.StatusNotFound, nil),
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not found"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				"branch": "missing",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get base commit",
+			expectError:    false,
+			expectedErrMsg: "branch missing not found in owner/repo, use list_branches to see the available branches",
 		},
 		{
-			name: "fails to create tree",
+			name: "delete fails",
 			mockedClient: mock.NewMockedHTTPClient(
-				// Get branch reference
 				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockRef,
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
 				),
-				// Get commit
 				mock.WithRequestMatch(
-					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
-					mockCommit,
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockFeatureBranch,
 				),
-				// Fail to create tree
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitTreesByOwnerByRepo,
-					mockResponse(t, http.StatusInternalServerError, nil),
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Reference does not exist"}`),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
-				"branch": "main",
-				"files": []interface{}{
-					map[string]interface{}{
-						"path":    "README.md",
-						"content": "# README",
-					},
-				},
-				"message": "Update file",
+				"branch": "feature",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create tree",
+			expectedErrMsg: "failed to delete branch",
 		},
 	}
 
@@ -1381,7 +3981,7 @@ func Test_PushFiles(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := PushFiles(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := DeleteBranch(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1396,135 +3996,288 @@ func Test_PushFiles(t *testing.T) {
 				return
 			}
 
-			if tc.expectedErrMsg != "" {
-				require.NotNil(t, result)
-				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
-				return
-			}
-
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			// Unmarshal and verify the result
-			var returnedRef github.Reference
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
-			require.NoError(t, err)
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
 
-			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
-			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
 		})
 	}
 }
 
-func Test_ListBranches(t *testing.T) {
+func Test_SetDefaultBranch(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SetDefaultBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "list_branches", tool.Name)
+	assert.Equal(t, "set_default_branch", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
 
-	// Setup mock branches for success case
-	mockBranches := []*github.Branch{
+	mockRepo := &github.Repository{FullName: github.Ptr("owner/repo"), DefaultBranch: github.Ptr("master")}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedChange defaultBranchChange
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "branch exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/branches/main", r.URL.Path)
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(&github.Branch{Name: github.Ptr("main")}))
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"default_branch": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{FullName: github.Ptr("owner/repo"), DefaultBranch: github.Ptr("main")}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			},
+			expectedChange: defaultBranchChange{
+				Repository:            "owner/repo",
+				PreviousDefaultBranch: "master",
+				DefaultBranch:         "main",
+			},
+		},
 		{
-			Name:   github.Ptr("main"),
-			Commit: &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+			name: "missing branch is rejected before editing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, `{"message": "Branch not found"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					failOnRequest(t),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "mian",
+			},
+			expectError:    true,
+			expectedErrMsg: "branch mian not found in owner/repo, create it before making it the default branch",
 		},
 		{
-			Name:   github.Ptr("develop"),
-			Commit: &github.RepositoryCommit{SHA: github.Ptr("def456")},
+			name: "already the default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "master",
+			},
+			expectedText: "master is already the default branch of owner/repo",
 		},
 	}
 
-	// Test cases
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SetDefaultBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var returned defaultBranchChange
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedChange, returned)
+		})
+	}
+}
+
+func Test_GetCommitDiff(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommitDiff(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_commit_diff", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	mockDiff := "diff --git a/docs/README.md b/docs/README.md\n" +
+		"index 1111111..2222222 100644\n" +
+		"--- a/docs/README.md\n" +
+		"+++ b/docs/README.md\n" +
+		"@@ -1 +1 @@\n" +
+		"-old\n" +
+		"+new\n" +
+		"diff --git a/src/main.go b/src/main.go\n" +
+		"index 3333333..4444444 100644\n" +
+		"--- a/src/main.go\n" +
+		"+++ b/src/main.go\n" +
+		"@@ -1,2 +1,2 @@\n" +
+		" package main\n" +
+		"-// old\n" +
+		"+// updated\n"
+	// serveDiff serves mockDiff for commit abc123, checking it was asked for as a diff
+	serveDiff := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+		assert.Equal(t, "/repos/owner/repo/commits/abc123", r.URL.Path)
+		_, _ = w.Write([]byte(mockDiff))
+	})
+
 	tests := []struct {
-		name          string
-		args          map[string]interface{}
-		mockResponses []mock.MockBackendOption
-		wantErr       bool
-		errContains   string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
 	}{
 		{
-			name: "success",
-			args: map[string]interface{}{
+			name: "returns the raw diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, serveDiff),
+			),
+			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
-				"page":  float64(2),
+				"sha":   "abc123",
 			},
-			mockResponses: []mock.MockBackendOption{
-				mock.WithRequestMatch(
-					mock.GetReposBranchesByOwnerByRepo,
-					mockBranches,
-				),
+			expectedText: mockDiff,
+		},
+		{
+			name: "filters files by path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, serveDiff),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"path":  "src/main.go",
 			},
-			wantErr: false,
+			expectedText: "diff --git a/src/main.go b/src/main.go\n" +
+				"index 3333333..4444444 100644\n" +
+				"--- a/src/main.go\n" +
+				"+++ b/src/main.go\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				" package main\n" +
+				"-// old\n" +
+				"+// updated\n",
 		},
 		{
-			name: "missing owner",
-			args: map[string]interface{}{
-				"repo": "repo",
+			name: "no changes under the path",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposCommitsByOwnerByRepoByRef, serveDiff),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"path":  "cmd",
 			},
-			mockResponses: []mock.MockBackendOption{},
-			wantErr:       false,
-			errContains:   "missing required parameter: owner",
+			expectedText: "Commit abc123 does not change cmd",
 		},
 		{
-			name: "missing repo",
-			args: map[string]interface{}{
+			name: "truncates large diffs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						_, _ = w.Write([]byte(mockDiff + strings.Repeat("+x\n", maxTextResultBytes)))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
 				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
 			},
-			mockResponses: []mock.MockBackendOption{},
-			wantErr:       false,
-			errContains:   "missing required parameter: repo",
+			expectedText: (mockDiff + strings.Repeat("+x\n", maxTextResultBytes))[:maxTextResultBytes] +
+				fmt.Sprintf("\n[diff truncated to %d bytes, pass a path to narrow it down]\n", maxTextResultBytes),
+		},
+		{
+			name: "commit not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "commit missing not found in owner/repo",
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Create mock client
-			mockClient := github.NewClient(mock.NewMockedHTTPClient(tt.mockResponses...))
-			_, handler := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
-
-			// Create request
-			request := createMCPRequest(tt.args)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommitDiff(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
-			if tt.wantErr {
-				require.Error(t, err)
-				if tt.errContains != "" {
-					assert.Contains(t, err.Error(), tt.errContains)
-				}
-				return
-			}
-
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
 			require.NoError(t, err)
-			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
 
-			if tt.errContains != "" {
-				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, tt.errContains)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
 				return
 			}
-
-			textContent := getTextResult(t, result)
-			require.NotEmpty(t, textContent.Text)
-
-			// Verify response
-			var branches []*github.Branch
-			err = json.Unmarshal([]byte(textContent.Text), &branches)
-			require.NoError(t, err)
-			assert.Len(t, branches, 2)
-			assert.Equal(t, "main", *branches[0].Name)
-			assert.Equal(t, "develop", *branches[1].Name)
+			require.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
 		})
 	}
 }
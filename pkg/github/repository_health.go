@@ -0,0 +1,289 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultStalePullRequestDays is the number of days without update after which
+// check_repository_health counts an open pull request as stale by default.
+const defaultStalePullRequestDays = 30
+
+// Severities of the findings of check_repository_health, most severe first.
+const (
+	healthSeverityHigh   = "high"
+	healthSeverityMedium = "medium"
+	healthSeverityLow    = "low"
+)
+
+var healthSeverityRank = map[string]int{
+	healthSeverityHigh:   0,
+	healthSeverityMedium: 1,
+	healthSeverityLow:    2,
+}
+
+// healthFinding is a hygiene issue found by check_repository_health.
+type healthFinding struct {
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// repositoryHealth is the result of check_repository_health. The checks that could not be run are
+// listed in Unchecked with the reason, rather than failing the others.
+type repositoryHealth struct {
+	Repository    string            `json:"repository"`
+	DefaultBranch string            `json:"default_branch"`
+	Findings      []healthFinding   `json:"findings"`
+	Unchecked     map[string]string `json:"unchecked,omitempty"`
+}
+
+// repoHealthState is what check_repository_health found out about a repository. A nil field is a
+// check that could not be run.
+type repoHealthState struct {
+	Private       bool
+	DefaultBranch string
+	// CommunityFiles tells which of readme, license and contributing the repository has.
+	CommunityFiles    map[string]bool
+	Protected         *bool
+	Workflows         *int
+	StalePullRequests *int
+	StaleDays         int
+}
+
+// communityFileKind returns which of readme, license and contributing a file name is, or the empty
+// string. Names are matched ignoring case and extension, e.g. README.md or LICENSE.txt.
+func communityFileKind(name string) string {
+	base := strings.ToLower(name)
+	if i := strings.Index(base, "."); i > 0 {
+		base = base[:i]
+	}
+	switch base {
+	case "readme":
+		return "readme"
+	case "license", "licence", "copying":
+		return "license"
+	case "contributing":
+		return "contributing"
+	}
+	return ""
+}
+
+// healthFindings returns the hygiene issues of a repository in the given state, most severe first.
+func healthFindings(state repoHealthState) []healthFinding {
+	findings := []healthFinding{}
+	if state.CommunityFiles != nil {
+		if !state.CommunityFiles["readme"] {
+			findings = append(findings, healthFinding{Check: "readme", Severity: healthSeverityHigh, Message: "the repository has no README"})
+		}
+		if !state.CommunityFiles["license"] {
+			// A private repository is not meant to be reused, so a missing license matters less
+			severity := healthSeverityHigh
+			if state.Private {
+				severity = healthSeverityLow
+			}
+			findings = append(findings, healthFinding{Check: "license", Severity: severity, Message: "the repository has no LICENSE"})
+		}
+		if !state.CommunityFiles["contributing"] {
+			findings = append(findings, healthFinding{Check: "contributing", Severity: healthSeverityLow, Message: "the repository has no CONTRIBUTING guide"})
+		}
+	}
+	if state.Protected != nil && !*state.Protected {
+		findings = append(findings, healthFinding{Check: "branch_protection", Severity: healthSeverityHigh, Message: fmt.Sprintf("the default branch %s is not protected", state.DefaultBranch)})
+	}
+	if state.Workflows != nil && *state.Workflows == 0 {
+		findings = append(findings, healthFinding{Check: "ci", Severity: healthSeverityMedium, Message: "the repository has no GitHub Actions workflow"})
+	}
+	if state.StalePullRequests != nil && *state.StalePullRequests > 0 {
+		findings = append(findings, healthFinding{Check: "stale_pull_requests", Severity: healthSeverityLow, Message: fmt.Sprintf("%d open pull requests have not been updated for %d days", *state.StalePullRequests, state.StaleDays)})
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return healthSeverityRank[findings[i].Severity] < healthSeverityRank[findings[j].Severity]
+	})
+	return findings
+}
+
+// healthCommunityFilesOf looks for the README, LICENSE and CONTRIBUTING files of a repository in its
+// root, .github and docs directories, where GitHub looks for them, or returns why it could not.
+func healthCommunityFilesOf(ctx context.Context, client *github.Client, owner, repo string, repository *github.Repository) (map[string]bool, string) {
+	root, missing := overviewTreeOf(ctx, client, owner, repo, repository.GetDefaultBranch())
+	if missing != "" {
+		return nil, missing
+	}
+	files := map[string]bool{"readme": false, "license": false, "contributing": false}
+	add := func(name string) {
+		if kind := communityFileKind(name); kind != "" {
+			files[kind] = true
+		}
+	}
+	var dirs []string
+	for _, entry := range root {
+		switch {
+		case entry.Type == "blob":
+			add(entry.Path)
+		case entry.Type == "tree" && (entry.Path == ".github" || entry.Path == "docs"):
+			dirs = append(dirs, entry.Path)
+		}
+	}
+	for _, dir := range dirs {
+		_, contents, resp, err := client.Repositories.GetContents(ctx, owner, repo, dir, nil)
+		if err != nil {
+			return nil, overviewError(resp, err, fmt.Sprintf("the %s directory could not be read", dir))
+		}
+		_ = resp.Body.Close()
+		for _, content := range contents {
+			if content.GetType() == "file" {
+				add(content.GetName())
+			}
+		}
+	}
+	// GitHub detects the license of a repository from other files too, e.g. a license header
+	if repository.GetLicense() != nil {
+		files["license"] = true
+	}
+	return files, ""
+}
+
+// healthProtectionOf reports whether a branch is protected, or returns why it could not.
+func healthProtectionOf(ctx context.Context, client *github.Client, owner, repo, branch string) (*bool, string) {
+	_, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	if err != nil {
+		if errors.Is(err, github.ErrBranchNotProtected) {
+			return github.Ptr(false), ""
+		}
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return nil, "reading the branch protection requires admin access to the repository"
+		}
+		return nil, err.Error()
+	}
+	_ = resp.Body.Close()
+	return github.Ptr(true), ""
+}
+
+// healthWorkflowsOf counts the GitHub Actions workflows of a repository, or returns why it could not.
+func healthWorkflowsOf(ctx context.Context, client *github.Client, owner, repo string) (*int, string) {
+	workflows, resp, err := client.Actions.ListWorkflows(ctx, owner, repo, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, overviewError(resp, err, "the workflows of the repository are not available")
+	}
+	_ = resp.Body.Close()
+	return github.Ptr(workflows.GetTotalCount()), ""
+}
+
+// healthStalePullRequestsOf counts the open pull requests of a repository not updated since before,
+// or returns why it could not.
+func healthStalePullRequestsOf(ctx context.Context, client *github.Client, owner, repo string, before time.Time) (*int, string) {
+	query := fmt.Sprintf("repo:%s/%s is:pr is:open updated:<%s", owner, repo, before.UTC().Format("2006-01-02"))
+	result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+	if err != nil {
+		return nil, overviewError(resp, err, "the pull requests of the repository could not be searched")
+	}
+	_ = resp.Body.Close()
+	return github.Ptr(result.GetTotal()), ""
+}
+
+// CheckRepositoryHealth creates a tool to check a repository for common hygiene issues.
+func CheckRepositoryHealth(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_repository_health",
+			mcp.WithDescription(t("TOOL_CHECK_REPOSITORY_HEALTH_DESCRIPTION", "Check a GitHub repository for common hygiene issues: a missing README, LICENSE or CONTRIBUTING guide, an unprotected default branch, no GitHub Actions workflow and stale open pull requests. Returns the findings with a severity, most severe first. Checks that cannot be run, e.g. for lack of access, are listed in 'unchecked' with the reason")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_REPOSITORY_HEALTH_USER_TITLE", "Check repository health"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("stale_days",
+				mcp.Description(fmt.Sprintf("Days without update after which an open pull request is stale, defaults to %d", defaultStalePullRequestDays)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			staleDays, err := OptionalIntParamWithDefault(request, "stale_days", defaultStalePullRequestDays)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if staleDays < 1 {
+				return mcp.NewToolResultError("stale_days must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository: %s", string(body))), nil
+			}
+
+			state := repoHealthState{
+				Private:       repository.GetPrivate(),
+				DefaultBranch: repository.GetDefaultBranch(),
+				StaleDays:     staleDays,
+			}
+			unchecked := map[string]string{}
+			var missing string
+			if state.CommunityFiles, missing = healthCommunityFilesOf(ctx, client, owner, repo, repository); missing != "" {
+				unchecked["community_files"] = missing
+			}
+			if state.Protected, missing = healthProtectionOf(ctx, client, owner, repo, state.DefaultBranch); missing != "" {
+				unchecked["branch_protection"] = missing
+			}
+			if state.Workflows, missing = healthWorkflowsOf(ctx, client, owner, repo); missing != "" {
+				unchecked["ci"] = missing
+			}
+			before := time.Now().AddDate(0, 0, -staleDays)
+			if state.StalePullRequests, missing = healthStalePullRequestsOf(ctx, client, owner, repo, before); missing != "" {
+				unchecked["stale_pull_requests"] = missing
+			}
+
+			r, err := json.Marshal(repositoryHealth{
+				Repository:    repository.GetFullName(),
+				DefaultBranch: state.DefaultBranch,
+				Findings:      healthFindings(state),
+				Unchecked:     unchecked,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,237 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommunityFileKind(t *testing.T) {
+	assert.Equal(t, "readme", communityFileKind("README.md"))
+	assert.Equal(t, "readme", communityFileKind("readme"))
+	assert.Equal(t, "license", communityFileKind("LICENSE.txt"))
+	assert.Equal(t, "license", communityFileKind("COPYING"))
+	assert.Equal(t, "contributing", communityFileKind("Contributing.md"))
+	assert.Equal(t, "", communityFileKind(".gitignore"))
+	assert.Equal(t, "", communityFileKind("README-dev.md"))
+}
+
+func Test_HealthFindings(t *testing.T) {
+	allFiles := map[string]bool{"readme": true, "license": true, "contributing": true}
+
+	tests := []struct {
+		name     string
+		state    repoHealthState
+		expected []healthFinding
+	}{
+		{
+			name: "healthy repository",
+			state: repoHealthState{
+				DefaultBranch:     "main",
+				CommunityFiles:    allFiles,
+				Protected:         github.Ptr(true),
+				Workflows:         github.Ptr(2),
+				StalePullRequests: github.Ptr(0),
+				StaleDays:         30,
+			},
+			expected: []healthFinding{},
+		},
+		{
+			name: "neglected public repository, most severe first",
+			state: repoHealthState{
+				DefaultBranch:     "main",
+				CommunityFiles:    map[string]bool{"readme": true, "license": false, "contributing": false},
+				Protected:         github.Ptr(false),
+				Workflows:         github.Ptr(0),
+				StalePullRequests: github.Ptr(3),
+				StaleDays:         14,
+			},
+			expected: []healthFinding{
+				{Check: "license", Severity: "high", Message: "the repository has no LICENSE"},
+				{Check: "branch_protection", Severity: "high", Message: "the default branch main is not protected"},
+				{Check: "ci", Severity: "medium", Message: "the repository has no GitHub Actions workflow"},
+				{Check: "contributing", Severity: "low", Message: "the repository has no CONTRIBUTING guide"},
+				{Check: "stale_pull_requests", Severity: "low", Message: "3 open pull requests have not been updated for 14 days"},
+			},
+		},
+		{
+			name: "missing license matters less in a private repository",
+			state: repoHealthState{
+				Private:        true,
+				CommunityFiles: map[string]bool{"readme": true, "contributing": true},
+			},
+			expected: []healthFinding{
+				{Check: "license", Severity: "low", Message: "the repository has no LICENSE"},
+			},
+		},
+		{
+			name: "checks that could not be run have no findings",
+			state: repoHealthState{
+				DefaultBranch: "main",
+				Workflows:     github.Ptr(0),
+			},
+			expected: []healthFinding{
+				{Check: "ci", Severity: "medium", Message: "the repository has no GitHub Actions workflow"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, healthFindings(tc.state))
+		})
+	}
+}
+
+func Test_CheckRepositoryHealth(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckRepositoryHealth(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "check_repository_health", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "stale_days")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		FullName:      github.Ptr("owner/repo"),
+		DefaultBranch: github.Ptr("main"),
+		License:       &github.License{SPDXID: github.Ptr("MIT")},
+	}
+	mockTree := &github.Tree{
+		Entries: []*github.TreeEntry{
+			{Path: github.Ptr("README.md"), Type: github.Ptr("blob")},
+			{Path: github.Ptr(".github"), Type: github.Ptr("tree")},
+			{Path: github.Ptr("cmd"), Type: github.Ptr("tree")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult repositoryHealth
+		expectedErrMsg string
+	}{
+		{
+			name: "findings of every check",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(mock.GetReposGitTreesByOwnerByRepoByTreeSha, mockTree),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/contents/.github", r.URL.Path)
+						mockResponse(t, http.StatusOK, []*github.RepositoryContent{
+							{Name: github.Ptr("CONTRIBUTING.md"), Path: github.Ptr(".github/CONTRIBUTING.md"), Type: github.Ptr("file")},
+							{Name: github.Ptr("workflows"), Path: github.Ptr(".github/workflows"), Type: github.Ptr("dir")},
+						})(w, r)
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+				mock.WithRequestMatch(mock.GetReposActionsWorkflowsByOwnerByRepo, &github.Workflows{TotalCount: github.Ptr(0)}),
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Contains(t, r.URL.Query().Get("q"), "repo:owner/repo is:pr is:open updated:<")
+						mockResponse(t, http.StatusOK, &github.IssuesSearchResult{Total: github.Ptr(4)})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"stale_days": float64(60),
+			},
+			expectedResult: repositoryHealth{
+				Repository:    "owner/repo",
+				DefaultBranch: "main",
+				Findings: []healthFinding{
+					{Check: "branch_protection", Severity: "high", Message: "the default branch main is not protected"},
+					{Check: "ci", Severity: "medium", Message: "the repository has no GitHub Actions workflow"},
+					{Check: "stale_pull_requests", Severity: "low", Message: "4 open pull requests have not been updated for 60 days"},
+				},
+			},
+		},
+		{
+			name: "checks that fail are reported as unchecked",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+				mock.WithRequestMatch(mock.GetReposActionsWorkflowsByOwnerByRepo, &github.Workflows{TotalCount: github.Ptr(1)}),
+				mock.WithRequestMatch(mock.GetSearchIssues, &github.IssuesSearchResult{Total: github.Ptr(0)}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: repositoryHealth{
+				Repository:    "owner/repo",
+				DefaultBranch: "main",
+				Findings:      []healthFinding{},
+				Unchecked: map[string]string{
+					"community_files":   "the repository is empty",
+					"branch_protection": "reading the branch protection requires admin access to the repository",
+				},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "repository owner/missing not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CheckRepositoryHealth(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned repositoryHealth
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
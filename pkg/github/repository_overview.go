@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultOverviewReadmeBytes is the size the README of get_repository_overview is truncated to by default.
+const defaultOverviewReadmeBytes = 4000
+
+// overviewReadme is the README of a repository overview, truncated.
+type overviewReadme struct {
+	Path      string `json:"path"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// overviewTreeEntry is an entry of the top level tree of a repository overview.
+type overviewTreeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// repositoryOverview is the result of get_repository_overview. The pieces that could not be
+// fetched are left out, with the reason in Missing.
+type repositoryOverview struct {
+	Repository    minimalRepository   `json:"repository"`
+	Readme        *overviewReadme     `json:"readme,omitempty"`
+	Tree          []overviewTreeEntry `json:"tree,omitempty"`
+	Languages     []repoLanguage      `json:"languages,omitempty"`
+	LatestRelease string              `json:"latest_release,omitempty"`
+	Missing       map[string]string   `json:"missing,omitempty"`
+}
+
+// overviewError returns why a piece of an overview could not be fetched, notFound when the API
+// answered 404.
+func overviewError(resp *github.Response, err error, notFound string) string {
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return notFound
+	}
+	return err.Error()
+}
+
+// overviewReadmeOf fetches the README of a repository truncated to maxBytes, or returns why it could not.
+func overviewReadmeOf(ctx context.Context, client *github.Client, owner, repo string, maxBytes int) (*overviewReadme, string) {
+	readme, resp, err := client.Repositories.GetReadme(ctx, owner, repo, nil)
+	if err != nil {
+		return nil, overviewError(resp, err, "the repository has no README")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	content, err := readme.GetContent()
+	if err != nil {
+		return nil, fmt.Sprintf("failed to decode README: %s", err)
+	}
+	content, truncated := truncateText(content, maxBytes)
+	return &overviewReadme{Path: readme.GetPath(), Content: content, Truncated: truncated}, ""
+}
+
+// overviewTreeOf fetches the top level tree of a repository at branch, or returns why it could not.
+func overviewTreeOf(ctx context.Context, client *github.Client, owner, repo, branch string) ([]overviewTreeEntry, string) {
+	tree, resp, err := client.Git.GetTree(ctx, owner, repo, branch, false)
+	if err != nil {
+		// An empty repository has no commit, so no tree
+		return nil, overviewError(resp, err, "the repository is empty")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	entries := make([]overviewTreeEntry, 0, len(tree.Entries))
+	for _, entry := range tree.Entries {
+		entries = append(entries, overviewTreeEntry{Path: entry.GetPath(), Type: entry.GetType()})
+	}
+	return entries, ""
+}
+
+// overviewLanguagesOf fetches the language breakdown of a repository, or returns why it could not.
+func overviewLanguagesOf(ctx context.Context, client *github.Client, owner, repo string) ([]repoLanguage, string) {
+	languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+	if err != nil {
+		return nil, overviewError(resp, err, "the languages of the repository are not available")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if len(languages) == 0 {
+		return nil, "the repository has no code GitHub detects a language of"
+	}
+	return sortLanguagesByBytes(languages), ""
+}
+
+// overviewLatestReleaseOf fetches the tag of the latest release of a repository, or returns why it could not.
+func overviewLatestReleaseOf(ctx context.Context, client *github.Client, owner, repo string) (string, string) {
+	release, resp, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+	if err != nil {
+		return "", overviewError(resp, err, "the repository has no release")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return release.GetTagName(), ""
+}
+
+// GetRepositoryOverview creates a tool to get an overview of a repository in one call.
+func GetRepositoryOverview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_overview",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_OVERVIEW_DESCRIPTION", "Get an overview of a GitHub repository in one call: its compact metadata, truncated README, top level files and directories, languages and latest release tag. Use this first to understand a repository. Pieces that cannot be fetched are left out, with the reason in 'missing'")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_OVERVIEW_USER_TITLE", "Get repository overview"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("readme_max_bytes",
+				mcp.Description(fmt.Sprintf("Size to truncate the README to, defaults to %d", defaultOverviewReadmeBytes)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readmeMaxBytes, err := OptionalIntParamWithDefault(request, "readme_max_bytes", defaultOverviewReadmeBytes)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository: %s", string(body))), nil
+			}
+
+			overview := repositoryOverview{
+				Repository: minimizeRepository(repository),
+				Missing:    map[string]string{},
+			}
+			var missing string
+			if overview.Readme, missing = overviewReadmeOf(ctx, client, owner, repo, readmeMaxBytes); missing != "" {
+				overview.Missing["readme"] = missing
+			}
+			if overview.Tree, missing = overviewTreeOf(ctx, client, owner, repo, repository.GetDefaultBranch()); missing != "" {
+				overview.Missing["tree"] = missing
+			}
+			if overview.Languages, missing = overviewLanguagesOf(ctx, client, owner, repo); missing != "" {
+				overview.Missing["languages"] = missing
+			}
+			if overview.LatestRelease, missing = overviewLatestReleaseOf(ctx, client, owner, repo); missing != "" {
+				overview.Missing["latest_release"] = missing
+			}
+
+			r, err := json.Marshal(overview)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryOverview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryOverview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_overview", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "readme_max_bytes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		FullName:        github.Ptr("owner/repo"),
+		Description:     github.Ptr("A test repository"),
+		DefaultBranch:   github.Ptr("main"),
+		Visibility:      github.Ptr("public"),
+		StargazersCount: github.Ptr(42),
+		Language:        github.Ptr("Go"),
+		Topics:          []string{"mcp"},
+	}
+	notFound := mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "every piece of the overview",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatch(
+					mock.GetReposReadmeByOwnerByRepo,
+					&github.RepositoryContent{
+						Path:     github.Ptr("README.md"),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte("# Repo\n\n" + strings.Repeat("a", 20)))),
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTreesByOwnerByRepoByTreeSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/trees/main", r.URL.Path)
+						assert.Empty(t, r.URL.Query().Get("recursive"))
+						mockResponse(t, http.StatusOK, &github.Tree{
+							Entries: []*github.TreeEntry{
+								{Path: github.Ptr("README.md"), Type: github.Ptr("blob")},
+								{Path: github.Ptr("cmd"), Type: github.Ptr("tree")},
+							},
+						})(w, r)
+					}),
+				),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{"Shell": 100, "Go": 5000}),
+				mock.WithRequestMatch(mock.GetReposReleasesLatestByOwnerByRepo, &github.RepositoryRelease{TagName: github.Ptr("v1.2.0")}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"readme_max_bytes": float64(10),
+			},
+			expectedResult: map[string]any{
+				"repository": map[string]any{
+					"full_name":      "owner/repo",
+					"description":    "A test repository",
+					"default_branch": "main",
+					"visibility":     "public",
+					"stars":          float64(42),
+					"open_issues":    float64(0),
+					"language":       "Go",
+					"topics":         []any{"mcp"},
+				},
+				"readme": map[string]any{
+					"path":      "README.md",
+					"content":   "# Repo\n\naa",
+					"truncated": true,
+				},
+				"tree": []any{
+					map[string]any{"path": "README.md", "type": "blob"},
+					map[string]any{"path": "cmd", "type": "tree"},
+				},
+				"languages": []any{
+					map[string]any{"language": "Go", "bytes": float64(5000)},
+					map[string]any{"language": "Shell", "bytes": float64(100)},
+				},
+				"latest_release": "v1.2.0",
+			},
+		},
+		{
+			name: "missing pieces are left out with their reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposByOwnerByRepo, mockRepo),
+				mock.WithRequestMatchHandler(mock.GetReposReadmeByOwnerByRepo, notFound),
+				mock.WithRequestMatchHandler(mock.GetReposGitTreesByOwnerByRepoByTreeSha, notFound),
+				mock.WithRequestMatch(mock.GetReposLanguagesByOwnerByRepo, map[string]int{}),
+				mock.WithRequestMatchHandler(mock.GetReposReleasesLatestByOwnerByRepo, notFound),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: map[string]any{
+				"repository": map[string]any{
+					"full_name":      "owner/repo",
+					"description":    "A test repository",
+					"default_branch": "main",
+					"visibility":     "public",
+					"stars":          float64(42),
+					"open_issues":    float64(0),
+					"language":       "Go",
+					"topics":         []any{"mcp"},
+				},
+				"missing": map[string]any{
+					"readme":         "the repository has no README",
+					"tree":           "the repository is empty",
+					"languages":      "the repository has no code GitHub detects a language of",
+					"latest_release": "the repository has no release",
+				},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposByOwnerByRepo, notFound),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "repository owner/repo not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryOverview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,456 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// rulesetSummary is the compact form of a ruleset returned by list_repository_rulesets.
+type rulesetSummary struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target"`
+	Enforcement string `json:"enforcement"`
+	SourceType  string `json:"source_type"`
+	Source      string `json:"source"`
+}
+
+// rulesetsUnavailable returns a tool error when err is the API refusing rulesets because of the
+// plan of the repository, which it reports as a 403 asking to upgrade.
+func rulesetsUnavailable(err error, resp *github.Response, owner, repo string) (*mcp.CallToolResult, bool) {
+	var errResp *github.ErrorResponse
+	if resp == nil || resp.StatusCode != http.StatusForbidden || !errors.As(err, &errResp) {
+		return nil, false
+	}
+	if !strings.Contains(strings.ToLower(errResp.Message), "upgrade") {
+		return nil, false
+	}
+	return mcp.NewToolResultError(fmt.Sprintf("rulesets are not available for %s/%s on its current plan: %s", owner, repo, errResp.Message)), true
+}
+
+// qualifyRefPatterns turns short branch or tag patterns such as main or release/* into the full
+// ref patterns rulesets expect. Full refs and the special ~DEFAULT_BRANCH and ~ALL patterns are
+// kept as they are.
+func qualifyRefPatterns(patterns []string, target github.RulesetTarget) []string {
+	prefix := "refs/heads/"
+	if target == github.RulesetTargetTag {
+		prefix = "refs/tags/"
+	}
+	qualified := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if strings.HasPrefix(pattern, "refs/") || strings.HasPrefix(pattern, "~") {
+			qualified = append(qualified, pattern)
+			continue
+		}
+		qualified = append(qualified, prefix+pattern)
+	}
+	return qualified
+}
+
+// parseRulesetRules converts the rules parameter, a list of {type, parameters} objects as in the
+// REST API, into ruleset rules. The go-github decoder ignores rule types it does not know, so each
+// rule is checked on its own to report them instead of silently dropping them.
+func parseRulesetRules(value any) (*github.RepositoryRulesetRules, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+	var rules []json.RawMessage
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("rules must be a list of objects with a type and parameters")
+	}
+	for _, rule := range rules {
+		var single github.RepositoryRulesetRules
+		if err := json.Unmarshal([]byte("["+string(rule)+"]"), &single); err != nil {
+			return nil, fmt.Errorf("invalid rule %s: %w", string(rule), err)
+		}
+		encoded, err := json.Marshal(&single)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %s: %w", string(rule), err)
+		}
+		if bytes.Equal(encoded, []byte("[]")) {
+			return nil, fmt.Errorf("unsupported rule %s", string(rule))
+		}
+	}
+
+	var parsed github.RepositoryRulesetRules
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+	return &parsed, nil
+}
+
+// parseBypassActors converts the bypass_actors parameter into ruleset bypass actors.
+func parseBypassActors(value any) ([]*github.BypassActor, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bypass_actors: %w", err)
+	}
+	var actors []*github.BypassActor
+	if err := json.Unmarshal(data, &actors); err != nil {
+		return nil, fmt.Errorf("bypass_actors must be a list of objects with an actor_type, actor_id and bypass_mode")
+	}
+	for _, actor := range actors {
+		if actor.ActorType == nil {
+			return nil, fmt.Errorf("bypass actor is missing its actor_type")
+		}
+	}
+	return actors, nil
+}
+
+// ListRepoRulesets creates a tool to list the rulesets of a repository.
+func ListRepoRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets of a GitHub repository, the newer replacement for branch protection")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("include_parents",
+				mcp.Description("Also list the organization and enterprise rulesets that apply to the repository"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeParents, err := OptionalParam[bool](request, "include_parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, includeParents)
+			if err != nil {
+				if result, ok := rulesetsUnavailable(err, resp, owner, repo); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list rulesets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list rulesets: %s", string(body))), nil
+			}
+
+			summaries := make([]rulesetSummary, 0, len(rulesets))
+			for _, ruleset := range rulesets {
+				summary := rulesetSummary{
+					ID:          ruleset.GetID(),
+					Name:        ruleset.Name,
+					Enforcement: string(ruleset.Enforcement),
+					Source:      ruleset.Source,
+				}
+				if ruleset.Target != nil {
+					summary.Target = string(*ruleset.Target)
+				}
+				if ruleset.SourceType != nil {
+					summary.SourceType = string(*ruleset.SourceType)
+				}
+				summaries = append(summaries, summary)
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepoRuleset creates a tool to get a ruleset of a repository with its conditions and rules.
+func GetRepoRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_ruleset",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION", "Get a ruleset of a GitHub repository with its bypass actors, ref conditions and rules")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_RULESET_USER_TITLE", "Get repository ruleset"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("The ID of the ruleset"),
+			),
+			mcp.WithBoolean("include_parents",
+				mcp.Description("Also look up organization and enterprise rulesets that apply to the repository"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeParents, err := OptionalParam[bool](request, "include_parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), includeParents)
+			if err != nil {
+				if result, ok := rulesetsUnavailable(err, resp, owner, repo); ok {
+					return result, nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("ruleset %d not found in %s/%s", rulesetID, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(ruleset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRepoRuleset creates a tool to create a ruleset in a repository.
+func CreateRepoRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_ruleset",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_RULESET_DESCRIPTION", "Create a ruleset in a GitHub repository, applying rules to the branches or tags matching the ref patterns")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_RULESET_USER_TITLE", "Create repository ruleset"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the ruleset"),
+			),
+			mcp.WithString("target",
+				mcp.Description("What the ruleset applies to (default branch)"),
+				mcp.Enum("branch", "tag", "push"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Required(),
+				mcp.Description("Whether the ruleset is enforced, only evaluated, or disabled"),
+				mcp.Enum("active", "evaluate", "disabled"),
+			),
+			mcp.WithArray("include_refs",
+				mcp.Description("Branch or tag name patterns the ruleset applies to, e.g. main or release/*. Full refs and ~DEFAULT_BRANCH or ~ALL are also accepted. Defaults to ~DEFAULT_BRANCH for branches and ~ALL for tags"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("exclude_refs",
+				mcp.Description("Branch or tag name patterns excluded from the ruleset"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("bypass_actors",
+				mcp.Description("Actors allowed to bypass the ruleset"),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"actor_type", "bypass_mode"},
+						"properties": map[string]interface{}{
+							"actor_id": map[string]interface{}{
+								"type":        "number",
+								"description": "ID of the team, app or repository role, omitted for OrganizationAdmin",
+							},
+							"actor_type": map[string]interface{}{
+								"type": "string",
+								"enum": []string{"Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey"},
+							},
+							"bypass_mode": map[string]interface{}{
+								"type": "string",
+								"enum": []string{"always", "pull_request"},
+							},
+						},
+					},
+				),
+			),
+			mcp.WithArray("rules",
+				mcp.Required(),
+				mcp.Description("Rules of the ruleset as in the REST API, e.g. [{\"type\": \"deletion\"}, {\"type\": \"pull_request\", \"parameters\": {\"required_approving_review_count\": 1}}]"),
+				mcp.Items(
+					map[string]interface{}{
+						"type":     "object",
+						"required": []string{"type"},
+						"properties": map[string]interface{}{
+							"type": map[string]interface{}{
+								"type": "string",
+							},
+							"parameters": map[string]interface{}{
+								"type": "object",
+							},
+						},
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			target, err := OptionalParam[string](request, "target")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if target == "" {
+				target = string(github.RulesetTargetBranch)
+			}
+			enforcement, err := requiredParam[string](request, "enforcement")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeRefs, err := OptionalStringArrayParam(request, "include_refs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			excludeRefs, err := OptionalStringArrayParam(request, "exclude_refs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			bypassActors, err := parseBypassActors(request.GetArguments()["bypass_actors"])
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := request.GetArguments()["rules"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: rules"), nil
+			}
+			rules, err := parseRulesetRules(request.GetArguments()["rules"])
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			ruleset := github.RepositoryRuleset{
+				Name:         name,
+				Target:       github.Ptr(github.RulesetTarget(target)),
+				Enforcement:  github.RulesetEnforcement(enforcement),
+				BypassActors: bypassActors,
+				Rules:        rules,
+			}
+			switch github.RulesetTarget(target) {
+			case github.RulesetTargetBranch, github.RulesetTargetTag:
+				if len(includeRefs) == 0 {
+					includeRefs = []string{"~DEFAULT_BRANCH"}
+					if target == string(github.RulesetTargetTag) {
+						includeRefs = []string{"~ALL"}
+					}
+				}
+				ruleset.Conditions = &github.RepositoryRulesetConditions{
+					RefName: &github.RepositoryRulesetRefConditionParameters{
+						Include: qualifyRefPatterns(includeRefs, github.RulesetTarget(target)),
+						Exclude: qualifyRefPatterns(excludeRefs, github.RulesetTarget(target)),
+					},
+				}
+			case github.RulesetTargetPush:
+				// Push rulesets apply to every push to the repository, they have no ref condition
+				if len(includeRefs) > 0 || len(excludeRefs) > 0 {
+					return mcp.NewToolResultError("include_refs and exclude_refs do not apply to push rulesets"), nil
+				}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid target: %s, must be one of branch, tag or push", target)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if isDryRun(ctx) {
+				return dryRunResult("create ruleset %s in %s/%s", name, owner, repo), nil
+			}
+			created, resp, err := client.Repositories.CreateRuleset(ctx, owner, repo, ruleset)
+			if err != nil {
+				if result, ok := rulesetsUnavailable(err, resp, owner, repo); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create ruleset: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,414 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QualifyRefPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		target   github.RulesetTarget
+		expected []string
+	}{
+		{
+			name:     "branch names get the heads prefix",
+			patterns: []string{"main", "release/*"},
+			target:   github.RulesetTargetBranch,
+			expected: []string{"refs/heads/main", "refs/heads/release/*"},
+		},
+		{
+			name:     "tag names get the tags prefix",
+			patterns: []string{"v*"},
+			target:   github.RulesetTargetTag,
+			expected: []string{"refs/tags/v*"},
+		},
+		{
+			name:     "full refs are kept",
+			patterns: []string{"refs/heads/main", "refs/tags/v1"},
+			target:   github.RulesetTargetBranch,
+			expected: []string{"refs/heads/main", "refs/tags/v1"},
+		},
+		{
+			name:     "special patterns are kept",
+			patterns: []string{"~DEFAULT_BRANCH", "~ALL"},
+			target:   github.RulesetTargetTag,
+			expected: []string{"~DEFAULT_BRANCH", "~ALL"},
+		},
+		{
+			name:     "no patterns",
+			patterns: nil,
+			target:   github.RulesetTargetBranch,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, qualifyRefPatterns(tc.patterns, tc.target))
+		})
+	}
+}
+
+func Test_ListRepoRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepoRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "include_parents")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []*github.RepositoryRuleset{
+		{
+			ID:          github.Ptr(int64(42)),
+			Name:        "protect main",
+			Target:      github.Ptr(github.RulesetTargetBranch),
+			Enforcement: github.RulesetEnforcementActive,
+			SourceType:  github.Ptr(github.RulesetSourceTypeRepository),
+			Source:      "owner/repo",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedRulesets []rulesetSummary
+		expectedErrMsg   string
+	}{
+		{
+			name: "lists rulesets",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"include_parents": true,
+			},
+			expectedRulesets: []rulesetSummary{
+				{
+					ID:          42,
+					Name:        "protect main",
+					Target:      "branch",
+					Enforcement: "active",
+					SourceType:  "Repository",
+					Source:      "owner/repo",
+				},
+			},
+		},
+		{
+			name: "rulesets not available on the plan",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, json.RawMessage(`{"message": "Upgrade to GitHub Pro or make this repository public to enable this feature."}`)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "rulesets are not available for owner/repo on its current plan: Upgrade to GitHub Pro or make this repository public to enable this feature.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepoRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned []rulesetSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRulesets, returned)
+		})
+	}
+}
+
+func Test_GetRepoRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepoRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	mockRuleset := json.RawMessage(`{
+		"id": 42,
+		"name": "protect main",
+		"target": "branch",
+		"enforcement": "active",
+		"conditions": {"ref_name": {"include": ["refs/heads/main"], "exclude": []}},
+		"rules": [{"type": "deletion"}]
+	}`)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name: "gets the ruleset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockResponse(t, http.StatusOK, mockRuleset),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(42),
+			},
+		},
+		{
+			name: "ruleset not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": float64(7),
+			},
+			expectedErrMsg: "ruleset 7 not found in owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepoRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned github.RepositoryRuleset
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, "protect main", returned.Name)
+			assert.Equal(t, []string{"refs/heads/main"}, returned.Conditions.RefName.Include)
+			assert.NotNil(t, returned.Rules.Deletion)
+		})
+	}
+}
+
+func Test_CreateRepoRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepoRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "include_refs")
+	assert.Contains(t, tool.InputSchema.Properties, "exclude_refs")
+	assert.Contains(t, tool.InputSchema.Properties, "bypass_actors")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name", "enforcement", "rules"})
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+
+	createdRuleset := json.RawMessage(`{"id": 43, "name": "protect releases", "target": "branch", "enforcement": "active"}`)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name: "creates a branch ruleset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposRulesetsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "protect releases",
+						"target":      "branch",
+						"source":      "",
+						"enforcement": "active",
+						"bypass_actors": []interface{}{
+							map[string]interface{}{
+								"actor_id":    float64(5),
+								"actor_type":  "Team",
+								"bypass_mode": "pull_request",
+							},
+						},
+						"conditions": map[string]interface{}{
+							"ref_name": map[string]interface{}{
+								"include": []interface{}{"refs/heads/release/*", "~DEFAULT_BRANCH"},
+								"exclude": []interface{}{"refs/heads/release/old"},
+							},
+						},
+						"rules": []interface{}{
+							map[string]interface{}{"type": "deletion"},
+							map[string]interface{}{
+								"type": "pull_request",
+								"parameters": map[string]interface{}{
+									"allowed_merge_methods":             nil,
+									"dismiss_stale_reviews_on_push":     false,
+									"require_code_owner_review":         false,
+									"require_last_push_approval":        false,
+									"required_approving_review_count":   float64(1),
+									"required_review_thread_resolution": false,
+								},
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, createdRuleset),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"name":         "protect releases",
+				"enforcement":  "active",
+				"include_refs": []interface{}{"release/*", "~DEFAULT_BRANCH"},
+				"exclude_refs": []interface{}{"release/old"},
+				"bypass_actors": []interface{}{
+					map[string]interface{}{"actor_id": float64(5), "actor_type": "Team", "bypass_mode": "pull_request"},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{"type": "deletion"},
+					map[string]interface{}{"type": "pull_request", "parameters": map[string]interface{}{"required_approving_review_count": float64(1)}},
+				},
+			},
+		},
+		{
+			name: "tag ruleset defaults to all tags",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposRulesetsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"name":        "protect releases",
+						"target":      "tag",
+						"source":      "",
+						"enforcement": "evaluate",
+						"conditions": map[string]interface{}{
+							"ref_name": map[string]interface{}{
+								"include": []interface{}{"~ALL"},
+								"exclude": []interface{}{},
+							},
+						},
+						"rules": []interface{}{
+							map[string]interface{}{"type": "deletion"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, createdRuleset),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "protect releases",
+				"target":      "tag",
+				"enforcement": "evaluate",
+				"rules": []interface{}{
+					map[string]interface{}{"type": "deletion"},
+				},
+			},
+		},
+		{
+			name:         "unsupported rule type",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "protect releases",
+				"enforcement": "active",
+				"rules": []interface{}{
+					map[string]interface{}{"type": "no_force_pushes"},
+				},
+			},
+			expectedErrMsg: `unsupported rule {"type":"no_force_pushes"}`,
+		},
+		{
+			name:         "refs on a push ruleset",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"name":         "protect releases",
+				"target":       "push",
+				"enforcement":  "active",
+				"include_refs": []interface{}{"main"},
+				"rules": []interface{}{
+					map[string]interface{}{"type": "deletion"},
+				},
+			},
+			expectedErrMsg: "include_refs and exclude_refs do not apply to push rulesets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepoRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned github.RepositoryRuleset
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, int64(43), returned.GetID())
+		})
+	}
+}
@@ -3,8 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -18,7 +22,7 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 			mcp.WithDescription(t("TOOL_SEARCH_REPOSITORIES_DESCRIPTION", "Search for GitHub repositories")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_REPOSITORIES_USER_TITLE", "Search repositories"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("query",
 				mcp.Required(),
@@ -76,7 +80,7 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_CODE_USER_TITLE", "Search code"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("q",
 				mcp.Required(),
@@ -90,6 +94,7 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
+			WithSearchTrimming(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			query, err := requiredParam[string](request, "q")
@@ -108,6 +113,10 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			trimming, err := optionalSearchTrimmingParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			opts := &github.SearchOptions{
 				Sort:  sort,
@@ -137,6 +146,25 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
+			if trimming.top > 0 && len(result.CodeResults) > trimming.top {
+				result.CodeResults = result.CodeResults[:trimming.top]
+			}
+			if trimming.top > 0 && !trimming.full {
+				summary := searchSummary{
+					TotalCount:        result.GetTotal(),
+					IncompleteResults: result.GetIncompleteResults(),
+					Results:           make([]string, 0, len(result.CodeResults)),
+				}
+				for _, code := range result.CodeResults {
+					summary.Results = append(summary.Results, summarizeCode(code))
+				}
+				r, err := json.Marshal(summary)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
 			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -146,13 +174,135 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 		}
 }
 
+// commitSearchItem is a commit found by search_commits.
+type commitSearchItem struct {
+	SHA         string     `json:"sha"`
+	Message     string     `json:"message"`
+	AuthorName  string     `json:"author_name"`
+	AuthorEmail string     `json:"author_email"`
+	AuthorLogin string     `json:"author_login,omitempty"`
+	AuthoredAt  *time.Time `json:"authored_at,omitempty"`
+	Repository  string     `json:"repository"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+// commitSearchResult is the result of search_commits.
+type commitSearchResult struct {
+	TotalCount        int                `json:"total_count"`
+	IncompleteResults bool               `json:"incomplete_results"`
+	Commits           []commitSearchItem `json:"commits"`
+}
+
+// SearchCommits creates a tool to search for commits across GitHub repositories.
+func SearchCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_commits",
+			mcp.WithDescription(t("TOOL_SEARCH_COMMITS_DESCRIPTION", "Search for commits across GitHub repositories by message, author, committer, date or repository. Returns the SHA, message, author and repository of each commit")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SEARCH_COMMITS_USER_TITLE", "Search commits"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Search query using GitHub commit search syntax, e.g. 'fix race repo:owner/repo author:octocat author-date:>2024-01-01'"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, by best match when omitted"),
+				mcp.Enum("author-date", "committer-date"),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := requiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.SearchOptions{
+				Sort:  sort,
+				Order: order,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github sends the cloak-preview media type commit search requires
+			result, resp, err := client.Search.Commits(ctx, query, opts)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid commit search query: %s", describeErrorResponse(errResp))), nil
+				}
+				return nil, fmt.Errorf("failed to search commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search commits: %s", string(body))), nil
+			}
+
+			commits := commitSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Commits:           make([]commitSearchItem, 0, len(result.Commits)),
+			}
+			for _, c := range result.Commits {
+				item := commitSearchItem{
+					SHA:         c.GetSHA(),
+					Message:     c.GetCommit().GetMessage(),
+					AuthorName:  c.GetCommit().GetAuthor().GetName(),
+					AuthorEmail: c.GetCommit().GetAuthor().GetEmail(),
+					AuthorLogin: c.GetAuthor().GetLogin(),
+					Repository:  c.GetRepository().GetFullName(),
+					HTMLURL:     c.GetHTMLURL(),
+				}
+				if date := c.GetCommit().GetAuthor().Date; date != nil {
+					item.AuthoredAt = &date.Time
+				}
+				commits.Commits = append(commits.Commits, item)
+			}
+
+			r, err := json.Marshal(commits)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 // SearchUsers creates a tool to search for GitHub users.
 func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_users",
 			mcp.WithDescription(t("TOOL_SEARCH_USERS_DESCRIPTION", "Search for GitHub users")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_SEARCH_USERS_USER_TITLE", "Search users"),
-				ReadOnlyHint: true,
+				ReadOnlyHint: mcp.ToBoolPtr(true),
 			}),
 			mcp.WithString("q",
 				mcp.Required(),
@@ -222,3 +372,69 @@ func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// WithSearchTrimming returns a ToolOption that adds "top" and "full" parameters to a search tool,
+// to return only the most relevant results as one line summaries.
+func WithSearchTrimming() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithNumber("top",
+			mcp.Description("Return only the first N results of the page, in relevance order unless sort is set, as one line summaries (min 1, max 100)"),
+			mcp.Min(1),
+			mcp.Max(100),
+		)(tool)
+
+		mcp.WithBoolean("full",
+			mcp.Description("With top, return the complete result objects instead of one line summaries"),
+		)(tool)
+	}
+}
+
+// searchTrimmingParams are the "top" and "full" parameters of a search tool, top is 0 when the
+// results are not trimmed.
+type searchTrimmingParams struct {
+	top  int
+	full bool
+}
+
+// optionalSearchTrimmingParams returns the "top" and "full" parameters from the request.
+func optionalSearchTrimmingParams(r mcp.CallToolRequest) (searchTrimmingParams, error) {
+	top, err := OptionalIntParam(r, "top")
+	if err != nil {
+		return searchTrimmingParams{}, err
+	}
+	if top < 0 {
+		return searchTrimmingParams{}, fmt.Errorf("top must be at least 1")
+	}
+	full, err := OptionalParam[bool](r, "full")
+	if err != nil {
+		return searchTrimmingParams{}, err
+	}
+	return searchTrimmingParams{top: top, full: full}, nil
+}
+
+// searchSummary is the result of a search tool trimmed to its top results, one line each.
+type searchSummary struct {
+	TotalCount        int      `json:"total_count"`
+	IncompleteResults bool     `json:"incomplete_results"`
+	Results           []string `json:"results"`
+}
+
+// summarizeIssue returns a one line summary of an issue or pull request found by search_issues.
+func summarizeIssue(issue *github.Issue) string {
+	repo := issue.GetRepositoryURL()
+	if i := strings.LastIndex(repo, "/repos/"); i >= 0 {
+		repo = repo[i+len("/repos/"):]
+	}
+	kind := "issue"
+	if issue.IsPullRequest() {
+		kind = "pull request"
+	}
+	return fmt.Sprintf("%s#%d [%s %s] %s (%d comments, updated %s)",
+		repo, issue.GetNumber(), issue.GetState(), kind, issue.GetTitle(),
+		issue.GetComments(), issue.GetUpdatedAt().Format("2006-01-02"))
+}
+
+// summarizeCode returns a one line summary of a file found by search_code.
+func summarizeCode(code *github.CodeResult) string {
+	return fmt.Sprintf("%s:%s (%s)", code.GetRepository().GetFullName(), code.GetPath(), code.GetHTMLURL())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -172,6 +173,8 @@ func Test_SearchCode(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "top")
+	assert.Contains(t, tool.InputSchema.Properties, "full")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
 
 	// Setup mock search results
@@ -311,6 +314,236 @@ func Test_SearchCode(t *testing.T) {
 	}
 }
 
+func Test_SearchCode_Trimming(t *testing.T) {
+	searchResult := &github.CodeSearchResult{
+		Total:             github.Ptr(120),
+		IncompleteResults: github.Ptr(false),
+		CodeResults: []*github.CodeResult{
+			{
+				Name:       github.Ptr("server.go"),
+				Path:       github.Ptr("pkg/server.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/pkg/server.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+			},
+			{
+				Name:       github.Ptr("main.go"),
+				Path:       github.Ptr("cmd/main.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/other/blob/main/cmd/main.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/other")},
+			},
+			{
+				Name:       github.Ptr("util.go"),
+				Path:       github.Ptr("util.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/util.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]interface{}
+		expectedSummary *searchSummary
+		expectedPaths   []string
+	}{
+		{
+			name: "top results as summaries",
+			requestArgs: map[string]interface{}{
+				"q":   "NewServer",
+				"top": float64(2),
+			},
+			expectedSummary: &searchSummary{
+				TotalCount: 120,
+				Results: []string{
+					"owner/repo:pkg/server.go (https://github.com/owner/repo/blob/main/pkg/server.go)",
+					"owner/other:cmd/main.go (https://github.com/owner/other/blob/main/cmd/main.go)",
+				},
+			},
+		},
+		{
+			name: "top larger than the page",
+			requestArgs: map[string]interface{}{
+				"q":   "NewServer",
+				"top": float64(10),
+			},
+			expectedSummary: &searchSummary{
+				TotalCount: 120,
+				Results: []string{
+					"owner/repo:pkg/server.go (https://github.com/owner/repo/blob/main/pkg/server.go)",
+					"owner/other:cmd/main.go (https://github.com/owner/other/blob/main/cmd/main.go)",
+					"owner/repo:util.go (https://github.com/owner/repo/blob/main/util.go)",
+				},
+			},
+		},
+		{
+			name: "top results as complete objects",
+			requestArgs: map[string]interface{}{
+				"q":    "NewServer",
+				"top":  float64(1),
+				"full": true,
+			},
+			expectedPaths: []string{"pkg/server.go"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchCode, searchResult),
+			))
+			_, handler := SearchCode(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedSummary != nil {
+				var returned searchSummary
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, *tc.expectedSummary, returned)
+				return
+			}
+
+			var returned github.CodeSearchResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			paths := make([]string, 0, len(returned.CodeResults))
+			for _, code := range returned.CodeResults {
+				paths = append(paths, code.GetPath())
+			}
+			assert.Equal(t, tc.expectedPaths, paths)
+			assert.Equal(t, 120, returned.GetTotal())
+		})
+	}
+}
+
+func Test_SearchCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
+
+	authoredAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	mockSearchResult := &github.CommitsSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Commits: []*github.CommitResult{
+			{
+				SHA: github.Ptr("abc123"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Fix race in cache eviction"),
+					Author: &github.CommitAuthor{
+						Name:  github.Ptr("Mona Lisa"),
+						Email: github.Ptr("mona@example.com"),
+						Date:  &github.Timestamp{Time: authoredAt},
+					},
+				},
+				Author:     &github.User{Login: github.Ptr("octocat")},
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/commit/abc123"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult commitSearchResult
+		expectedErrMsg string
+	}{
+		{
+			name: "search with preview media type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCommits,
+					expectQueryParams(t, map[string]string{
+						"q":        "fix race repo:owner/repo",
+						"sort":     "author-date",
+						"order":    "desc",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+							assert.Contains(t, r.Header.Get("Accept"), "application/vnd.github.cloak-preview+json")
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write(mock.MustMarshal(mockSearchResult))
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":   "fix race repo:owner/repo",
+				"sort":    "author-date",
+				"order":   "desc",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedResult: commitSearchResult{
+				TotalCount: 1,
+				Commits: []commitSearchItem{
+					{
+						SHA:         "abc123",
+						Message:     "Fix race in cache eviction",
+						AuthorName:  "Mona Lisa",
+						AuthorEmail: "mona@example.com",
+						AuthorLogin: "octocat",
+						AuthoredAt:  &authoredAt,
+						Repository:  "owner/repo",
+						HTMLURL:     "https://github.com/owner/repo/commit/abc123",
+					},
+				},
+			},
+		},
+		{
+			name: "invalid query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCommits,
+					mockResponse(t, http.StatusUnprocessableEntity, json.RawMessage(`{"message": "Validation Failed", "errors": [{"resource": "Search", "field": "q", "code": "invalid"}]}`)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "author-date:yesterday",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid commit search query: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned commitSearchResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
 func Test_SearchUsers(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -0,0 +1,348 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// advisoryPackage identifies the package affected by a repository security advisory.
+type advisoryPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name,omitempty"`
+}
+
+// advisoryVulnerability describes one affected product of a repository security advisory.
+type advisoryVulnerability struct {
+	Package                advisoryPackage `json:"package"`
+	VulnerableVersionRange string          `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        string          `json:"patched_versions,omitempty"`
+}
+
+// createSecurityAdvisoryRequest is the payload of the create repository security advisory endpoint,
+// which go-github does not wrap yet.
+type createSecurityAdvisoryRequest struct {
+	Summary         string                  `json:"summary"`
+	Description     string                  `json:"description"`
+	Severity        string                  `json:"severity,omitempty"`
+	CVEID           string                  `json:"cve_id,omitempty"`
+	Vulnerabilities []advisoryVulnerability `json:"vulnerabilities"`
+}
+
+// ListRepoSecurityAdvisories creates a tool to list the security advisories of a repository.
+func ListRepoSecurityAdvisories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_security_advisories",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_DESCRIPTION", "List the security advisories of a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_SECURITY_ADVISORIES_USER_TITLE", "List repository security advisories"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter advisories by state"),
+				mcp.Enum("triage", "draft", "published", "closed"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field"),
+				mcp.Enum("created", "updated", "published"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction"),
+				mcp.Enum("asc", "desc"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListRepositorySecurityAdvisoriesOptions{
+				State:     state,
+				Sort:      sort,
+				Direction: direction,
+			}
+			advisories, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list security advisories: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list security advisories: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(advisories)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepoSecurityAdvisory creates a tool to get a single security advisory of a repository.
+func GetRepoSecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_security_advisory",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Get details of a security advisory of a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Get repository security advisory"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ghsa_id",
+				mcp.Required(),
+				mcp.Description("GitHub Security Advisory identifier, e.g. GHSA-xxxx-xxxx-xxxx"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ghsaID, err := requiredParam[string](request, "ghsa_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/security-advisories/%s", owner, repo, ghsaID), nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			advisory := new(github.SecurityAdvisory)
+			resp, err := client.Do(ctx, req, advisory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get security advisory: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get security advisory: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(advisory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRepoSecurityAdvisory creates a tool to draft a new security advisory for a repository.
+func CreateRepoSecurityAdvisory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_security_advisory",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Create a draft security advisory for a GitHub repository")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Create repository security advisory"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("summary",
+				mcp.Required(),
+				mcp.Description("Short summary of the advisory"),
+			),
+			mcp.WithString("description",
+				mcp.Required(),
+				mcp.Description("Detailed description of the vulnerability"),
+			),
+			mcp.WithString("severity",
+				mcp.Description("Severity of the vulnerability"),
+				mcp.Enum("low", "medium", "high", "critical"),
+			),
+			mcp.WithString("cve_id",
+				mcp.Description("CVE identifier, if one has already been assigned"),
+			),
+			mcp.WithArray("vulnerabilities",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"ecosystem"},
+						"properties": map[string]interface{}{
+							"ecosystem": map[string]interface{}{
+								"type":        "string",
+								"description": "package ecosystem, e.g. npm, pip, maven, go, rubygems, rust, actions or other",
+							},
+							"package": map[string]interface{}{
+								"type":        "string",
+								"description": "name of the affected package",
+							},
+							"vulnerable_version_range": map[string]interface{}{
+								"type":        "string",
+								"description": "range of affected versions, e.g. '< 1.2.3'",
+							},
+							"patched_versions": map[string]interface{}{
+								"type":        "string",
+								"description": "versions that fix the vulnerability, e.g. '1.2.3'",
+							},
+						},
+					}),
+				mcp.Description("Affected products, each object with ecosystem (string) and optional package, vulnerable_version_range and patched_versions (string)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			summary, err := requiredParam[string](request, "summary")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := requiredParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			severity, err := OptionalParam[string](request, "severity")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch severity {
+			case "", "low", "medium", "high", "critical":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid severity: %s, must be one of low, medium, high or critical", severity)), nil
+			}
+			cveID, err := OptionalParam[string](request, "cve_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Parse vulnerabilities parameter - this should be an array of objects with at least an ecosystem
+			vulnerabilitiesObj, ok := request.GetArguments()["vulnerabilities"].([]interface{})
+			if !ok || len(vulnerabilitiesObj) == 0 {
+				return mcp.NewToolResultError("vulnerabilities parameter must be a non-empty array of objects with ecosystem"), nil
+			}
+			vulnerabilities := make([]advisoryVulnerability, 0, len(vulnerabilitiesObj))
+			for _, v := range vulnerabilitiesObj {
+				vulnMap, ok := v.(map[string]interface{})
+				if !ok {
+					return mcp.NewToolResultError("each vulnerability must be an object with ecosystem"), nil
+				}
+				ecosystem, ok := vulnMap["ecosystem"].(string)
+				if !ok || ecosystem == "" {
+					return mcp.NewToolResultError("each vulnerability must have an ecosystem"), nil
+				}
+				name, _ := vulnMap["package"].(string)
+				versionRange, _ := vulnMap["vulnerable_version_range"].(string)
+				patched, _ := vulnMap["patched_versions"].(string)
+				vulnerabilities = append(vulnerabilities, advisoryVulnerability{
+					Package: advisoryPackage{
+						Ecosystem: ecosystem,
+						Name:      name,
+					},
+					VulnerableVersionRange: versionRange,
+					PatchedVersions:        patched,
+				})
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("create a draft security advisory %q in %s/%s", summary, owner, repo), nil
+			}
+			payload := &createSecurityAdvisoryRequest{
+				Summary:         summary,
+				Description:     description,
+				Severity:        severity,
+				CVEID:           cveID,
+				Vulnerabilities: vulnerabilities,
+			}
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/security-advisories", owner, repo), payload)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			advisory := new(github.SecurityAdvisory)
+			resp, err := client.Do(ctx, req, advisory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create security advisory: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create security advisory: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(advisory)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,381 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepoSecurityAdvisories(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepoSecurityAdvisories(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_security_advisories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockAdvisories := []*github.SecurityAdvisory{
+		{
+			GHSAID:   github.Ptr("GHSA-aaaa-bbbb-cccc"),
+			Summary:  github.Ptr("Path traversal"),
+			Severity: github.Ptr("high"),
+			State:    github.Ptr("draft"),
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedAdvisories []*github.SecurityAdvisory
+		expectedErrMsg     string
+	}{
+		{
+			name: "list advisories with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":     "draft",
+						"sort":      "updated",
+						"direction": "desc",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockAdvisories),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "draft",
+				"sort":      "updated",
+				"direction": "desc",
+			},
+			expectError:        false,
+			expectedAdvisories: mockAdvisories,
+		},
+		{
+			name: "list advisories fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list security advisories",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepoSecurityAdvisories(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedAdvisories []*github.SecurityAdvisory
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisories)
+			require.NoError(t, err)
+			assert.Len(t, returnedAdvisories, len(tc.expectedAdvisories))
+			for i, advisory := range returnedAdvisories {
+				assert.Equal(t, *tc.expectedAdvisories[i].GHSAID, *advisory.GHSAID)
+				assert.Equal(t, *tc.expectedAdvisories[i].Severity, *advisory.Severity)
+			}
+		})
+	}
+}
+
+func Test_GetRepoSecurityAdvisory(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepoSecurityAdvisory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ghsa_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ghsa_id"})
+
+	mockAdvisory := &github.SecurityAdvisory{
+		GHSAID:   github.Ptr("GHSA-aaaa-bbbb-cccc"),
+		Summary:  github.Ptr("Path traversal"),
+		Severity: github.Ptr("high"),
+		CVEID:    github.Ptr("CVE-2025-0001"),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAdvisory *github.SecurityAdvisory
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful advisory fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					mockAdvisory,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ghsa_id": "GHSA-aaaa-bbbb-cccc",
+			},
+			expectError:      false,
+			expectedAdvisory: mockAdvisory,
+		},
+		{
+			name: "advisory fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSecurityAdvisoriesByOwnerByRepoByGhsaId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ghsa_id": "GHSA-xxxx-xxxx-xxxx",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get security advisory",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepoSecurityAdvisory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedAdvisory github.SecurityAdvisory
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisory)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedAdvisory.GHSAID, *returnedAdvisory.GHSAID)
+			assert.Equal(t, *tc.expectedAdvisory.CVEID, *returnedAdvisory.CVEID)
+		})
+	}
+}
+
+func Test_CreateRepoSecurityAdvisory(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRepoSecurityAdvisory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "summary")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "severity")
+	assert.Contains(t, tool.InputSchema.Properties, "cve_id")
+	assert.Contains(t, tool.InputSchema.Properties, "vulnerabilities")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "summary", "description", "vulnerabilities"})
+
+	mockAdvisory := &github.SecurityAdvisory{
+		GHSAID:   github.Ptr("GHSA-aaaa-bbbb-cccc"),
+		Summary:  github.Ptr("Path traversal"),
+		Severity: github.Ptr("critical"),
+		State:    github.Ptr("draft"),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedAdvisory *github.SecurityAdvisory
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful advisory creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"summary":     "Path traversal",
+						"description": "Crafted paths escape the upload directory",
+						"severity":    "critical",
+						"cve_id":      "CVE-2025-0001",
+						"vulnerabilities": []any{
+							map[string]any{
+								"package": map[string]any{
+									"ecosystem": "go",
+									"name":      "github.com/owner/repo",
+								},
+								"vulnerable_version_range": "< 1.2.3",
+								"patched_versions":         "1.2.3",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockAdvisory),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal",
+				"description": "Crafted paths escape the upload directory",
+				"severity":    "critical",
+				"cve_id":      "CVE-2025-0001",
+				"vulnerabilities": []interface{}{
+					map[string]interface{}{
+						"ecosystem":                "go",
+						"package":                  "github.com/owner/repo",
+						"vulnerable_version_range": "< 1.2.3",
+						"patched_versions":         "1.2.3",
+					},
+				},
+			},
+			expectError:      false,
+			expectedAdvisory: mockAdvisory,
+		},
+		{
+			name:         "invalid severity",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal",
+				"description": "Crafted paths escape the upload directory",
+				"severity":    "severe",
+				"vulnerabilities": []interface{}{
+					map[string]interface{}{
+						"ecosystem": "go",
+					},
+				},
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid severity: severe, must be one of low, medium, high or critical",
+		},
+		{
+			name:         "missing vulnerabilities",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal",
+				"description": "Crafted paths escape the upload directory",
+			},
+			expectError:    false,
+			expectedErrMsg: "vulnerabilities parameter must be a non-empty array of objects with ecosystem",
+		},
+		{
+			name: "advisory creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposSecurityAdvisoriesByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Path traversal",
+				"description": "Crafted paths escape the upload directory",
+				"vulnerabilities": []interface{}{
+					map[string]interface{}{
+						"ecosystem": "go",
+					},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create security advisory",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRepoSecurityAdvisory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedAdvisory github.SecurityAdvisory
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisory)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedAdvisory.GHSAID, *returnedAdvisory.GHSAID)
+			assert.Equal(t, *tc.expectedAdvisory.State, *returnedAdvisory.State)
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package github
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/google/go-github/v69/github"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -17,6 +19,8 @@ func NewServer(version string, opts ...server.ServerOption) *server.MCPServer {
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, true),
 		server.WithLogging(),
+		server.WithToolHandlerMiddleware(tokenScopesCacheMiddleware),
+		server.WithToolHandlerMiddleware(rateLimitErrorMiddleware),
 	}
 	opts = append(defaultOpts, opts...)
 
@@ -29,11 +33,22 @@ func NewServer(version string, opts ...server.ServerOption) *server.MCPServer {
 	return s
 }
 
+// tokenScopesCacheMiddleware gives every tool call a token scopes cache, so the scopes are looked up
+// at most once per request. The calls of a batch run inside the batch call and share its cache.
+func tokenScopesCacheMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := ctx.Value(tokenScopesCacheKey{}).(*tokenScopesCache); !ok {
+			ctx = ContextWithTokenScopesCache(ctx)
+		}
+		return next(ctx, request)
+	}
+}
+
 // OptionalParamOK is a helper function that can be used to fetch a requested parameter from the request.
 // It returns the value, a boolean indicating if the parameter was present, and an error if the type is wrong.
 func OptionalParamOK[T any](r mcp.CallToolRequest, p string) (value T, ok bool, err error) {
 	// Check if the parameter is present in the request
-	val, exists := r.Params.Arguments[p]
+	val, exists := r.GetArguments()[p]
 	if !exists {
 		// Not present, return zero value, false, no error
 		return
@@ -68,21 +83,21 @@ func requiredParam[T comparable](r mcp.CallToolRequest, p string) (T, error) {
 	var zero T
 
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return zero, fmt.Errorf("missing required parameter: %s", p)
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := r.Params.Arguments[p].(T); !ok {
+	if _, ok := r.GetArguments()[p].(T); !ok {
 		return zero, fmt.Errorf("parameter %s is not of type %T", p, zero)
 	}
 
-	if r.Params.Arguments[p].(T) == zero {
+	if r.GetArguments()[p].(T) == zero {
 		return zero, fmt.Errorf("missing required parameter: %s", p)
 
 	}
 
-	return r.Params.Arguments[p].(T), nil
+	return r.GetArguments()[p].(T), nil
 }
 
 // RequiredInt is a helper function that can be used to fetch a requested parameter from the request.
@@ -106,16 +121,16 @@ func OptionalParam[T any](r mcp.CallToolRequest, p string) (T, error) {
 	var zero T
 
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return zero, nil
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := r.Params.Arguments[p].(T); !ok {
-		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, r.Params.Arguments[p])
+	if _, ok := r.GetArguments()[p].(T); !ok {
+		return zero, fmt.Errorf("parameter %s is not of type %T, is %T", p, zero, r.GetArguments()[p])
 	}
 
-	return r.Params.Arguments[p].(T), nil
+	return r.GetArguments()[p].(T), nil
 }
 
 // OptionalIntParam is a helper function that can be used to fetch a requested parameter from the request.
@@ -149,11 +164,11 @@ func OptionalIntParamWithDefault(r mcp.CallToolRequest, p string, d int) (int, e
 // 2. If it is present, iterates the elements and checks each is a string
 func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error) {
 	// Check if the parameter is present in the request
-	if _, ok := r.Params.Arguments[p]; !ok {
+	if _, ok := r.GetArguments()[p]; !ok {
 		return []string{}, nil
 	}
 
-	switch v := r.Params.Arguments[p].(type) {
+	switch v := r.GetArguments()[p].(type) {
 	case nil:
 		return []string{}, nil
 	case []string:
@@ -169,7 +184,37 @@ func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error)
 		}
 		return strSlice, nil
 	default:
-		return []string{}, fmt.Errorf("parameter %s could not be coerced to []string, is %T", p, r.Params.Arguments[p])
+		return []string{}, fmt.Errorf("parameter %s could not be coerced to []string, is %T", p, r.GetArguments()[p])
+	}
+}
+
+// OptionalIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request, if not, it returns its zero-value
+// 2. If it is present, iterates the elements and checks each is a number
+func OptionalIntArrayParam(r mcp.CallToolRequest, p string) ([]int, error) {
+	// Check if the parameter is present in the request
+	if _, ok := r.GetArguments()[p]; !ok {
+		return []int{}, nil
+	}
+
+	switch v := r.GetArguments()[p].(type) {
+	case nil:
+		return []int{}, nil
+	case []int:
+		return v, nil
+	case []any:
+		intSlice := make([]int, len(v))
+		for i, v := range v {
+			f, ok := v.(float64)
+			if !ok {
+				return []int{}, fmt.Errorf("parameter %s is not of type number, is %T", p, v)
+			}
+			intSlice[i] = int(f)
+		}
+		return intSlice, nil
+	default:
+		return []int{}, fmt.Errorf("parameter %s could not be coerced to []int, is %T", p, r.GetArguments()[p])
 	}
 }
 
@@ -214,3 +259,68 @@ func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
 		perPage: perPage,
 	}, nil
 }
+
+// WithGraphQLPagination returns a ToolOption that adds "first" and "after" parameters to the tool,
+// the cursor based counterpart of WithPagination for tools listing a GraphQL connection.
+func WithGraphQLPagination() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithNumber("first",
+			mcp.Description("Number of results to return (min 1, max 100)"),
+			mcp.Min(1),
+			mcp.Max(100),
+		)(tool)
+
+		mcp.WithString("after",
+			mcp.Description("Cursor to continue from, the endCursor returned with the previous results"),
+		)(tool)
+	}
+}
+
+type GraphQLPaginationParams struct {
+	first int
+	after string
+}
+
+// variables returns the GraphQL variables for the page, for a query taking $first: Int! and
+// $after: String. The cursor is left out for the first page.
+func (p GraphQLPaginationParams) variables() map[string]any {
+	variables := map[string]any{"first": p.first}
+	if p.after != "" {
+		variables["after"] = p.after
+	}
+	return variables
+}
+
+// OptionalGraphQLPaginationParams returns the "first" and "after" parameters from the request,
+// "first" defaults to 30 like "perPage" and "after" to the start of the connection.
+func OptionalGraphQLPaginationParams(r mcp.CallToolRequest) (GraphQLPaginationParams, error) {
+	first, err := OptionalIntParamWithDefault(r, "first", 30)
+	if err != nil {
+		return GraphQLPaginationParams{}, err
+	}
+	after, err := OptionalParam[string](r, "after")
+	if err != nil {
+		return GraphQLPaginationParams{}, err
+	}
+	return GraphQLPaginationParams{
+		first: first,
+		after: after,
+	}, nil
+}
+
+// maxTextResultBytes is the size above which tools truncate the text they return, to keep
+// large files and logs from overflowing the model's context.
+const maxTextResultBytes = 100 * 1024
+
+// truncateText shortens s to at most limit bytes without splitting a UTF-8 character,
+// reporting whether anything was cut off.
+func truncateText(s string, limit int) (string, bool) {
+	if len(s) <= limit {
+		return s, false
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut], true
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -435,6 +436,65 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestOptionalIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "ids",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "valid any array parameter",
+			params: map[string]any{
+				"ids": []any{float64(1), float64(42)},
+			},
+			paramName:   "ids",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"ids": "1",
+			},
+			paramName:   "ids",
+			expected:    []int{},
+			expectError: true,
+		},
+		{
+			name: "wrong slice type parameter",
+			params: map[string]any{
+				"ids": []any{float64(1), "2"},
+			},
+			paramName:   "ids",
+			expected:    []int{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalIntArrayParam(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -517,3 +577,113 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func TestWithGraphQLPagination(t *testing.T) {
+	tool := mcp.NewTool("list_things", WithGraphQLPagination())
+
+	assert.Contains(t, tool.InputSchema.Properties, "first")
+	assert.Contains(t, tool.InputSchema.Properties, "after")
+	assert.Empty(t, tool.InputSchema.Required)
+}
+
+func TestOptionalGraphQLPaginationParams(t *testing.T) {
+	tests := []struct {
+		name              string
+		params            map[string]any
+		expected          GraphQLPaginationParams
+		expectedVariables map[string]any
+		expectError       bool
+	}{
+		{
+			name:              "no pagination parameters, default values",
+			params:            map[string]any{},
+			expected:          GraphQLPaginationParams{first: 30},
+			expectedVariables: map[string]any{"first": 30},
+		},
+		{
+			name: "first and after parameters",
+			params: map[string]any{
+				"first": float64(50),
+				"after": "Y3Vyc29yOjUw",
+			},
+			expected:          GraphQLPaginationParams{first: 50, after: "Y3Vyc29yOjUw"},
+			expectedVariables: map[string]any{"first": 50, "after": "Y3Vyc29yOjUw"},
+		},
+		{
+			name: "invalid first parameter",
+			params: map[string]any{
+				"first": "not-a-number",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid after parameter",
+			params: map[string]any{
+				"after": float64(1),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalGraphQLPaginationParams(request)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.expectedVariables, result.variables())
+		})
+	}
+}
+
+func Test_TruncateText(t *testing.T) {
+	tests := []struct {
+		name              string
+		text              string
+		limit             int
+		expected          string
+		expectedTruncated bool
+	}{
+		{
+			name:              "shorter than limit",
+			text:              "hello",
+			limit:             10,
+			expected:          "hello",
+			expectedTruncated: false,
+		},
+		{
+			name:              "exactly the limit",
+			text:              "hello",
+			limit:             5,
+			expected:          "hello",
+			expectedTruncated: false,
+		},
+		{
+			name:              "longer than limit",
+			text:              "hello world",
+			limit:             5,
+			expected:          "hello",
+			expectedTruncated: true,
+		},
+		{
+			name:              "does not split a multi-byte character",
+			text:              "héllo",
+			limit:             2,
+			expected:          "h",
+			expectedTruncated: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, truncated := truncateText(tc.text, tc.limit)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Phases of a source import, grouping the many statuses of the API by what the caller has to do next.
+const (
+	importPhaseInProgress = "in_progress"
+	importPhaseComplete   = "complete"
+	importPhaseNeedsInput = "needs_input"
+	importPhaseFailed     = "failed"
+)
+
+// importStatus is what a status of a source import means for the caller.
+type importStatus struct {
+	phase       string
+	description string
+}
+
+// importStatuses describes the statuses a source import goes through, see
+// https://docs.github.com/rest/migrations/source-imports#get-an-import-status.
+var importStatuses = map[string]importStatus{
+	"detecting":                {importPhaseInProgress, "detecting the version control system of the source repository"},
+	"importing":                {importPhaseInProgress, "fetching the commits of the source repository"},
+	"mapping":                  {importPhaseInProgress, "converting branches and applying author updates"},
+	"waiting_to_push":          {importPhaseInProgress, "waiting to push the imported commits to GitHub"},
+	"pushing":                  {importPhaseInProgress, "pushing the imported commits to GitHub"},
+	"complete":                 {importPhaseComplete, "the import is complete and the repository is ready on GitHub"},
+	"auth_failed":              {importPhaseNeedsInput, "the source repository requires authentication, provide vcs_username and vcs_password"},
+	"detection_needs_auth":     {importPhaseNeedsInput, "detecting the source repository requires authentication, provide vcs_username and vcs_password"},
+	"detection_found_multiple": {importPhaseNeedsInput, "several projects were found at the source URL, provide the vcs and tfvc_project of one of the project choices"},
+	"detection_found_nothing":  {importPhaseFailed, "no repository was found at the source URL"},
+	"error":                    {importPhaseFailed, "the import failed"},
+}
+
+// sourceImport is the state of a source import as returned by get_import_progress and start_import.
+type sourceImport struct {
+	Status         string          `json:"status"`
+	Phase          string          `json:"phase"`
+	Description    string          `json:"description"`
+	VCS            string          `json:"vcs,omitempty"`
+	VCSURL         string          `json:"vcs_url"`
+	CommitCount    int             `json:"commit_count,omitempty"`
+	Percent        int             `json:"percent,omitempty"`
+	PushPercent    int             `json:"push_percent,omitempty"`
+	AuthorsCount   int             `json:"authors_count,omitempty"`
+	HasLargeFiles  bool            `json:"has_large_files,omitempty"`
+	FailedStep     string          `json:"failed_step,omitempty"`
+	Message        string          `json:"message,omitempty"`
+	ProjectChoices []importProject `json:"project_choices,omitempty"`
+	HTMLURL        string          `json:"html_url"`
+}
+
+// importProject is one of the projects found at the source URL of an import.
+type importProject struct {
+	VCS         string `json:"vcs"`
+	TFVCProject string `json:"tfvc_project,omitempty"`
+	HumanName   string `json:"human_name"`
+}
+
+// summarizeImport maps the status of an import onto its phase and a description of what it means.
+func summarizeImport(imp *github.Import) sourceImport {
+	status, ok := importStatuses[imp.GetStatus()]
+	if !ok {
+		status = importStatus{importPhaseInProgress, fmt.Sprintf("the import is %s", imp.GetStatus())}
+	}
+	description := status.description
+	if imp.GetStatusText() != "" && status.phase != importPhaseNeedsInput {
+		description = fmt.Sprintf("%s (%s)", description, imp.GetStatusText())
+	}
+
+	var choices []importProject
+	for _, choice := range imp.ProjectChoices {
+		choices = append(choices, importProject{
+			VCS:         choice.GetVCS(),
+			TFVCProject: choice.GetTFVCProject(),
+			HumanName:   choice.GetHumanName(),
+		})
+	}
+	return sourceImport{
+		Status:         imp.GetStatus(),
+		Phase:          status.phase,
+		Description:    description,
+		VCS:            imp.GetVCS(),
+		VCSURL:         imp.GetVCSURL(),
+		CommitCount:    imp.GetCommitCount(),
+		Percent:        imp.GetPercent(),
+		PushPercent:    imp.GetPushPercent(),
+		AuthorsCount:   imp.GetAuthorsCount(),
+		HasLargeFiles:  imp.GetHasLargeFiles(),
+		FailedStep:     imp.GetFailedStep(),
+		Message:        imp.GetMessage(),
+		ProjectChoices: choices,
+		HTMLURL:        imp.GetHTMLURL(),
+	}
+}
+
+// GetImportProgress creates a tool to get the progress of the source import of a repository.
+func GetImportProgress(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_import_progress",
+			mcp.WithDescription(t("TOOL_GET_IMPORT_PROGRESS_DESCRIPTION", "Get the progress of the import of a repository from another version control system. Returns the status of the import, its phase (in_progress, complete, needs_input or failed) and what it means, and the progress of the current step")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_IMPORT_PROGRESS_USER_TITLE", "Get import progress"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			imp, resp, err := client.Migrations.ImportProgress(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("no import found for %s/%s", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get import progress: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get import progress: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeImport(imp))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// StartImport creates a tool to start importing a repository from another version control system.
+func StartImport(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("start_import",
+			mcp.WithDescription(t("TOOL_START_IMPORT_DESCRIPTION", "Start importing a repository from another version control system into an existing empty GitHub repository. Use get_import_progress to follow the import")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_START_IMPORT_USER_TITLE", "Start repository import"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository to import into"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the repository to import into"),
+			),
+			mcp.WithString("vcs_url",
+				mcp.Required(),
+				mcp.Description("URL of the source repository"),
+			),
+			mcp.WithString("vcs",
+				mcp.Description("Version control system of the source repository, detected when omitted"),
+				mcp.Enum("subversion", "git", "mercurial", "tfvc"),
+			),
+			mcp.WithString("vcs_username",
+				mcp.Description("Username to authenticate to the source repository with"),
+			),
+			mcp.WithString("vcs_password",
+				mcp.Description("Password to authenticate to the source repository with"),
+			),
+			mcp.WithString("tfvc_project",
+				mcp.Description("For a tfvc import, the name of the project to import"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			vcsURL, err := requiredParam[string](request, "vcs_url")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			vcs, err := OptionalParam[string](request, "vcs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := OptionalParam[string](request, "vcs_username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			password, err := OptionalParam[string](request, "vcs_password")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tfvcProject, err := OptionalParam[string](request, "tfvc_project")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (username == "") != (password == "") {
+				return mcp.NewToolResultError("vcs_username and vcs_password must be provided together"), nil
+			}
+			if tfvcProject != "" && vcs != "tfvc" {
+				return mcp.NewToolResultError("tfvc_project can only be used when vcs is tfvc"), nil
+			}
+
+			in := &github.Import{
+				VCSURL: github.Ptr(vcsURL),
+			}
+			if vcs != "" {
+				in.VCS = github.Ptr(vcs)
+			}
+			if username != "" {
+				in.VCSUsername = github.Ptr(username)
+				in.VCSPassword = github.Ptr(password)
+			}
+			if tfvcProject != "" {
+				in.TFVCProject = github.Ptr(tfvcProject)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("import %s into %s/%s", vcsURL, owner, repo), nil
+			}
+			imp, resp, err := client.Migrations.StartImport(ctx, owner, repo, in)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot import %s into %s/%s: %s", vcsURL, owner, repo, describeErrorResponse(errResp))), nil
+				}
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found, create it empty before importing into it", owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to start import: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to start import: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(summarizeImport(imp))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
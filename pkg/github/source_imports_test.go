@@ -0,0 +1,322 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SummarizeImport(t *testing.T) {
+	tests := []struct {
+		name                string
+		imp                 *github.Import
+		expectedPhase       string
+		expectedDescription string
+	}{
+		{
+			name:                "detecting",
+			imp:                 &github.Import{Status: github.Ptr("detecting")},
+			expectedPhase:       importPhaseInProgress,
+			expectedDescription: "detecting the version control system of the source repository",
+		},
+		{
+			name:                "importing with status text",
+			imp:                 &github.Import{Status: github.Ptr("importing"), StatusText: github.Ptr("Importing commits"), CommitCount: github.Ptr(1042), Percent: github.Ptr(40)},
+			expectedPhase:       importPhaseInProgress,
+			expectedDescription: "fetching the commits of the source repository (Importing commits)",
+		},
+		{
+			name:                "complete",
+			imp:                 &github.Import{Status: github.Ptr("complete")},
+			expectedPhase:       importPhaseComplete,
+			expectedDescription: "the import is complete and the repository is ready on GitHub",
+		},
+		{
+			name:                "authentication needed",
+			imp:                 &github.Import{Status: github.Ptr("auth_failed"), StatusText: github.Ptr("Authentication failed")},
+			expectedPhase:       importPhaseNeedsInput,
+			expectedDescription: "the source repository requires authentication, provide vcs_username and vcs_password",
+		},
+		{
+			name:                "error",
+			imp:                 &github.Import{Status: github.Ptr("error"), FailedStep: github.Ptr("importing"), Message: github.Ptr("svn: E170013")},
+			expectedPhase:       importPhaseFailed,
+			expectedDescription: "the import failed",
+		},
+		{
+			name:                "nothing found",
+			imp:                 &github.Import{Status: github.Ptr("detection_found_nothing")},
+			expectedPhase:       importPhaseFailed,
+			expectedDescription: "no repository was found at the source URL",
+		},
+		{
+			name:                "unknown status",
+			imp:                 &github.Import{Status: github.Ptr("setup")},
+			expectedPhase:       importPhaseInProgress,
+			expectedDescription: "the import is setup",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			summary := summarizeImport(tc.imp)
+			assert.Equal(t, tc.imp.GetStatus(), summary.Status)
+			assert.Equal(t, tc.expectedPhase, summary.Phase)
+			assert.Equal(t, tc.expectedDescription, summary.Description)
+			assert.Equal(t, tc.imp.GetCommitCount(), summary.CommitCount)
+			assert.Equal(t, tc.imp.GetPercent(), summary.Percent)
+			assert.Equal(t, tc.imp.GetFailedStep(), summary.FailedStep)
+			assert.Equal(t, tc.imp.GetMessage(), summary.Message)
+		})
+	}
+}
+
+func Test_GetImportProgress(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetImportProgress(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_import_progress", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedImport sourceImport
+		expectedErrMsg string
+	}{
+		{
+			name: "multiple projects found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposImportByOwnerByRepo,
+					&github.Import{
+						Status:  github.Ptr("detection_found_multiple"),
+						VCSURL:  github.Ptr("https://tfs.example.com/collection"),
+						HTMLURL: github.Ptr("https://github.com/owner/repo/import"),
+						ProjectChoices: []*github.Import{
+							{VCS: github.Ptr("tfvc"), TFVCProject: github.Ptr("Backend"), HumanName: github.Ptr("Backend (tfs)")},
+							{VCS: github.Ptr("git"), HumanName: github.Ptr("Frontend (git)")},
+						},
+					},
+				),
+			),
+			expectedImport: sourceImport{
+				Status:      "detection_found_multiple",
+				Phase:       importPhaseNeedsInput,
+				Description: "several projects were found at the source URL, provide the vcs and tfvc_project of one of the project choices",
+				VCSURL:      "https://tfs.example.com/collection",
+				ProjectChoices: []importProject{
+					{VCS: "tfvc", TFVCProject: "Backend", HumanName: "Backend (tfs)"},
+					{VCS: "git", HumanName: "Frontend (git)"},
+				},
+				HTMLURL: "https://github.com/owner/repo/import",
+			},
+		},
+		{
+			name: "no import",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposImportByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "no import found for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetImportProgress(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned sourceImport
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedImport, returned)
+		})
+	}
+}
+
+func Test_StartImport(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := StartImport(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "start_import", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "vcs_url")
+	assert.Contains(t, tool.InputSchema.Properties, "vcs")
+	assert.Contains(t, tool.InputSchema.Properties, "vcs_username")
+	assert.Contains(t, tool.InputSchema.Properties, "vcs_password")
+	assert.Contains(t, tool.InputSchema.Properties, "tfvc_project")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "vcs_url"})
+
+	started := &github.Import{
+		Status:  github.Ptr("importing"),
+		VCS:     github.Ptr("subversion"),
+		VCSURL:  github.Ptr("https://svn.example.com/project"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/import"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedImport sourceImport
+		expectedErrMsg string
+	}{
+		{
+			name: "start import with credentials",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposImportByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"vcs_url":      "https://svn.example.com/project",
+						"vcs":          "subversion",
+						"vcs_username": "octocat",
+						"vcs_password": "hunter2",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, started),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"vcs_url":      "https://svn.example.com/project",
+				"vcs":          "subversion",
+				"vcs_username": "octocat",
+				"vcs_password": "hunter2",
+			},
+			expectedImport: sourceImport{
+				Status:      "importing",
+				Phase:       importPhaseInProgress,
+				Description: "fetching the commits of the source repository",
+				VCS:         "subversion",
+				VCSURL:      "https://svn.example.com/project",
+				HTMLURL:     "https://github.com/owner/repo/import",
+			},
+		},
+		{
+			name: "start import with detection",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposImportByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"vcs_url": "https://hg.example.com/project",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Import{
+							Status: github.Ptr("detecting"),
+							VCSURL: github.Ptr("https://hg.example.com/project"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"vcs_url": "https://hg.example.com/project",
+			},
+			expectedImport: sourceImport{
+				Status:      "detecting",
+				Phase:       importPhaseInProgress,
+				Description: "detecting the version control system of the source repository",
+				VCSURL:      "https://hg.example.com/project",
+			},
+		},
+		{
+			name:         "username without password",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"vcs_url":      "https://svn.example.com/project",
+				"vcs_username": "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "vcs_username and vcs_password must be provided together",
+		},
+		{
+			name:         "tfvc project without tfvc",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"vcs_url":      "https://tfs.example.com/collection",
+				"vcs":          "git",
+				"tfvc_project": "Backend",
+			},
+			expectError:    true,
+			expectedErrMsg: "tfvc_project can only be used when vcs is tfvc",
+		},
+		{
+			name: "repository not empty",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposImportByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, json.RawMessage(`{"message": "Validation Failed", "errors": [{"resource": "Import", "code": "custom", "message": "Repository is not empty"}]}`)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"vcs_url": "https://svn.example.com/project",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot import https://svn.example.com/project into owner/repo: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := StartImport(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned sourceImport
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedImport, returned)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// starredRepository is a starred repository with the time it was starred.
+type starredRepository struct {
+	Repository minimalRepository `json:"repository"`
+	StarredAt  *time.Time        `json:"starred_at"`
+}
+
+// ListStarredReposWithTimestamps creates a tool to list the repositories a user starred, with the time each was starred.
+func ListStarredReposWithTimestamps(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_starred_repos_with_timestamps",
+			mcp.WithDescription(t("TOOL_LIST_STARRED_REPOS_WITH_TIMESTAMPS_DESCRIPTION", "List the repositories starred by the authenticated user or another GitHub user, with the time each was starred, most recently starred first by default")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_STARRED_REPOS_WITH_TIMESTAMPS_USER_TITLE", "List starred repositories with timestamps"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("username",
+				mcp.Description("User whose stars to list, defaults to the authenticated user"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by when the repository was starred (created) or last pushed to (updated), default created"),
+				mcp.Enum("created", "updated"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction, default desc"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sort == "" {
+				sort = "created"
+			}
+			if sort != "created" && sort != "updated" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort: %s, must be created or updated", sort)), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if direction == "" {
+				direction = "desc"
+			}
+			if direction != "asc" && direction != "desc" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid direction: %s, must be asc or desc", direction)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// ListStarred asks for the star+json media type, the only one the starred_at times
+			// are returned with. An empty username lists the stars of the authenticated user.
+			starred, resp, err := client.Activity.ListStarred(ctx, username, &github.ActivityListStarredOptions{
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			})
+			if err != nil {
+				if username != "" && resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("user %s not found", username)), nil
+				}
+				return nil, fmt.Errorf("failed to list starred repositories: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list starred repositories: %s", string(body))), nil
+			}
+
+			repos := make([]starredRepository, 0, len(starred))
+			for _, star := range starred {
+				repo := starredRepository{Repository: minimizeRepository(star.GetRepository())}
+				if star.StarredAt != nil {
+					repo.StarredAt = &star.StarredAt.Time
+				}
+				repos = append(repos, repo)
+			}
+
+			r, err := json.Marshal(repos)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListStarredReposWithTimestamps(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListStarredReposWithTimestamps(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_starred_repos_with_timestamps", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	newer := time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC)
+	older := time.Date(2025, 11, 20, 8, 0, 0, 0, time.UTC)
+	mockStarred := []*github.StarredRepository{
+		{
+			StarredAt:  &github.Timestamp{Time: newer},
+			Repository: &github.Repository{FullName: github.Ptr("octo/tool"), StargazersCount: github.Ptr(12), Language: github.Ptr("Go")},
+		},
+		{
+			StarredAt:  &github.Timestamp{Time: older},
+			Repository: &github.Repository{FullName: github.Ptr("octo/docs"), Topics: []string{"docs"}},
+		},
+	}
+
+	// starredHandler checks that the starred_at times are asked for, else GitHub omits them
+	starredHandler := func(expectedPath string, query map[string]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, expectedPath, r.URL.Path)
+			assert.Contains(t, r.Header.Get("Accept"), "application/vnd.github.v3.star+json")
+			expectQueryParams(t, query).andThen(mockResponse(t, http.StatusOK, mockStarred))(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult []starredRepository
+		expectedErrMsg string
+	}{
+		{
+			name: "stars of the authenticated user, most recent first by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUserStarred,
+					starredHandler("/user/starred", map[string]string{
+						"sort":      "created",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{},
+			expectedResult: []starredRepository{
+				{Repository: minimalRepository{FullName: "octo/tool", Stars: 12, Language: "Go"}, StarredAt: &newer},
+				{Repository: minimalRepository{FullName: "octo/docs", Topics: []string{"docs"}}, StarredAt: &older},
+			},
+		},
+		{
+			name: "stars of another user, sorted and paged",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersStarredByUsername,
+					starredHandler("/users/hubot/starred", map[string]string{
+						"sort":      "updated",
+						"direction": "asc",
+						"page":      "2",
+						"per_page":  "5",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username":  "hubot",
+				"sort":      "updated",
+				"direction": "asc",
+				"page":      float64(2),
+				"perPage":   float64(5),
+			},
+			expectedResult: []starredRepository{
+				{Repository: minimalRepository{FullName: "octo/tool", Stars: 12, Language: "Go"}, StarredAt: &newer},
+				{Repository: minimalRepository{FullName: "octo/docs", Topics: []string{"docs"}}, StarredAt: &older},
+			},
+		},
+		{
+			name:         "invalid sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"sort": "stars",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid sort: stars, must be created or updated",
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersStarredByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "ghost",
+			},
+			expectError:    true,
+			expectedErrMsg: "user ghost not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListStarredReposWithTimestamps(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError)
+
+			var returned []starredRepository
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned, len(tc.expectedResult))
+			for i, expected := range tc.expectedResult {
+				assert.Equal(t, expected.Repository, returned[i].Repository)
+				require.NotNil(t, returned[i].StarredAt)
+				assert.True(t, expected.StarredAt.Equal(*returned[i].StarredAt))
+			}
+		})
+	}
+}
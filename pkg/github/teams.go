@@ -0,0 +1,644 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ListTeams creates a tool to list the teams in an organization.
+func ListTeams(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_teams",
+			mcp.WithDescription(t("TOOL_LIST_TEAMS_DESCRIPTION", "List the teams in a GitHub organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TEAMS_USER_TITLE", "List teams"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			teams, resp, err := client.Teams.ListTeams(ctx, org, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list teams: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list teams: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(teams)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetTeamBySlug creates a tool to get the details of a team by its slug.
+func GetTeamBySlug(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_team",
+			mcp.WithDescription(t("TOOL_GET_TEAM_DESCRIPTION", "Get details of a team in a GitHub organization by its slug")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_TEAM_USER_TITLE", "Get team details"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			team, resp, err := client.Teams.GetTeamBySlug(ctx, org, teamSlug)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get team: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get team: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(team)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListTeamMembers creates a tool to list the members of a team.
+func ListTeamMembers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_team_members",
+			mcp.WithDescription(t("TOOL_LIST_TEAM_MEMBERS_DESCRIPTION", "List the members of a team in a GitHub organization, optionally filtered by role")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TEAM_MEMBERS_USER_TITLE", "List team members"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("role",
+				mcp.Description("Filter members by their role in the team"),
+				mcp.Enum("all", "member", "maintainer"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if role != "" && role != "all" && role != "member" && role != "maintainer" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid role: %s, must be one of all, member or maintainer", role)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.TeamListTeamMembersOptions{
+				Role: role,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			members, resp, err := client.Teams.ListTeamMembersBySlug(ctx, org, teamSlug, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list team members: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list team members: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(members)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddOrUpdateTeamMembership creates a tool to add a user to a team or update their role in it.
+func AddOrUpdateTeamMembership(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_or_update_team_membership",
+			mcp.WithDescription(t("TOOL_ADD_OR_UPDATE_TEAM_MEMBERSHIP_DESCRIPTION", "Add a user to a team in a GitHub organization, or update the role of an existing team member")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_OR_UPDATE_TEAM_MEMBERSHIP_USER_TITLE", "Add or update team membership"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("The handle for the GitHub user account"),
+			),
+			mcp.WithString("role",
+				mcp.Description("The role that this user should have in the team, defaults to member"),
+				mcp.Enum("member", "maintainer"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, err := OptionalParam[string](request, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if role == "" {
+				role = "member"
+			}
+			if role != "member" && role != "maintainer" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid role: %s, must be one of member or maintainer", role)), nil
+			}
+
+			opts := &github.TeamAddTeamMembershipOptions{
+				Role: role,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("add %s to team %s of %s as %s", username, teamSlug, org, role), nil
+			}
+			membership, resp, err := client.Teams.AddTeamMembershipBySlug(ctx, org, teamSlug, username, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add team membership: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add team membership: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(membership)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RemoveTeamMembership creates a tool to remove a user from a team.
+func RemoveTeamMembership(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_team_membership",
+			mcp.WithDescription(t("TOOL_REMOVE_TEAM_MEMBERSHIP_DESCRIPTION", "Remove a user from a team in a GitHub organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REMOVE_TEAM_MEMBERSHIP_USER_TITLE", "Remove team membership"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("The handle for the GitHub user account"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("remove %s from team %s of %s", username, teamSlug, org), nil
+			}
+			resp, err := client.Teams.RemoveTeamMembershipBySlug(ctx, org, teamSlug, username)
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove team membership: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to remove team membership: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %s from team %s/%s", username, org, teamSlug)), nil
+		}
+}
+
+// ListTeamRepos creates a tool to list the repositories a team has access to.
+func ListTeamRepos(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_team_repos",
+			mcp.WithDescription(t("TOOL_LIST_TEAM_REPOS_DESCRIPTION", "List the repositories a team in a GitHub organization has access to")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TEAM_REPOS_USER_TITLE", "List team repositories"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repos, resp, err := client.Teams.ListTeamReposBySlug(ctx, org, teamSlug, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list team repositories: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list team repositories: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(repos)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddTeamRepo creates a tool to grant a team access to a repository.
+func AddTeamRepo(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_team_repo",
+			mcp.WithDescription(t("TOOL_ADD_TEAM_REPO_DESCRIPTION", "Grant a team in a GitHub organization access to a repository, or update the team's permission on it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_TEAM_REPO_USER_TITLE", "Add team repository access"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("permission",
+				mcp.Required(),
+				mcp.Description("The permission to grant the team on this repository"),
+				mcp.Enum("pull", "triage", "push", "maintain", "admin"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := requiredParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch permission {
+			case "pull", "triage", "push", "maintain", "admin":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid permission: %s, must be one of pull, triage, push, maintain or admin", permission)), nil
+			}
+
+			opts := &github.TeamAddTeamRepoOptions{
+				Permission: permission,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("give team %s of %s %s access to %s/%s", teamSlug, org, permission, owner, repo), nil
+			}
+			resp, err := client.Teams.AddTeamRepoBySlug(ctx, org, teamSlug, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add team repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add team repository: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Granted team %s/%s %s access to %s/%s", org, teamSlug, permission, owner, repo)), nil
+		}
+}
+
+// RemoveTeamRepo creates a tool to revoke a team's access to a repository.
+func RemoveTeamRepo(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_team_repo",
+			mcp.WithDescription(t("TOOL_REMOVE_TEAM_REPO_DESCRIPTION", "Revoke a team's access to a repository in a GitHub organization")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_REMOVE_TEAM_REPO_USER_TITLE", "Remove team repository access"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("remove %s/%s from team %s of %s", owner, repo, teamSlug, org), nil
+			}
+			resp, err := client.Teams.RemoveTeamRepoBySlug(ctx, org, teamSlug, owner, repo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to remove team repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to remove team repository: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("Revoked team %s/%s access to %s/%s", org, teamSlug, owner, repo)), nil
+		}
+}
+
+// IsTeamRepo creates a tool to check whether a team has access to a repository, and with which permissions.
+func IsTeamRepo(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_team_repo",
+			mcp.WithDescription(t("TOOL_CHECK_TEAM_REPO_DESCRIPTION", "Check whether a team in a GitHub organization has access to a repository, and report the team's permissions on it")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_TEAM_REPO_USER_TITLE", "Check team repository access"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("The slug of the team name"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := requiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			repository, resp, err := client.Teams.IsTeamRepoBySlug(ctx, org, teamSlug, owner, repo)
+			if err != nil {
+				// A 404 means the team does not have access to the repository, which is a valid answer.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultText(fmt.Sprintf("Team %s/%s does not have access to %s/%s", org, teamSlug, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to check team repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to check team repository: %s", string(body))), nil
+			}
+
+			result := map[string]interface{}{
+				"has_access":  true,
+				"repository":  repository.GetFullName(),
+				"permissions": repository.GetPermissions(),
+				"role_name":   repository.GetRoleName(),
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,924 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListTeams(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTeams(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_teams", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockTeams := []*github.Team{
+		{ID: github.Ptr(int64(1)), Name: github.Ptr("Core"), Slug: github.Ptr("core")},
+		{ID: github.Ptr(int64(2)), Name: github.Ptr("Docs"), Slug: github.Ptr("docs")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedTeams  []*github.Team
+		expectedErrMsg string
+	}{
+		{
+			name: "successful teams listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsByOrg,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTeams),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":     "org",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:   false,
+			expectedTeams: mockTeams,
+		},
+		{
+			name: "teams listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsByOrg,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list teams",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListTeams(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedTeams []*github.Team
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTeams)
+			require.NoError(t, err)
+			assert.Len(t, returnedTeams, len(tc.expectedTeams))
+			for i, team := range returnedTeams {
+				assert.Equal(t, *tc.expectedTeams[i].Slug, *team.Slug)
+			}
+		})
+	}
+}
+
+func Test_GetTeamBySlug(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTeamBySlug(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_team", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug"})
+
+	mockTeam := &github.Team{
+		ID:          github.Ptr(int64(1)),
+		Name:        github.Ptr("Core"),
+		Slug:        github.Ptr("core"),
+		Permission:  github.Ptr("push"),
+		Description: github.Ptr("Core maintainers"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedTeam   *github.Team
+		expectedErrMsg string
+	}{
+		{
+			name: "successful team fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsTeamsByOrgByTeamSlug,
+					mockTeam,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+			},
+			expectError:  false,
+			expectedTeam: mockTeam,
+		},
+		{
+			name: "team not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsByOrgByTeamSlug,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get team",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTeamBySlug(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedTeam github.Team
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTeam)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedTeam.Slug, *returnedTeam.Slug)
+			assert.Equal(t, *tc.expectedTeam.Permission, *returnedTeam.Permission)
+		})
+	}
+}
+
+func Test_ListTeamMembers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTeamMembers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_team_members", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug"})
+
+	mockMembers := []*github.User{
+		{Login: github.Ptr("maintainer1")},
+		{Login: github.Ptr("maintainer2")},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedMembers []*github.User
+		expectedErrMsg  string
+	}{
+		{
+			name: "list members filtered by role",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembersByOrgByTeamSlug,
+					expectQueryParams(t, map[string]string{
+						"role":     "maintainer",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMembers),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"role":      "maintainer",
+			},
+			expectError:     false,
+			expectedMembers: mockMembers,
+		},
+		{
+			name: "list members without role filter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembersByOrgByTeamSlug,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMembers),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+			},
+			expectError:     false,
+			expectedMembers: mockMembers,
+		},
+		{
+			name:         "invalid role",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"role":      "owner",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid role: owner, must be one of all, member or maintainer",
+		},
+		{
+			name: "list members fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsMembersByOrgByTeamSlug,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list team members",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListTeamMembers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedMembers []*github.User
+			err = json.Unmarshal([]byte(textContent.Text), &returnedMembers)
+			require.NoError(t, err)
+			assert.Len(t, returnedMembers, len(tc.expectedMembers))
+			for i, member := range returnedMembers {
+				assert.Equal(t, *tc.expectedMembers[i].Login, *member.Login)
+			}
+		})
+	}
+}
+
+func Test_AddOrUpdateTeamMembership(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddOrUpdateTeamMembership(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_or_update_team_membership", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "role")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRole   string
+		expectedErrMsg string
+	}{
+		{
+			name: "add maintainer passes role through",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					expectRequestBody(t, map[string]any{
+						"role": "maintainer",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Membership{
+							Role:  github.Ptr("maintainer"),
+							State: github.Ptr("active"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+				"role":      "maintainer",
+			},
+			expectError:  false,
+			expectedRole: "maintainer",
+		},
+		{
+			name: "role defaults to member",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					expectRequestBody(t, map[string]any{
+						"role": "member",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Membership{
+							Role:  github.Ptr("member"),
+							State: github.Ptr("pending"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+			},
+			expectError:  false,
+			expectedRole: "member",
+		},
+		{
+			name:         "invalid role",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+				"role":      "owner",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid role: owner",
+		},
+		{
+			name: "add membership fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add team membership",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddOrUpdateTeamMembership(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedMembership github.Membership
+			err = json.Unmarshal([]byte(textContent.Text), &returnedMembership)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, *returnedMembership.Role)
+		})
+	}
+}
+
+func Test_RemoveTeamMembership(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveTeamMembership(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_team_membership", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful removal",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+			},
+			expectError:  false,
+			expectedText: "Removed octocat from team org/core",
+		},
+		{
+			name: "removal fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsTeamsMembershipsByOrgByTeamSlugByUsername,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"username":  "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to remove team membership",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveTeamMembership(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_ListTeamRepos(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTeamRepos(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_team_repos", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug"})
+
+	mockRepos := []*github.Repository{
+		{Name: github.Ptr("repo1"), FullName: github.Ptr("org/repo1")},
+		{Name: github.Ptr("repo2"), FullName: github.Ptr("org/repo2")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRepos  []*github.Repository
+		expectedErrMsg string
+	}{
+		{
+			name: "successful repos listing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsTeamsReposByOrgByTeamSlug,
+					mockRepos,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+			},
+			expectError:   false,
+			expectedRepos: mockRepos,
+		},
+		{
+			name: "repos listing fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsReposByOrgByTeamSlug,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list team repositories",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListTeamRepos(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedRepos []*github.Repository
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRepos)
+			require.NoError(t, err)
+			assert.Len(t, returnedRepos, len(tc.expectedRepos))
+			for i, repo := range returnedRepos {
+				assert.Equal(t, *tc.expectedRepos[i].FullName, *repo.FullName)
+			}
+		})
+	}
+}
+
+func Test_AddTeamRepo(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddTeamRepo(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_team_repo", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "owner", "repo", "permission"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "grant push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"permission": "push",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":        "org",
+				"team_slug":  "core",
+				"owner":      "org",
+				"repo":       "repo",
+				"permission": "push",
+			},
+			expectError:  false,
+			expectedText: "Granted team org/core push access to org/repo",
+		},
+		{
+			name:         "invalid permission",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":        "org",
+				"team_slug":  "core",
+				"owner":      "org",
+				"repo":       "repo",
+				"permission": "write",
+			},
+			expectError:  false,
+			expectedText: "invalid permission: write",
+		},
+		{
+			name: "grant fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":        "org",
+				"team_slug":  "core",
+				"owner":      "org",
+				"repo":       "repo",
+				"permission": "admin",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add team repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddTeamRepo(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func Test_RemoveTeamRepo(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveTeamRepo(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_team_repo", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "revoke access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"owner":     "org",
+				"repo":      "repo",
+			},
+			expectError:  false,
+			expectedText: "Revoked team org/core access to org/repo",
+		},
+		{
+			name: "revoke fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Forbidden"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"owner":     "org",
+				"repo":      "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to remove team repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveTeamRepo(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_IsTeamRepo(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := IsTeamRepo(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "check_team_repo", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "team_slug", "owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "team has access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					&github.Repository{
+						FullName:    github.Ptr("org/repo"),
+						Permissions: map[string]bool{"pull": true, "push": true, "admin": false},
+						RoleName:    github.Ptr("write"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"owner":     "org",
+				"repo":      "repo",
+			},
+			expectError:  false,
+			expectedText: `"role_name":"write"`,
+		},
+		{
+			name: "team has no access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamsReposByOrgByTeamSlugByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "org",
+				"team_slug": "core",
+				"owner":     "org",
+				"repo":      "repo",
+			},
+			expectError:  false,
+			expectedText: "Team org/core does not have access to org/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := IsTeamRepo(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	tokenTypeClassic     = "classic"
+	tokenTypeFineGrained = "fine-grained"
+)
+
+// impliedScopes lists the scopes granted implicitly by a classic token scope.
+// See https://docs.github.com/apps/oauth-apps/building-oauth-apps/scopes-for-oauth-apps
+var impliedScopes = map[string][]string{
+	"repo":             {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:org":        {"write:org", "read:org", "manage_runners:org"},
+	"write:org":        {"read:org"},
+	"admin:public_key": {"write:public_key", "read:public_key"},
+	"write:public_key": {"read:public_key"},
+	"admin:repo_hook":  {"write:repo_hook", "read:repo_hook"},
+	"write:repo_hook":  {"read:repo_hook"},
+	"admin:org_hook":   {},
+	"user":             {"read:user", "user:email", "user:follow"},
+	"write:packages":   {"read:packages"},
+	"admin:gpg_key":    {"write:gpg_key", "read:gpg_key"},
+	"write:gpg_key":    {"read:gpg_key"},
+	"project":          {"read:project"},
+	"write:discussion": {"read:discussion"},
+}
+
+// tokenScopes describes what the current token is allowed to do.
+type tokenScopes struct {
+	TokenType string `json:"token_type"`
+	// Scopes are the scopes granted to a classic token, including implied ones. Fine-grained
+	// and GitHub App tokens have no scopes, their access is reported per repository instead.
+	Scopes                []string        `json:"scopes"`
+	RepositoryPermissions map[string]bool `json:"repository_permissions,omitempty"`
+	// Note tells when required_scopes could not be checked, so the result is not read as a pass.
+	Note string `json:"note,omitempty"`
+}
+
+// hasScope reports whether a classic token has been granted scope, directly or implicitly.
+func (s *tokenScopes) hasScope(scope string) bool {
+	for _, granted := range s.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// parseScopesHeader parses the X-OAuth-Scopes header of a classic token into its
+// sorted scopes, expanding the scopes implied by broader ones.
+func parseScopesHeader(header string) []string {
+	set := make(map[string]bool)
+	var add func(scope string)
+	add = func(scope string) {
+		if scope == "" || set[scope] {
+			return
+		}
+		set[scope] = true
+		for _, implied := range impliedScopes[scope] {
+			add(implied)
+		}
+	}
+	for _, scope := range strings.Split(header, ",") {
+		add(strings.TrimSpace(scope))
+	}
+
+	scopes := make([]string, 0, len(set))
+	for scope := range set {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
+
+type tokenScopesCacheKey struct{}
+
+// tokenScopesCache holds the token scopes looked up while serving a single request.
+type tokenScopesCache struct {
+	once   sync.Once
+	scopes *tokenScopes
+	err    error
+}
+
+// ContextWithTokenScopesCache returns a context in which the token scopes are looked up at most once,
+// so several checks while serving the same request share a single API call.
+func ContextWithTokenScopesCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tokenScopesCacheKey{}, &tokenScopesCache{})
+}
+
+// getTokenScopes returns the scopes of the token used by client, from the request cache when there is one.
+func getTokenScopes(ctx context.Context, client *github.Client) (*tokenScopes, error) {
+	cache, ok := ctx.Value(tokenScopesCacheKey{}).(*tokenScopesCache)
+	if !ok {
+		return fetchTokenScopes(ctx, client)
+	}
+	cache.once.Do(func() {
+		cache.scopes, cache.err = fetchTokenScopes(ctx, client)
+	})
+	return cache.scopes, cache.err
+}
+
+// fetchTokenScopes calls the API to find out the token type and scopes. Only classic tokens
+// report their scopes, in the X-OAuth-Scopes header which is absent for other token types.
+func fetchTokenScopes(ctx context.Context, client *github.Client) (*tokenScopes, error) {
+	_, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if _, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]; !ok {
+		return &tokenScopes{TokenType: tokenTypeFineGrained, Scopes: []string{}}, nil
+	}
+	return &tokenScopes{
+		TokenType: tokenTypeClassic,
+		Scopes:    parseScopesHeader(resp.Header.Get("X-OAuth-Scopes")),
+	}, nil
+}
+
+// CheckTokenScopes creates a tool to report which capabilities the current token has.
+func CheckTokenScopes(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_token_scopes",
+			mcp.WithDescription(t("TOOL_CHECK_TOKEN_SCOPES_DESCRIPTION", "Check which scopes the current GitHub token has, and optionally whether it has specific scopes or access to a repository. Use this before write operations to fail fast instead of hitting permission errors")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_TOKEN_SCOPES_USER_TITLE", "Check token scopes"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithArray("required_scopes",
+				mcp.Description("Classic token scopes the token must have, e.g. 'repo' or 'read:org'. Not applicable to fine-grained tokens, which the result notes"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Owner of a repository to check the token's permissions on, required for fine-grained tokens"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Name of a repository to check the token's permissions on, required for fine-grained tokens"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			requiredScopes, err := OptionalStringArrayParam(request, "required_scopes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (owner == "") != (repo == "") {
+				return mcp.NewToolResultError("owner and repo must be provided together"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			scopes, err := getTokenScopes(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+
+			if scopes.TokenType == tokenTypeClassic {
+				for _, required := range requiredScopes {
+					if !scopes.hasScope(required) {
+						return mcp.NewToolResultError(fmt.Sprintf("token lacks %s scope, it has: %s", required, strings.Join(scopes.Scopes, ", "))), nil
+					}
+				}
+			}
+
+			result := *scopes
+			if scopes.TokenType != tokenTypeClassic && len(requiredScopes) > 0 {
+				result.Note = "required_scopes not applicable to fine-grained tokens, check repository_permissions instead"
+			}
+			if owner != "" {
+				// Fine-grained tokens have no scopes to inspect, so probe the repository instead:
+				// its permissions field reflects what the token may do there.
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("token cannot access repository %s/%s", owner, repo)), nil
+					}
+					return nil, fmt.Errorf("failed to get repository: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+				result.RepositoryPermissions = repository.GetPermissions()
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
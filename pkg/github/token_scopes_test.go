@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseScopesHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected []string
+	}{
+		{
+			name:     "empty header",
+			header:   "",
+			expected: []string{},
+		},
+		{
+			name:     "single scope with whitespace",
+			header:   " gist ",
+			expected: []string{"gist"},
+		},
+		{
+			name:     "repo implies its sub-scopes",
+			header:   "repo, read:org",
+			expected: []string{"public_repo", "read:org", "repo", "repo:invite", "repo:status", "repo_deployment", "security_events"},
+		},
+		{
+			name:     "admin org implies write and read org",
+			header:   "admin:org,workflow",
+			expected: []string{"admin:org", "manage_runners:org", "read:org", "workflow", "write:org"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseScopesHeader(tc.header))
+		})
+	}
+}
+
+// mockUserWithScopes answers GET /user with a user and the given X-OAuth-Scopes header,
+// leaving the header out when scopes is nil as the API does for fine-grained tokens.
+func mockUserWithScopes(scopes *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if scopes != nil {
+			w.Header().Set("X-OAuth-Scopes", *scopes)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"login":"testuser"}`))
+	}
+}
+
+func Test_CheckTokenScopes(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckTokenScopes(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "check_token_scopes", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "required_scopes")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockRepo := &github.Repository{
+		FullName:    github.Ptr("owner/repo"),
+		Permissions: map[string]bool{"admin": false, "push": true, "pull": true},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult tokenScopes
+		expectedErrMsg string
+	}{
+		{
+			name: "classic token with required scopes",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockUserWithScopes(github.Ptr("repo, read:org")),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"required_scopes": []interface{}{"public_repo", "read:org"},
+			},
+			expectError: false,
+			expectedResult: tokenScopes{
+				TokenType: tokenTypeClassic,
+				Scopes:    []string{"public_repo", "read:org", "repo", "repo:invite", "repo:status", "repo_deployment", "security_events"},
+			},
+		},
+		{
+			name: "classic token lacks repo scope",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockUserWithScopes(github.Ptr("read:org, gist")),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"required_scopes": []interface{}{"repo"},
+			},
+			expectError:    false,
+			expectedErrMsg: "token lacks repo scope, it has: gist, read:org",
+		},
+		{
+			name: "fine-grained token probes repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockUserWithScopes(nil),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"required_scopes": []interface{}{"repo"},
+				"owner":           "owner",
+				"repo":            "repo",
+			},
+			expectError: false,
+			expectedResult: tokenScopes{
+				TokenType:             tokenTypeFineGrained,
+				Scopes:                []string{},
+				RepositoryPermissions: map[string]bool{"admin": false, "push": true, "pull": true},
+				Note:                  "required_scopes not applicable to fine-grained tokens, check repository_permissions instead",
+			},
+		},
+		{
+			name: "fine-grained token without repository access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockUserWithScopes(nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "token cannot access repository owner/repo",
+		},
+		{
+			name:         "owner without repo",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+			},
+			expectError:    false,
+			expectedErrMsg: "owner and repo must be provided together",
+		},
+		{
+			name: "user lookup fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUser,
+					mockResponse(t, http.StatusUnauthorized, `{"message": "Bad credentials"}`),
+				),
+			),
+			requestArgs:    map[string]interface{}{},
+			expectError:    true,
+			expectedErrMsg: "failed to get user",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CheckTokenScopes(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returned tokenScopes
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetTokenScopesCachesPerContext(t *testing.T) {
+	calls := 0
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetUser,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				mockUserWithScopes(github.Ptr("repo"))(w, r)
+			}),
+		),
+	))
+
+	ctx := ContextWithTokenScopesCache(context.Background())
+	first, err := getTokenScopes(ctx, client)
+	require.NoError(t, err)
+	second, err := getTokenScopes(ctx, client)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, calls)
+
+	// A context without a cache looks the scopes up every time
+	_, err = getTokenScopes(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func Test_CheckTokenScopesSharesCacheInBatch(t *testing.T) {
+	var calls atomic.Int32
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetUser,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				mockUserWithScopes(github.Ptr("repo"))(w, r)
+			}),
+		),
+	))
+	s := NewServer("test")
+	s.AddTool(CheckTokenScopes(stubGetClientFn(client), translations.NullTranslationHelper))
+	s.AddTool(BatchExecute(s, true, translations.NullTranslationHelper))
+
+	returned := callBatch(t, s, map[string]interface{}{
+		"calls": []interface{}{
+			map[string]interface{}{"tool": "check_token_scopes", "arguments": map[string]interface{}{"required_scopes": []interface{}{"repo"}}},
+			map[string]interface{}{"tool": "check_token_scopes", "arguments": map[string]interface{}{"required_scopes": []interface{}{"public_repo"}}},
+		},
+	})
+	require.Len(t, returned, 2)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Separate tool calls look the scopes up again
+	callTool(t, s, "check_token_scopes", map[string]interface{}{})
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// redactedValue replaces the values of sensitive arguments in the logs.
+const redactedValue = "[REDACTED]"
+
+// sensitiveArgumentMarkers are the parts of an argument name that mark its value as sensitive, such
+// as the value of a secret or the content of a file, which may hold credentials.
+var sensitiveArgumentMarkers = []string{"secret", "token", "password", "private_key", "content"}
+
+// isSensitiveArgument reports whether the value of the named argument must not be logged.
+func isSensitiveArgument(name string) bool {
+	name = strings.ToLower(name)
+	for _, marker := range sensitiveArgumentMarkers {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactArguments returns a copy of the arguments of a tool call with the values of sensitive
+// arguments replaced, including those nested in objects and arrays such as the files of push_files.
+func redactArguments(arguments map[string]any) map[string]any {
+	redacted := make(map[string]any, len(arguments))
+	for name, value := range arguments {
+		if isSensitiveArgument(name) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = redactValue(value)
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return redactArguments(v)
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			items = append(items, redactValue(item))
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// ToolCallLoggingMiddleware logs every tool call to logger, with the name of the tool, its arguments
+// with the sensitive ones redacted, how long it took and whether it succeeded. Calls that fail with
+// a tool error are logged at warning level and calls that fail with an error at error level.
+func ToolCallLoggingMiddleware(logger *slog.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, request)
+
+			level, status := slog.LevelInfo, "ok"
+			attrs := []slog.Attr{
+				slog.String("tool", request.Params.Name),
+				slog.Any("arguments", redactArguments(request.GetArguments())),
+				slog.Duration("duration", time.Since(start)),
+			}
+			switch {
+			case err != nil:
+				level, status = slog.LevelError, "error"
+				attrs = append(attrs, slog.String("error", err.Error()))
+			case result != nil && result.IsError:
+				level, status = slog.LevelWarn, "tool_error"
+			}
+			attrs = append(attrs, slog.String("status", status))
+			logger.LogAttrs(ctx, level, "tool call", attrs...)
+
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ToolMetricsFunc is called after every tool call with the name of the tool, how long the call
+// took, the error it failed with if any, and the status of the last GitHub API response it got,
+// 0 if unknown. Calls that return a tool error are reported with an error holding its message.
+type ToolMetricsFunc func(toolName string, duration time.Duration, err error, httpStatus int)
+
+// WithToolMetrics returns a server option reporting every tool call to fn, e.g. to feed the
+// counters and histograms of a metrics library.
+//
+// The status of the GitHub API responses is only known for a failed request, unless the HTTP
+// client of the GitHub client sends its requests through NewStatusRecordingTransport.
+func WithToolMetrics(fn ToolMetricsFunc) server.ServerOption {
+	return server.WithToolHandlerMiddleware(toolMetricsMiddleware(fn))
+}
+
+// toolResultError is the error reported to a ToolMetricsFunc for a call returning a tool error.
+type toolResultError struct {
+	message string
+}
+
+func (e *toolResultError) Error() string {
+	return e.message
+}
+
+type httpStatusRecorderKey struct{}
+
+// httpStatusRecorder holds the status of the last GitHub API response of a tool call.
+type httpStatusRecorder struct {
+	status atomic.Int64
+}
+
+// statusRecordingTransport records the status of each response in the httpStatusRecorder of the
+// context of its request.
+type statusRecordingTransport struct {
+	base http.RoundTripper
+}
+
+// NewStatusRecordingTransport wraps base, http.DefaultTransport if nil, so that WithToolMetrics
+// reports the status of the GitHub API responses of each tool call.
+func NewStatusRecordingTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &statusRecordingTransport{base: base}
+}
+
+func (t *statusRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if resp != nil {
+		if recorder, ok := req.Context().Value(httpStatusRecorderKey{}).(*httpStatusRecorder); ok {
+			recorder.status.Store(int64(resp.StatusCode))
+		}
+	}
+	return resp, err
+}
+
+// errorHTTPStatus returns the status of the GitHub API response err was built from, if any.
+func errorHTTPStatus(err error) int {
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode
+	}
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.Response != nil {
+		return rateLimitErr.Response.StatusCode
+	}
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) && abuseErr.Response != nil {
+		return abuseErr.Response.StatusCode
+	}
+	return 0
+}
+
+func toolMetricsMiddleware(fn ToolMetricsFunc) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			recorder := &httpStatusRecorder{}
+			start := time.Now()
+			result, err := next(context.WithValue(ctx, httpStatusRecorderKey{}, recorder), request)
+			duration := time.Since(start)
+
+			reported := err
+			if reported == nil && result != nil && result.IsError {
+				message := "tool error"
+				if len(result.Content) > 0 {
+					if text, ok := result.Content[0].(mcp.TextContent); ok {
+						message = text.Text
+					}
+				}
+				reported = &toolResultError{message: message}
+			}
+			status := int(recorder.status.Load())
+			if status == 0 {
+				status = errorHTTPStatus(err)
+			}
+			fn(request.Params.Name, duration, reported, status)
+
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToolMetricsMiddleware(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		recordStatuses     bool
+		expectHandlerErr   bool
+		expectedErrMsg     string
+		expectedHTTPStatus int
+	}{
+		{
+			name: "successful call",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetGistsByGistId,
+					&github.Gist{ID: github.Ptr("abc123")},
+				),
+			),
+			recordStatuses:     true,
+			expectedHTTPStatus: http.StatusOK,
+		},
+		{
+			name: "call returning a tool error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			recordStatuses:     true,
+			expectedErrMsg:     "gist abc123 not found",
+			expectedHTTPStatus: http.StatusNotFound,
+		},
+		{
+			name: "failed call",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			recordStatuses:     true,
+			expectHandlerErr:   true,
+			expectedErrMsg:     "failed to get gist",
+			expectedHTTPStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "failed call without status recording",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetGistsByGistId,
+					mockResponse(t, http.StatusBadGateway, `{"message": "Bad Gateway"}`),
+				),
+			),
+			expectHandlerErr:   true,
+			expectedErrMsg:     "failed to get gist",
+			expectedHTTPStatus: http.StatusBadGateway,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.recordStatuses {
+				tc.mockedClient.Transport = NewStatusRecordingTransport(tc.mockedClient.Transport)
+			}
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetGist(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			var calls int
+			var toolName string
+			var duration time.Duration
+			var reportedErr error
+			var httpStatus int
+			handler = toolMetricsMiddleware(func(name string, d time.Duration, err error, status int) {
+				calls++
+				toolName, duration, reportedErr, httpStatus = name, d, err, status
+			})(handler)
+
+			request := createMCPRequest(map[string]interface{}{
+				"gist_id": "abc123",
+			})
+			request.Params.Name = "get_gist"
+			_, err := handler(context.Background(), request)
+			if tc.expectHandlerErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, 1, calls)
+			assert.Equal(t, "get_gist", toolName)
+			assert.Positive(t, duration)
+			assert.Equal(t, tc.expectedHTTPStatus, httpStatus)
+			if tc.expectedErrMsg == "" {
+				assert.NoError(t, reportedErr)
+				return
+			}
+			require.Error(t, reportedErr)
+			assert.Contains(t, reportedErr.Error(), tc.expectedErrMsg)
+		})
+	}
+}
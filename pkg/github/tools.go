@@ -22,11 +22,47 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 	repos := toolsets.NewToolset("repos", "GitHub Repository related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
+			toolsets.NewServerTool(GetRepository(getClient, t)),
+			toolsets.NewServerTool(GetRepositoryOverview(getClient, t)),
+			toolsets.NewServerTool(CheckRepositoryHealth(getClient, t)),
+			toolsets.NewServerTool(ListOrgReposAdvanced(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, t)),
+			toolsets.NewServerTool(GetFileContentsBatch(getClient, t)),
+			toolsets.NewServerTool(CompareFileAcrossRefs(getClient, t)),
+			toolsets.NewServerTool(ExpandPermalink(getClient, t)),
+			toolsets.NewServerTool(GetRawFile(getClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
+			toolsets.NewServerTool(GetLatestCommitForPath(getClient, t)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
+			toolsets.NewServerTool(SearchCommits(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
+			toolsets.NewServerTool(GetCommitDiff(getClient, t)),
+			toolsets.NewServerTool(ResolveRef(getClient, t)),
+			toolsets.NewServerTool(GetCodeOwners(getClient, t)),
+			toolsets.NewServerTool(GetDiffBetweenRefs(getClient, t)),
+			toolsets.NewServerTool(ListChangedFilesInRange(getClient, t)),
+			toolsets.NewServerTool(GetPatchSeries(getClient, t)),
+			toolsets.NewServerTool(ListCommitComments(getClient, t)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
+			toolsets.NewServerTool(GetBranch(getClient, t)),
+			toolsets.NewServerTool(GetRequiredStatusChecks(getClient, t)),
+			toolsets.NewServerTool(GetMergeSettings(getClient, t)),
+			toolsets.NewServerTool(GetRepoLanguages(getClient, t)),
+			toolsets.NewServerTool(GetCommunityProfile(getClient, t)),
+			toolsets.NewServerTool(GetReadme(getClient, t)),
+			toolsets.NewServerTool(GetLicense(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryEvents(getClient, t)),
+			toolsets.NewServerTool(GetTrafficSummary(getClient, t)),
+			toolsets.NewServerTool(GetImportProgress(getClient, t)),
+			toolsets.NewServerTool(ListHookDeliveries(getClient, t)),
+			toolsets.NewServerTool(GetHookDelivery(getClient, t)),
+			toolsets.NewServerTool(VerifyWebhookSignature(t)),
+			toolsets.NewServerTool(SummarizeChangesSince(getClient, t)),
+			toolsets.NewServerTool(ListMergedPullRequestsBetweenReleases(getClient, t)),
+			toolsets.NewServerTool(DownloadReleaseAsset(getClient, t)),
+			toolsets.NewServerTool(DownloadRepositoryArchive(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryInvitations(getClient, t)),
+			toolsets.NewServerTool(ListDeployKeys(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
@@ -34,27 +70,61 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 			toolsets.NewServerTool(ForkRepository(getClient, t)),
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
 			toolsets.NewServerTool(PushFiles(getClient, t)),
+			toolsets.NewServerTool(CherryPick(getClient, t)),
+			toolsets.NewServerTool(RenameBranch(getClient, t)),
+			toolsets.NewServerTool(SetDefaultBranch(getClient, t)),
+			toolsets.NewServerTool(MergeBranch(getClient, t)),
+			toolsets.NewServerTool(SyncFork(getClient, t)),
+			toolsets.NewServerTool(StartImport(getClient, t)),
+			toolsets.NewServerTool(RedeliverHookDelivery(getClient, t)),
+			toolsets.NewServerTool(DeleteBranch(getClient, t)),
+			toolsets.NewServerTool(UpdateRequiredStatusChecks(getClient, t)),
+			toolsets.NewServerTool(UpdateMergeSettings(getClient, t)),
+			toolsets.NewServerTool(CreateCommitComment(getClient, t)),
+			toolsets.NewServerTool(DeleteCommitComment(getClient, t)),
+			toolsets.NewServerTool(CreateDeployKey(getClient, t)),
+			toolsets.NewServerTool(DeleteDeployKey(getClient, t)),
 		)
 	issues := toolsets.NewToolset("issues", "GitHub Issues related tools").
 		AddReadTools(
 			toolsets.NewServerTool(GetIssue(getClient, t)),
 			toolsets.NewServerTool(SearchIssues(getClient, t)),
+			toolsets.NewServerTool(FindIssues(getClient, t)),
 			toolsets.NewServerTool(ListIssues(getClient, t)),
 			toolsets.NewServerTool(GetIssueComments(getClient, t)),
+			toolsets.NewServerTool(ListIssueCommentReactions(getClient, t)),
+			toolsets.NewServerTool(SuggestLabels(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateIssue(getClient, t)),
+			toolsets.NewServerTool(CreateIssueFromTemplate(getClient, t)),
 			toolsets.NewServerTool(AddIssueComment(getClient, t)),
+			toolsets.NewServerTool(DeleteIssueCommentReaction(getClient, t)),
 			toolsets.NewServerTool(UpdateIssue(getClient, t)),
+			toolsets.NewServerTool(ConvertIssueToDiscussion(getClient, t)),
+			toolsets.NewServerTool(SyncLabels(getClient, t)),
+			toolsets.NewServerTool(AssignMilestone(getClient, t)),
 		)
 	users := toolsets.NewToolset("users", "GitHub User related tools").
 		AddReadTools(
 			toolsets.NewServerTool(SearchUsers(getClient, t)),
+			toolsets.NewServerTool(ListUserRepositoryInvitations(getClient, t)),
+			toolsets.NewServerTool(ListUserGPGKeys(getClient, t)),
+			toolsets.NewServerTool(ListUserSSHKeys(getClient, t)),
+			toolsets.NewServerTool(ListStarredReposWithTimestamps(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AcceptRepositoryInvitation(getClient, t)),
+			toolsets.NewServerTool(DeclineRepositoryInvitation(getClient, t)),
+			toolsets.NewServerTool(CreateUserGPGKey(getClient, t)),
+			toolsets.NewServerTool(CreateUserSSHKey(getClient, t)),
 		)
 	pullRequests := toolsets.NewToolset("pull_requests", "GitHub Pull Request related tools").
 		AddReadTools(
 			toolsets.NewServerTool(GetPullRequest(getClient, t)),
 			toolsets.NewServerTool(ListPullRequests(getClient, t)),
+			toolsets.NewServerTool(ListReviewRequests(getClient, t)),
+			toolsets.NewServerTool(ListPullRequestsForCommit(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestFiles(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestStatus(getClient, t)),
 			toolsets.NewServerTool(GetPullRequestComments(getClient, t)),
@@ -64,7 +134,9 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 			toolsets.NewServerTool(MergePullRequest(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequestReview(getClient, t)),
+			toolsets.NewServerTool(DismissPullRequestReview(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
+			toolsets.NewServerTool(CreatePullRequestFromIssue(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, t)),
 			toolsets.NewServerTool(AddPullRequestReviewComment(getClient, t)),
 		)
@@ -72,12 +144,95 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 		AddReadTools(
 			toolsets.NewServerTool(GetCodeScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListCodeScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(ListCodeQLAnalyses(getClient, t)),
+			toolsets.NewServerTool(GetCodeQLAnalysis(getClient, t)),
+			toolsets.NewServerTool(ExportSBOM(getClient, t)),
+			toolsets.NewServerTool(GetDependencyDiff(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UploadSARIF(getClient, t)),
 		)
 	secretProtection := toolsets.NewToolset("secret_protection", "Secret protection related tools, such as GitHub Secret Scanning").
 		AddReadTools(
 			toolsets.NewServerTool(GetSecretScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListSecretScanningAlerts(getClient, t)),
 		)
+	teams := toolsets.NewToolset("teams", "GitHub Organization Team related tools").
+		AddReadTools(
+			toolsets.NewServerTool(GetOrganization(getClient, t)),
+			toolsets.NewServerTool(ListMyOrganizations(getClient, t)),
+			toolsets.NewServerTool(GetMyOrgMembership(getClient, t)),
+			toolsets.NewServerTool(ListTeams(getClient, t)),
+			toolsets.NewServerTool(GetTeamBySlug(getClient, t)),
+			toolsets.NewServerTool(ListTeamMembers(getClient, t)),
+			toolsets.NewServerTool(ListTeamRepos(getClient, t)),
+			toolsets.NewServerTool(IsTeamRepo(getClient, t)),
+			toolsets.NewServerTool(ListPendingOrgInvitations(getClient, t)),
+			toolsets.NewServerTool(ListOrgPATGrants(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AddOrUpdateTeamMembership(getClient, t)),
+			toolsets.NewServerTool(RemoveTeamMembership(getClient, t)),
+			toolsets.NewServerTool(AddTeamRepo(getClient, t)),
+			toolsets.NewServerTool(RemoveTeamRepo(getClient, t)),
+			toolsets.NewServerTool(CreateOrgInvitation(getClient, t)),
+			toolsets.NewServerTool(CancelOrgInvitation(getClient, t)),
+			toolsets.NewServerTool(RevokeOrgPATGrant(getClient, t)),
+		)
+	securityAdvisories := toolsets.NewToolset("security_advisories", "Security advisory related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListRepoSecurityAdvisories(getClient, t)),
+			toolsets.NewServerTool(GetRepoSecurityAdvisory(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateRepoSecurityAdvisory(getClient, t)),
+		)
+	actions := toolsets.NewToolset("actions", "GitHub Actions related tools").
+		AddReadTools(
+			toolsets.NewServerTool(WaitForWorkflowRun(getClient, t)),
+			toolsets.NewServerTool(GetWorkflowRunUsage(getClient, t)),
+			toolsets.NewServerTool(GetWorkflowUsage(getClient, t)),
+			toolsets.NewServerTool(ListSelfHostedRunners(getClient, t)),
+			toolsets.NewServerTool(GetPendingDeployments(getClient, t)),
+			toolsets.NewServerTool(ListCheckRunAnnotations(getClient, t)),
+			toolsets.NewServerTool(GetOrgActionsPermissions(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(EnableWorkflow(getClient, t)),
+			toolsets.NewServerTool(DisableWorkflow(getClient, t)),
+			toolsets.NewServerTool(ReviewPendingDeployments(getClient, t)),
+			toolsets.NewServerTool(SetOrgActionsPermissions(getClient, t)),
+		)
+	packages := toolsets.NewToolset("packages", "GitHub Packages related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListPackages(getClient, t)),
+			toolsets.NewServerTool(GetPackage(getClient, t)),
+			toolsets.NewServerTool(ListPackageVersions(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(DeletePackageVersion(getClient, t)),
+		)
+	rulesets := toolsets.NewToolset("rulesets", "GitHub Repository Ruleset related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListRepoRulesets(getClient, t)),
+			toolsets.NewServerTool(GetRepoRuleset(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateRepoRuleset(getClient, t)),
+		)
+	autolinks := toolsets.NewToolset("autolinks", "GitHub Repository autolink reference related tools").
+		AddReadTools(
+			toolsets.NewServerTool(ListAutolinks(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateAutolink(getClient, t)),
+			toolsets.NewServerTool(DeleteAutolink(getClient, t)),
+		)
+	gists := toolsets.NewToolset("gists", "GitHub Gist related tools").
+		AddReadTools(
+			toolsets.NewServerTool(GetGist(getClient, t)),
+			toolsets.NewServerTool(ListGists(getClient, t)),
+		)
 	// Keep experiments alive so the system doesn't error out when it's always enabled
 	experiments := toolsets.NewToolset("experiments", "Experimental features that are not considered stable yet")
 
@@ -88,6 +243,13 @@ func InitToolsets(passedToolsets []string, readOnly bool, getClient GetClientFn,
 	tsg.AddToolset(pullRequests)
 	tsg.AddToolset(codeSecurity)
 	tsg.AddToolset(secretProtection)
+	tsg.AddToolset(teams)
+	tsg.AddToolset(securityAdvisories)
+	tsg.AddToolset(actions)
+	tsg.AddToolset(packages)
+	tsg.AddToolset(rulesets)
+	tsg.AddToolset(autolinks)
+	tsg.AddToolset(gists)
 	tsg.AddToolset(experiments)
 	// Enable the requested features
 
@@ -103,11 +265,29 @@ func InitContextToolset(getClient GetClientFn, t translations.TranslationHelperF
 	contextTools := toolsets.NewToolset("context", "Tools that provide context about the current user and GitHub context you are operating in").
 		AddReadTools(
 			toolsets.NewServerTool(GetMe(getClient, t)),
+			toolsets.NewServerTool(RenderMarkdown(getClient, t)),
+			toolsets.NewServerTool(CheckTokenScopes(getClient, t)),
 		)
 	contextTools.Enabled = true
 	return contextTools
 }
 
+// InitBatchToolset creates a toolset to batch calls to the other tools of the server, and so
+// requires the server as argument
+func InitBatchToolset(s *server.MCPServer, readOnly bool, t translations.TranslationHelperFunc) *toolsets.Toolset {
+	// On a read-only server the batch tool can only make read-only calls, so it is a read tool
+	// there and read-only servers can batch reads. Elsewhere its calls may write.
+	batch := toolsets.NewToolset("batch", "Make several calls to the other tools of the server in one round trip")
+	if readOnly {
+		batch.AddReadTools(toolsets.NewServerTool(BatchExecute(s, readOnly, t)))
+	} else {
+		batch.AddWriteTools(toolsets.NewServerTool(BatchExecute(s, readOnly, t)))
+	}
+
+	batch.Enabled = true
+	return batch
+}
+
 // InitDynamicToolset creates a dynamic toolset that can be used to enable other toolsets, and so requires the server and toolset group as arguments
 func InitDynamicToolset(s *server.MCPServer, tsg *toolsets.ToolsetGroup, t translations.TranslationHelperFunc) *toolsets.Toolset {
 	// Create a new dynamic toolset
@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listRegisteredTools returns the names of the tools s lists to clients.
+func listRegisteredTools(t *testing.T, s *server.MCPServer) []string {
+	t.Helper()
+	response := s.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc": "2.0", "id": 1, "method": "tools/list"}`))
+	r, err := json.Marshal(response)
+	require.NoError(t, err)
+	var listed struct {
+		Result mcp.ListToolsResult `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(r, &listed))
+
+	names := make([]string, 0, len(listed.Result.Tools))
+	for _, tool := range listed.Result.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func Test_ToolNamePrefix(t *testing.T) {
+	getClient := stubGetClientFn(github.NewClient(nil))
+	s := NewServer("test")
+
+	tsg, err := InitToolsets([]string{"all"}, false, getClient, translations.NullTranslationHelper)
+	require.NoError(t, err)
+	tsg.SetToolNamePrefix("gh_")
+	contextTools := InitContextToolset(getClient, translations.NullTranslationHelper)
+	contextTools.SetToolNamePrefix("gh_")
+	dynamic := InitDynamicToolset(s, tsg, translations.NullTranslationHelper)
+	dynamic.SetToolNamePrefix("gh_")
+
+	tsg.RegisterTools(s)
+	contextTools.RegisterTools(s)
+	dynamic.RegisterTools(s)
+
+	names := listRegisteredTools(t, s)
+	require.NotEmpty(t, names)
+	for _, name := range names {
+		assert.True(t, strings.HasPrefix(name, "gh_"), "tool %s is not prefixed", name)
+	}
+	assert.Contains(t, names, "gh_get_me")
+	assert.Contains(t, names, "gh_get_gist")
+	assert.Contains(t, names, "gh_enable_toolset")
+	assert.NotContains(t, names, "gh_gh_get_me")
+}
+
+func Test_DisabledTools(t *testing.T) {
+	getClient := stubGetClientFn(github.NewClient(nil))
+	s := NewServer("test")
+
+	tsg, err := InitToolsets([]string{"repos"}, false, getClient, translations.NullTranslationHelper)
+	require.NoError(t, err)
+	tsg.SetToolNamePrefix("gh_")
+	tsg.DisableTools("delete_branch", "merge_branch")
+	tsg.RegisterTools(s)
+
+	names := listRegisteredTools(t, s)
+	assert.NotContains(t, names, "gh_delete_branch")
+	assert.NotContains(t, names, "gh_merge_branch")
+	assert.Contains(t, names, "gh_create_branch")
+	assert.Contains(t, names, "gh_rename_branch")
+	assert.Contains(t, names, "gh_list_branches")
+
+	// Disabled tools are not offered by the dynamic toolset discovery either
+	for _, tool := range tsg.Toolsets["repos"].GetAvailableTools() {
+		assert.NotEqual(t, "gh_delete_branch", tool.Tool.Name)
+	}
+}
+
+func Test_InitBatchToolset(t *testing.T) {
+	for _, readOnly := range []bool{false, true} {
+		t.Run(fmt.Sprintf("read-only %t", readOnly), func(t *testing.T) {
+			s := NewServer("test")
+			// Adding a tool with the wrong annotation to a toolset panics, which would crash the server
+			var batch *toolsets.Toolset
+			require.NotPanics(t, func() {
+				batch = InitBatchToolset(s, readOnly, translations.NullTranslationHelper)
+			})
+			batch.RegisterTools(s)
+
+			tools, err := listServerTools(context.Background(), s)
+			require.NoError(t, err)
+			require.Contains(t, tools, "batch_execute")
+			// batch_execute only reads when the calls it makes can only read
+			assert.Equal(t, readOnly, *tools["batch_execute"].Annotations.ReadOnlyHint)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// trafficWindowDays is the number of days of traffic GitHub keeps for a repository.
+const trafficWindowDays = 14
+
+// trafficDay is the traffic of a repository on one day.
+type trafficDay struct {
+	Date          string `json:"date"`
+	Views         int    `json:"views"`
+	UniqueViewers int    `json:"unique_viewers"`
+	Clones        int    `json:"clones"`
+	UniqueCloners int    `json:"unique_cloners"`
+}
+
+// trafficSummary is the result of get_traffic_summary. The totals count unique visitors over the
+// whole window, so they are not the sums of the daily uniques.
+type trafficSummary struct {
+	Views         int          `json:"views"`
+	UniqueViewers int          `json:"unique_viewers"`
+	Clones        int          `json:"clones"`
+	UniqueCloners int          `json:"unique_cloners"`
+	Days          []trafficDay `json:"days"`
+}
+
+// trafficTimeSeries aligns the daily views and clones of a repository into one series with an
+// entry for each of the trafficWindowDays days up to end. The traffic API leaves out the days
+// without any traffic, those are filled with zeros.
+func trafficTimeSeries(views, clones []*github.TrafficData, end time.Time) []trafficDay {
+	day := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	last := day(end.UTC())
+	first := last.AddDate(0, 0, 1-trafficWindowDays)
+	// Widen the window rather than drop data outside of it
+	for _, data := range append(append([]*github.TrafficData{}, views...), clones...) {
+		d := day(data.GetTimestamp().UTC())
+		if d.Before(first) {
+			first = d
+		}
+		if d.After(last) {
+			last = d
+		}
+	}
+
+	var days []trafficDay
+	index := make(map[string]int)
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		date := d.Format(time.DateOnly)
+		index[date] = len(days)
+		days = append(days, trafficDay{Date: date})
+	}
+	for _, data := range views {
+		entry := &days[index[day(data.GetTimestamp().UTC()).Format(time.DateOnly)]]
+		entry.Views += data.GetCount()
+		entry.UniqueViewers += data.GetUniques()
+	}
+	for _, data := range clones {
+		entry := &days[index[day(data.GetTimestamp().UTC()).Format(time.DateOnly)]]
+		entry.Clones += data.GetCount()
+		entry.UniqueCloners += data.GetUniques()
+	}
+	return days
+}
+
+// GetTrafficSummary creates a tool to get the daily views and clones of a repository.
+func GetTrafficSummary(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_traffic_summary",
+			mcp.WithDescription(t("TOOL_GET_TRAFFIC_SUMMARY_DESCRIPTION", fmt.Sprintf("Get the views and clones of a GitHub repository over the last %d days, as totals and a daily series with an entry for every day. Requires push access to the repository", trafficWindowDays))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_TRAFFIC_SUMMARY_USER_TITLE", "Get repository traffic"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			forbidden := mcp.NewToolResultError(fmt.Sprintf("viewing the traffic of %s/%s requires push access to the repository", owner, repo))
+			opts := &github.TrafficBreakdownOptions{Per: "day"}
+
+			views, resp, err := client.Repositories.ListTrafficViews(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return forbidden, nil
+				}
+				return nil, fmt.Errorf("failed to get repository views: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository views: %s", string(body))), nil
+			}
+
+			clones, resp, err := client.Repositories.ListTrafficClones(ctx, owner, repo, opts)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusForbidden {
+					return forbidden, nil
+				}
+				return nil, fmt.Errorf("failed to get repository clones: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get repository clones: %s", string(body))), nil
+			}
+
+			summary := trafficSummary{
+				Views:         views.GetCount(),
+				UniqueViewers: views.GetUniques(),
+				Clones:        clones.GetCount(),
+				UniqueCloners: clones.GetUniques(),
+				Days:          trafficTimeSeries(views.Views, clones.Clones, time.Now()),
+			}
+
+			r, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,224 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func trafficData(t time.Time, count, uniques int) *github.TrafficData {
+	return &github.TrafficData{
+		Timestamp: &github.Timestamp{Time: t},
+		Count:     github.Ptr(count),
+		Uniques:   github.Ptr(uniques),
+	}
+}
+
+func Test_TrafficTimeSeries(t *testing.T) {
+	end := time.Date(2025, 4, 14, 18, 30, 0, 0, time.UTC)
+	day := func(d int) time.Time {
+		return time.Date(2025, 4, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("fills days without traffic with zeros", func(t *testing.T) {
+		days := trafficTimeSeries(
+			[]*github.TrafficData{trafficData(day(3), 10, 4), trafficData(day(10), 5, 2)},
+			nil,
+			end,
+		)
+
+		require.Len(t, days, trafficWindowDays)
+		assert.Equal(t, "2025-04-01", days[0].Date)
+		assert.Equal(t, "2025-04-14", days[trafficWindowDays-1].Date)
+		assert.Equal(t, trafficDay{Date: "2025-04-03", Views: 10, UniqueViewers: 4}, days[2])
+		assert.Equal(t, trafficDay{Date: "2025-04-10", Views: 5, UniqueViewers: 2}, days[9])
+		for _, i := range []int{0, 1, 3, 8, 10, 13} {
+			assert.Equal(t, trafficDay{Date: days[i].Date}, days[i])
+		}
+	})
+
+	t.Run("aligns views and clones by day", func(t *testing.T) {
+		days := trafficTimeSeries(
+			[]*github.TrafficData{trafficData(day(5), 7, 3), trafficData(day(6), 2, 1)},
+			[]*github.TrafficData{trafficData(day(6), 4, 2), trafficData(day(8), 1, 1)},
+			end,
+		)
+
+		require.Len(t, days, trafficWindowDays)
+		assert.Equal(t, trafficDay{Date: "2025-04-05", Views: 7, UniqueViewers: 3}, days[4])
+		assert.Equal(t, trafficDay{Date: "2025-04-06", Views: 2, UniqueViewers: 1, Clones: 4, UniqueCloners: 2}, days[5])
+		assert.Equal(t, trafficDay{Date: "2025-04-07"}, days[6])
+		assert.Equal(t, trafficDay{Date: "2025-04-08", Clones: 1, UniqueCloners: 1}, days[7])
+	})
+
+	t.Run("normalizes timestamps to UTC days", func(t *testing.T) {
+		offset := time.FixedZone("UTC+2", 2*60*60)
+		days := trafficTimeSeries(
+			[]*github.TrafficData{trafficData(time.Date(2025, 4, 13, 1, 0, 0, 0, offset), 3, 3)},
+			nil,
+			end,
+		)
+
+		require.Len(t, days, trafficWindowDays)
+		assert.Equal(t, trafficDay{Date: "2025-04-12", Views: 3, UniqueViewers: 3}, days[11])
+	})
+
+	t.Run("widens the window for data outside of it", func(t *testing.T) {
+		days := trafficTimeSeries(
+			nil,
+			[]*github.TrafficData{trafficData(time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), 2, 1)},
+			end,
+		)
+
+		require.Len(t, days, trafficWindowDays+2)
+		assert.Equal(t, trafficDay{Date: "2025-03-30", Clones: 2, UniqueCloners: 1}, days[0])
+		assert.Equal(t, "2025-04-14", days[len(days)-1].Date)
+	})
+}
+
+func Test_GetTrafficSummary(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTrafficSummary(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_traffic_summary", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	mockViews := &github.TrafficViews{
+		Count:   github.Ptr(12),
+		Uniques: github.Ptr(5),
+		Views: []*github.TrafficData{
+			trafficData(today.AddDate(0, 0, -3), 12, 5),
+		},
+	}
+	mockClones := &github.TrafficClones{
+		Count:   github.Ptr(4),
+		Uniques: github.Ptr(2),
+		Clones: []*github.TrafficData{
+			trafficData(today.AddDate(0, 0, -3), 3, 2),
+			trafficData(today, 1, 1),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "summarizes views and clones",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"per": "day",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockViews),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"per": "day",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockClones),
+					),
+				),
+			),
+		},
+		{
+			name: "no push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, `{"message": "Must have push access to repository"}`),
+				),
+			),
+			expectedErrMsg: "viewing the traffic of owner/repo requires push access to the repository",
+		},
+		{
+			name: "clones fail",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					mockViews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficClonesByOwnerByRepo,
+					mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get repository clones",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTrafficSummary(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var summary trafficSummary
+			err = json.Unmarshal([]byte(textContent.Text), &summary)
+			require.NoError(t, err)
+			assert.Equal(t, 12, summary.Views)
+			assert.Equal(t, 5, summary.UniqueViewers)
+			assert.Equal(t, 4, summary.Clones)
+			assert.Equal(t, 2, summary.UniqueCloners)
+			require.Len(t, summary.Days, trafficWindowDays)
+			assert.Equal(t, trafficDay{
+				Date:          today.AddDate(0, 0, -3).Format(time.DateOnly),
+				Views:         12,
+				UniqueViewers: 5,
+				Clones:        3,
+				UniqueCloners: 2,
+			}, summary.Days[trafficWindowDays-4])
+			assert.Equal(t, trafficDay{
+				Date:          today.Format(time.DateOnly),
+				Clones:        1,
+				UniqueCloners: 1,
+			}, summary.Days[trafficWindowDays-1])
+		})
+	}
+}
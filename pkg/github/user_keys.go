@@ -0,0 +1,289 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	gpgPublicKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	gpgPublicKeyFooter = "-----END PGP PUBLIC KEY BLOCK-----"
+)
+
+// validateArmoredGPGKey checks that key is an ASCII armored GPG public key, as printed by
+// gpg --armor --export, so that a private key or a bare fingerprint is never sent.
+func validateArmoredGPGKey(key string) error {
+	if strings.Contains(key, "PRIVATE KEY BLOCK") {
+		return fmt.Errorf("armored_public_key must be a GPG public key, not a private key")
+	}
+	if !strings.HasPrefix(key, gpgPublicKeyHeader) || !strings.HasSuffix(key, gpgPublicKeyFooter) {
+		return fmt.Errorf("armored_public_key must be an ASCII armored GPG public key, starting with %s and ending with %s", gpgPublicKeyHeader, gpgPublicKeyFooter)
+	}
+
+	// The armor is made of optional "Name: value" headers, a blank line, the base64 encoded key
+	// and a checksum line starting with =
+	var data strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(key, gpgPublicKeyHeader), gpgPublicKeyFooter), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.Contains(line, ": ") || strings.HasPrefix(line, "=") {
+			continue
+		}
+		data.WriteString(line)
+	}
+	if data.Len() == 0 {
+		return fmt.Errorf("armored_public_key contains no key data")
+	}
+	if _, err := base64.StdEncoding.DecodeString(data.String()); err != nil {
+		return fmt.Errorf("armored_public_key contains invalid key data")
+	}
+	return nil
+}
+
+// missingKeyScope returns a tool error when err is the API hiding the keys of the authenticated
+// user from a token without the scope for them, which it reports as a 404.
+func missingKeyScope(resp *github.Response, scope string) (*mcp.CallToolResult, bool) {
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return nil, false
+	}
+	return mcp.NewToolResultError(fmt.Sprintf("the token is missing the %s scope needed to manage these keys", scope)), true
+}
+
+// ListUserGPGKeys creates a tool to list the GPG keys of the authenticated user.
+func ListUserGPGKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_gpg_keys",
+			mcp.WithDescription(t("TOOL_LIST_USER_GPG_KEYS_DESCRIPTION", "List the GPG keys the authenticated user signs commits with")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_USER_GPG_KEYS_USER_TITLE", "List my GPG keys"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			keys, resp, err := client.Users.ListGPGKeys(ctx, "", &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if result, ok := missingKeyScope(resp, "read:gpg_key"); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list GPG keys: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list GPG keys: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(keys)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateUserGPGKey creates a tool to add a GPG key to the authenticated user.
+func CreateUserGPGKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_user_gpg_key",
+			mcp.WithDescription(t("TOOL_CREATE_USER_GPG_KEY_DESCRIPTION", "Add a GPG public key to the authenticated user, so that commits signed with it show as verified")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_USER_GPG_KEY_USER_TITLE", "Add GPG key"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("armored_public_key",
+				mcp.Required(),
+				mcp.Description("ASCII armored GPG public key, as printed by gpg --armor --export"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			armoredKey, err := requiredParam[string](request, "armored_public_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			armoredKey = strings.TrimSpace(armoredKey)
+			if err := validateArmoredGPGKey(armoredKey); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("add a GPG key to the authenticated user"), nil
+			}
+			key, resp, err := client.Users.CreateGPGKey(ctx, armoredKey)
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot add GPG key: %s", describeErrorResponse(errResp))), nil
+				}
+				if result, ok := missingKeyScope(resp, "write:gpg_key"); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create GPG key: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create GPG key: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListUserSSHKeys creates a tool to list the SSH keys of the authenticated user.
+func ListUserSSHKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_ssh_keys",
+			mcp.WithDescription(t("TOOL_LIST_USER_SSH_KEYS_DESCRIPTION", "List the SSH keys the authenticated user accesses repositories with")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_USER_SSH_KEYS_USER_TITLE", "List my SSH keys"),
+				ReadOnlyHint: mcp.ToBoolPtr(true),
+			}),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			keys, resp, err := client.Users.ListKeys(ctx, "", &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if result, ok := missingKeyScope(resp, "read:public_key"); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list SSH keys: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list SSH keys: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(keys)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateUserSSHKey creates a tool to add an SSH key to the authenticated user.
+func CreateUserSSHKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_user_ssh_key",
+			mcp.WithDescription(t("TOOL_CREATE_USER_SSH_KEY_DESCRIPTION", "Add an SSH public key to the authenticated user, giving it access to every repository the user can access")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_USER_SSH_KEY_USER_TITLE", "Add SSH key"),
+				ReadOnlyHint: mcp.ToBoolPtr(false),
+			}),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Name of the key"),
+			),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("SSH public key, e.g. the contents of id_ed25519.pub"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key, err := requiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key = strings.TrimSpace(key)
+			if err := validateSSHPublicKey(key); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if isDryRun(ctx) {
+				return dryRunResult("add SSH key %s to the authenticated user", title), nil
+			}
+			created, resp, err := client.Users.CreateKey(ctx, &github.Key{
+				Title: github.Ptr(title),
+				Key:   github.Ptr(key),
+			})
+			if err != nil {
+				var errResp *github.ErrorResponse
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && errors.As(err, &errResp) {
+					return mcp.NewToolResultError(fmt.Sprintf("cannot add SSH key %s: %s", title, describeErrorResponse(errResp))), nil
+				}
+				if result, ok := missingKeyScope(resp, "write:public_key"); ok {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create SSH key: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create SSH key: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(created)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
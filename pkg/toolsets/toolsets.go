@@ -16,39 +16,58 @@ type Toolset struct {
 	Description string
 	Enabled     bool
 	readOnly    bool
+	namePrefix  string
+	disabled    map[string]bool
 	writeTools  []server.ServerTool
 	readTools   []server.ServerTool
 }
 
 func (t *Toolset) GetActiveTools() []server.ServerTool {
 	if t.Enabled {
-		if t.readOnly {
-			return t.readTools
-		}
-		return append(t.readTools, t.writeTools...)
+		return t.GetAvailableTools()
 	}
 	return nil
 }
 
 func (t *Toolset) GetAvailableTools() []server.ServerTool {
-	if t.readOnly {
-		return t.readTools
-	}
-	return append(t.readTools, t.writeTools...)
-}
-
-func (t *Toolset) RegisterTools(s *server.MCPServer) {
-	if !t.Enabled {
-		return
-	}
+	tools := make([]server.ServerTool, 0, len(t.readTools)+len(t.writeTools))
 	for _, tool := range t.readTools {
-		s.AddTool(tool.Tool, tool.Handler)
+		if !t.disabled[tool.Tool.Name] {
+			tools = append(tools, tool)
+		}
 	}
 	if !t.readOnly {
 		for _, tool := range t.writeTools {
-			s.AddTool(tool.Tool, tool.Handler)
+			if !t.disabled[tool.Tool.Name] {
+				tools = append(tools, tool)
+			}
 		}
 	}
+	if t.namePrefix == "" {
+		return tools
+	}
+	for i := range tools {
+		tools[i].Tool.Name = t.namePrefix + tools[i].Tool.Name
+	}
+	return tools
+}
+
+// HasTool reports whether the toolset holds a tool named name, without its prefix.
+func (t *Toolset) HasTool(name string) bool {
+	for _, tools := range [][]server.ServerTool{t.readTools, t.writeTools} {
+		for _, tool := range tools {
+			if tool.Tool.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *Toolset) RegisterTools(s *server.MCPServer) {
+	for _, tool := range t.GetActiveTools() {
+		s.AddTool(tool.Tool, tool.Handler)
+	}
 }
 
 func (t *Toolset) SetReadOnly() {
@@ -56,10 +75,33 @@ func (t *Toolset) SetReadOnly() {
 	t.readOnly = true
 }
 
+// SetToolNamePrefix prefixes the names of the tools of the toolset with prefix when they are listed
+// or registered, so the server can run alongside other MCP servers with tools of the same names.
+func (t *Toolset) SetToolNamePrefix(prefix string) {
+	t.namePrefix = prefix
+}
+
+// DisableTools keeps the named tools of the toolset from being listed or registered, while the
+// other tools of the toolset stay available. Names are matched without the tool name prefix.
+func (t *Toolset) DisableTools(names ...string) {
+	if t.disabled == nil {
+		t.disabled = make(map[string]bool, len(names))
+	}
+	for _, name := range names {
+		t.disabled[name] = true
+	}
+}
+
+// isReadOnly reports whether tool is annotated as read-only.
+func isReadOnly(tool server.ServerTool) bool {
+	hint := tool.Tool.Annotations.ReadOnlyHint
+	return hint != nil && *hint
+}
+
 func (t *Toolset) AddWriteTools(tools ...server.ServerTool) *Toolset {
 	// Silently ignore if the toolset is read-only to avoid any breach of that contract
 	for _, tool := range tools {
-		if tool.Tool.Annotations.ReadOnlyHint {
+		if isReadOnly(tool) {
 			panic(fmt.Sprintf("tool (%s) is incorrectly annotated as read-only", tool.Tool.Name))
 		}
 	}
@@ -71,11 +113,11 @@ func (t *Toolset) AddWriteTools(tools ...server.ServerTool) *Toolset {
 
 func (t *Toolset) AddReadTools(tools ...server.ServerTool) *Toolset {
 	for _, tool := range tools {
-		if !tool.Tool.Annotations.ReadOnlyHint {
+		if !isReadOnly(tool) {
 			panic(fmt.Sprintf("tool (%s) must be annotated as read-only", tool.Tool.Name))
 		}
 		tool.Tool.Annotations = mcp.ToolAnnotation{
-			ReadOnlyHint: true,
+			ReadOnlyHint: mcp.ToBoolPtr(true),
 			Title:        tool.Tool.Annotations.Title,
 		}
 	}
@@ -87,6 +129,8 @@ type ToolsetGroup struct {
 	Toolsets     map[string]*Toolset
 	everythingOn bool
 	readOnly     bool
+	namePrefix   string
+	disabled     []string
 }
 
 func NewToolsetGroup(readOnly bool) *ToolsetGroup {
@@ -101,9 +145,43 @@ func (tg *ToolsetGroup) AddToolset(ts *Toolset) {
 	if tg.readOnly {
 		ts.SetReadOnly()
 	}
+	if tg.namePrefix != "" {
+		ts.SetToolNamePrefix(tg.namePrefix)
+	}
+	if len(tg.disabled) > 0 {
+		ts.DisableTools(tg.disabled...)
+	}
 	tg.Toolsets[ts.Name] = ts
 }
 
+// SetToolNamePrefix prefixes the names of the tools of every toolset in the group, including
+// toolsets added later.
+func (tg *ToolsetGroup) SetToolNamePrefix(prefix string) {
+	tg.namePrefix = prefix
+	for _, ts := range tg.Toolsets {
+		ts.SetToolNamePrefix(prefix)
+	}
+}
+
+// DisableTools disables the named tools in every toolset of the group, including toolsets added
+// later.
+func (tg *ToolsetGroup) DisableTools(names ...string) {
+	tg.disabled = append(tg.disabled, names...)
+	for _, ts := range tg.Toolsets {
+		ts.DisableTools(names...)
+	}
+}
+
+// HasTool reports whether a toolset of the group holds a tool named name, without its prefix.
+func (tg *ToolsetGroup) HasTool(name string) bool {
+	for _, ts := range tg.Toolsets {
+		if ts.HasTool(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func NewToolset(name string, description string) *Toolset {
 	return &Toolset{
 		Name:        name,
@@ -2,6 +2,8 @@ package toolsets
 
 import (
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
 )
 
 func TestNewToolsetGroup(t *testing.T) {
@@ -228,3 +230,72 @@ func TestIsEnabledWithEverythingOn(t *testing.T) {
 		t.Error("Expected IsEnabled to return true for any toolset when everythingOn is true")
 	}
 }
+
+func TestSetToolNamePrefix(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+
+	toolset := NewToolset("test-toolset", "A test toolset").
+		AddReadTools(NewServerTool(mcp.NewTool("get_thing", mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)})), nil)).
+		AddWriteTools(NewServerTool(mcp.NewTool("create_thing"), nil))
+	toolset.Enabled = true
+	tsg.AddToolset(toolset)
+	tsg.SetToolNamePrefix("gh_")
+
+	// A toolset added after the prefix is set is prefixed too
+	laterToolset := NewToolset("later-toolset", "A toolset added later").
+		AddReadTools(NewServerTool(mcp.NewTool("list_things", mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)})), nil))
+	tsg.AddToolset(laterToolset)
+
+	expected := map[string][]string{
+		"test-toolset":  {"gh_get_thing", "gh_create_thing"},
+		"later-toolset": {"gh_list_things"},
+	}
+	for name, names := range expected {
+		tools := tsg.Toolsets[name].GetAvailableTools()
+		if len(tools) != len(names) {
+			t.Fatalf("Expected %d tools in %s, got %d", len(names), name, len(tools))
+		}
+		for i, tool := range tools {
+			if tool.Tool.Name != names[i] {
+				t.Errorf("Expected tool name '%s', got '%s'", names[i], tool.Tool.Name)
+			}
+		}
+	}
+
+	// The prefix is applied to copies, listing the tools again does not prefix them twice
+	if name := toolset.GetActiveTools()[0].Tool.Name; name != "gh_get_thing" {
+		t.Errorf("Expected tool name 'gh_get_thing', got '%s'", name)
+	}
+}
+
+func TestDisableTools(t *testing.T) {
+	tsg := NewToolsetGroup(false)
+
+	toolset := NewToolset("test-toolset", "A test toolset").
+		AddReadTools(NewServerTool(mcp.NewTool("get_thing", mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: mcp.ToBoolPtr(true)})), nil)).
+		AddWriteTools(
+			NewServerTool(mcp.NewTool("create_thing"), nil),
+			NewServerTool(mcp.NewTool("delete_thing"), nil),
+		)
+	toolset.Enabled = true
+	tsg.AddToolset(toolset)
+	tsg.DisableTools("delete_thing")
+
+	tools := toolset.GetActiveTools()
+	if len(tools) != 2 {
+		t.Fatalf("Expected 2 active tools, got %d", len(tools))
+	}
+	for _, tool := range tools {
+		if tool.Tool.Name == "delete_thing" {
+			t.Error("Expected delete_thing to be disabled")
+		}
+	}
+
+	// A disabled tool still belongs to the toolset
+	if !tsg.HasTool("delete_thing") {
+		t.Error("Expected the group to hold delete_thing")
+	}
+	if tsg.HasTool("missing_thing") {
+		t.Error("Expected the group not to hold missing_thing")
+	}
+}